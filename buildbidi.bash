@@ -0,0 +1,129 @@
+#!/bin/bash
+#
+# Usage: buildbidi.bash [UCDDIR]
+#
+# Generates the bidirectional character type and mirroring tables in
+# pdf/bidiclass.go from extracted/DerivedBidiClass.txt and BidiMirroring.txt
+# in the Unicode Character Database.  If UCDDIR is given, the files are read
+# from it instead of being downloaded.
+
+VERSION="14.0.0"
+URL="https://www.unicode.org/Public/$VERSION/ucd"
+OUTPUT="pdf/bidiclass.go"
+export LC_ALL=C
+
+if [ -n "$1" ]
+then
+    UCDDIR="$1"
+else
+    DIR="$(mktemp -d -t buildbidiXXX)"
+    echo "$DIR"
+    curl "$URL/extracted/DerivedBidiClass.txt" > "$DIR/DerivedBidiClass.txt"
+    curl "$URL/BidiMirroring.txt" > "$DIR/BidiMirroring.txt"
+    UCDDIR="$DIR"
+fi
+
+# Functions shared by the awk programs below.
+AWKLIB='
+function hex(s,    i, n) {
+    n = 0
+    s = toupper(s)
+    for (i = 1; i <= length(s); i++) {
+        n = n * 16 + index("0123456789ABCDEF", substr(s, i, 1)) - 1
+    }
+    return n
+}
+
+function utf8(r) {
+    if (r < 128) {
+        return sprintf("%c", r)
+    }
+    if (r < 2048) {
+        return sprintf("%c%c", 192 + int(r / 64), 128 + r % 64)
+    }
+    if (r < 65536) {
+        return sprintf("%c%c%c", 224 + int(r / 4096), 128 + int(r / 64) % 64, 128 + r % 64)
+    }
+    return sprintf("%c%c%c%c", 240 + int(r / 262144), 128 + int(r / 4096) % 64, 128 + int(r / 64) % 64, 128 + r % 64)
+}
+'
+
+rm -f "$OUTPUT"
+cat > "$OUTPUT" <<EOF
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+// The bidirectional character types and mirrored characters are from version
+// ${VERSION%.0} of the Unicode Character Database.  This file is generated by
+// buildbidi.bash.
+
+// bidiClassRanges lists the ranges of code points whose bidirectional type is
+// not L, in increasing order.
+var bidiClassRanges = []bidiClassRange{
+EOF
+
+# Each line of DerivedBidiClass.txt gives a range and its type, grouped by
+# type.  The ranges are sorted by code point and adjacent ranges of the same
+# type are merged.
+sed 's/#.*//' < "$UCDDIR/DerivedBidiClass.txt" |
+    awk -F ';' "$AWKLIB"'
+        NF == 2 {
+            gsub(/ /, "", $1)
+            gsub(/ /, "", $2)
+            if ($2 == "L") {
+                next
+            }
+            n = split($1, r, /\.\./)
+            lo = r[1]
+            hi = n == 2 ? r[2] : r[1]
+            printf "%06X %06X %s\n", hex(lo), hex(hi), $2
+        }' |
+    sort |
+    awk "$AWKLIB"'
+        function flush() {
+            if (class != "") {
+                printf "\t{0x%04X, 0x%04X, bidi%s},\n", lo, hi, class
+            }
+        }
+        {
+            start = hex($1)
+            end = hex($2)
+            if ($3 == class && start == hi + 1) {
+                hi = end
+                next
+            }
+            flush()
+            lo = start
+            hi = end
+            class = $3
+        }
+        END { flush() }' >> "$OUTPUT"
+
+cat >> "$OUTPUT" <<EOF
+}
+
+// bidiMirrors maps characters to the characters that are their mirror images.
+var bidiMirrors = map[rune]rune{
+EOF
+
+sed 's/#.*//' < "$UCDDIR/BidiMirroring.txt" |
+    awk -F ';' "$AWKLIB"'
+        NF == 2 {
+            gsub(/ /, "", $1)
+            gsub(/ /, "", $2)
+            r = hex($1)
+            m = hex($2)
+            printf "%06X\t0x%04X: 0x%04X, // %s %s\n", r, r, m, utf8(r), utf8(m)
+        }' |
+    sort |
+    cut -f 2- |
+    sed 's/^/\t/' >> "$OUTPUT"
+
+echo "}" >> "$OUTPUT"
+
+gofmt -w "$OUTPUT"
+if [ -n "$DIR" ]
+then
+    rm -rf "$DIR"
+fi
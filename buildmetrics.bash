@@ -1,28 +1,342 @@
 #!/bin/bash
+#
+# Usage: buildmetrics.bash [AFMDIR]
+#
+# Generates the metrics, width and kerning tables in pdf/metrics.go from the Adobe
+# Core14 AFM files.  If AFMDIR is
+# given, the AFM files are read from it instead of being downloaded.
 
-DIR="$(mktemp -d -t buildmetricsXXX)"
 TARBALL="Core14_AFMs.tar"
 URL="https://partners.adobe.com/public/developer/en/pdf/$TARBALL"
 OUTPUT="pdf/metrics.go"
+export LC_ALL=C
 
-echo "$DIR"
-curl "$URL" > "$DIR/$TARBALL"
-(cd "$DIR" ; tar -xf "$TARBALL")
+if [ -n "$1" ]
+then
+    AFMDIR="$1"
+else
+    DIR="$(mktemp -d -t buildmetricsXXX)"
+    echo "$DIR"
+    curl "$URL" > "$DIR/$TARBALL"
+    (cd "$DIR" ; tar -xf "$TARBALL")
+    AFMDIR="$DIR"
+fi
+
+# Glyph names of the WinAnsiEncoding character codes.  Symbol and ZapfDingbats
+# use their built-in encodings instead.
+WINANSI="
+32 space
+33 exclam
+34 quotedbl
+35 numbersign
+36 dollar
+37 percent
+38 ampersand
+39 quotesingle
+40 parenleft
+41 parenright
+42 asterisk
+43 plus
+44 comma
+45 hyphen
+46 period
+47 slash
+48 zero
+49 one
+50 two
+51 three
+52 four
+53 five
+54 six
+55 seven
+56 eight
+57 nine
+58 colon
+59 semicolon
+60 less
+61 equal
+62 greater
+63 question
+64 at
+65 A
+66 B
+67 C
+68 D
+69 E
+70 F
+71 G
+72 H
+73 I
+74 J
+75 K
+76 L
+77 M
+78 N
+79 O
+80 P
+81 Q
+82 R
+83 S
+84 T
+85 U
+86 V
+87 W
+88 X
+89 Y
+90 Z
+91 bracketleft
+92 backslash
+93 bracketright
+94 asciicircum
+95 underscore
+96 grave
+97 a
+98 b
+99 c
+100 d
+101 e
+102 f
+103 g
+104 h
+105 i
+106 j
+107 k
+108 l
+109 m
+110 n
+111 o
+112 p
+113 q
+114 r
+115 s
+116 t
+117 u
+118 v
+119 w
+120 x
+121 y
+122 z
+123 braceleft
+124 bar
+125 braceright
+126 asciitilde
+128 Euro
+130 quotesinglbase
+131 florin
+132 quotedblbase
+133 ellipsis
+134 dagger
+135 daggerdbl
+136 circumflex
+137 perthousand
+138 Scaron
+139 guilsinglleft
+140 OE
+142 Zcaron
+145 quoteleft
+146 quoteright
+147 quotedblleft
+148 quotedblright
+149 bullet
+150 endash
+151 emdash
+152 tilde
+153 trademark
+154 scaron
+155 guilsinglright
+156 oe
+158 zcaron
+159 Ydieresis
+160 space
+161 exclamdown
+162 cent
+163 sterling
+164 currency
+165 yen
+166 brokenbar
+167 section
+168 dieresis
+169 copyright
+170 ordfeminine
+171 guillemotleft
+172 logicalnot
+173 hyphen
+174 registered
+175 macron
+176 degree
+177 plusminus
+178 twosuperior
+179 threesuperior
+180 acute
+181 mu
+182 paragraph
+183 periodcentered
+184 cedilla
+185 onesuperior
+186 ordmasculine
+187 guillemotright
+188 onequarter
+189 onehalf
+190 threequarters
+191 questiondown
+192 Agrave
+193 Aacute
+194 Acircumflex
+195 Atilde
+196 Adieresis
+197 Aring
+198 AE
+199 Ccedilla
+200 Egrave
+201 Eacute
+202 Ecircumflex
+203 Edieresis
+204 Igrave
+205 Iacute
+206 Icircumflex
+207 Idieresis
+208 Eth
+209 Ntilde
+210 Ograve
+211 Oacute
+212 Ocircumflex
+213 Otilde
+214 Odieresis
+215 multiply
+216 Oslash
+217 Ugrave
+218 Uacute
+219 Ucircumflex
+220 Udieresis
+221 Yacute
+222 Thorn
+223 germandbls
+224 agrave
+225 aacute
+226 acircumflex
+227 atilde
+228 adieresis
+229 aring
+230 ae
+231 ccedilla
+232 egrave
+233 eacute
+234 ecircumflex
+235 edieresis
+236 igrave
+237 iacute
+238 icircumflex
+239 idieresis
+240 eth
+241 ntilde
+242 ograve
+243 oacute
+244 ocircumflex
+245 otilde
+246 odieresis
+247 divide
+248 oslash
+249 ugrave
+250 uacute
+251 ucircumflex
+252 udieresis
+253 yacute
+254 thorn
+255 ydieresis
+"
 
 rm -f "$OUTPUT"
 touch "$OUTPUT"
 echo "package pdf" >> "$OUTPUT"
 
-for afmFile in "$DIR"/*.afm
+for fontName in $(ls "$AFMDIR" | sed -n 's/\.afm$//p' | sort)
 do
-    fontName="$(basename "$afmFile" .afm)"
-    varName="$(echo "$fontName" | sed 's/-//')Widths"
+    afmFile="$AFMDIR/$fontName.afm"
+    varName="$(echo "$fontName" | sed 's/-//; s/^./\L&/')"
     echo >> "$OUTPUT"
     echo "// $fontName" >> "$OUTPUT"
-    echo "var $varName = []uint16{" >> "$OUTPUT"
-    sed -n 's/^C \([0-9]\|[0-9][0-9]\|1[012][0-9]\) ; WX \([0-9]\+\) ; N \([a-zA-Z]\+\).*/\1: \2, \/\/ \3/p' < "$afmFile" >> "$OUTPUT"
-    echo "}" >> "$OUTPUT"
+    awk -v varName="$varName" '
+        /^FontBBox / { llx = $2; lly = $3; urx = $4; ury = $5 }
+        /^ItalicAngle / { italicAngle = $2 }
+        /^Ascender / { ascender = $2 }
+        /^Descender / { descender = $2 }
+        /^CapHeight / { capHeight = $2 }
+        /^XHeight / { xHeight = $2 }
+        END {
+            # Symbol and ZapfDingbats do not give an ascender or descender,
+            # so use the bounding box instead.
+            if (ascender == "") {
+                ascender = ury
+            }
+            if (descender == "") {
+                descender = lly
+            }
+            print "var " varName "Metrics = FontMetrics{"
+            printf "Ascender: %d,\nDescender: %d,\nCapHeight: %d,\nXHeight: %d,\n", ascender, descender, capHeight, xHeight
+            printf "ItalicAngle: %s,\n", italicAngle
+            printf "FontBBox: Rectangle{Point{%d, %d}, Point{%d, %d}},\n", llx, lly, urx, ury
+            print "}"
+            print ""
+        }' < "$afmFile" >> "$OUTPUT"
+    case "$fontName" in
+    Symbol|ZapfDingbats)
+        echo "var ${varName}Widths = []uint16{" >> "$OUTPUT"
+        sed -n 's/^C \([0-9]\+\) ; WX \([0-9]\+\) ; N \([a-zA-Z0-9]\+\).*/\1: \2, \/\/ \3/p' < "$afmFile" >> "$OUTPUT"
+        echo "}" >> "$OUTPUT"
+        ;;
+    *)
+        awk -v winansi="$WINANSI" -v varName="$varName" '
+            BEGIN {
+                n = split(winansi, lines, "\n")
+                for (i = 1; i <= n; i++) {
+                    if (split(lines[i], f, " ") == 2) {
+                        codes[f[1]] = f[2]
+                        names[f[2]] = names[f[2]] " " f[1]
+                    }
+                }
+            }
+            /^C -?[0-9]+ ; WX [0-9]+ ; N [a-zA-Z0-9]+ / { widths[$8] = $5 }
+            /^KPX / {
+                if (!($2 in names) || !($3 in names)) {
+                    next
+                }
+                split(names[$2], left, " ")
+                split(names[$3], right, " ")
+                for (i in left) {
+                    for (j in right) {
+                        kern[left[i] * 256 + right[j]] = $4
+                        nkern++
+                    }
+                }
+            }
+            END {
+                print "var " varName "Widths = []uint16{"
+                for (c = 0; c < 256; c++) {
+                    if ((c in codes) && (codes[c] in widths)) {
+                        printf "%d: %d, // %s\n", c, widths[codes[c]], codes[c]
+                    }
+                }
+                print "}"
+                if (nkern == 0) {
+                    exit
+                }
+                print ""
+                print "var " varName "Kerning = map[kernPair]int16{"
+                for (k = 0; k < 65536; k++) {
+                    if (k in kern) {
+                        l = int(k / 256)
+                        r = k % 256
+                        printf "{%d, %d}: %d, // %s %s\n", l, r, kern[k], codes[l], codes[r]
+                    }
+                }
+                print "}"
+            }' < "$afmFile" >> "$OUTPUT"
+        ;;
+    esac
 done
 
 gofmt -w "$OUTPUT"
-rm -rf "$DIR"
+if [ -n "$DIR" ]
+then
+    rm -rf "$DIR"
+fi
@@ -0,0 +1,757 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"sort"
+	"unicode"
+)
+
+// Direction is the base direction of a paragraph of text.
+type Direction int
+
+// Text directions
+const (
+	// DirectionAuto takes the direction of the first letter that has a
+	// strong direction, or left-to-right if there is none.
+	DirectionAuto Direction = iota
+	LeftToRight
+	RightToLeft
+)
+
+// bidiClass is a bidirectional character type from the Unicode Bidirectional
+// Algorithm (UAX #9).
+type bidiClass uint8
+
+// Bidirectional character types
+const (
+	bidiL bidiClass = iota
+	bidiR
+	bidiAL
+	bidiEN
+	bidiES
+	bidiET
+	bidiAN
+	bidiCS
+	bidiNSM
+	bidiBN
+	bidiB
+	bidiS
+	bidiWS
+	bidiON
+	bidiLRE
+	bidiLRO
+	bidiRLE
+	bidiRLO
+	bidiPDF
+	bidiLRI
+	bidiRLI
+	bidiFSI
+	bidiPDI
+)
+
+type bidiClassRange struct {
+	lo, hi rune
+	class  bidiClass
+}
+
+// bidiClassOf returns the bidirectional type of a character.
+func bidiClassOf(r rune) bidiClass {
+	i := sort.Search(len(bidiClassRanges), func(i int) bool {
+		return bidiClassRanges[i].hi >= r
+	})
+	if i < len(bidiClassRanges) && bidiClassRanges[i].lo <= r {
+		return bidiClassRanges[i].class
+	}
+	return bidiL
+}
+
+// isolateInitiator reports whether c starts a directional isolate.
+func (c bidiClass) isolateInitiator() bool {
+	return c == bidiLRI || c == bidiRLI || c == bidiFSI
+}
+
+// removed reports whether characters of type c are ignored by the algorithm
+// after the explicit levels have been computed (rule X9).
+func (c bidiClass) removed() bool {
+	switch c {
+	case bidiLRE, bidiLRO, bidiRLE, bidiRLO, bidiPDF, bidiBN:
+		return true
+	}
+	return false
+}
+
+// neutral reports whether c is a neutral or isolate formatting type.
+func (c bidiClass) neutral() bool {
+	switch c {
+	case bidiB, bidiS, bidiWS, bidiON, bidiLRI, bidiRLI, bidiFSI, bidiPDI:
+		return true
+	}
+	return false
+}
+
+// strong returns the strong direction (bidiL or bidiR) that c is treated as
+// when resolving neutrals, or bidiON if c is not strong.  Numbers are treated
+// as right-to-left.
+func (c bidiClass) strong() bidiClass {
+	switch c {
+	case bidiL:
+		return bidiL
+	case bidiR, bidiAL, bidiEN, bidiAN:
+		return bidiR
+	}
+	return bidiON
+}
+
+// levelDirection returns the direction of an embedding level.
+func levelDirection(level uint8) bidiClass {
+	if level%2 == 1 {
+		return bidiR
+	}
+	return bidiL
+}
+
+// maxBidiDepth is the deepest explicit embedding level.
+const maxBidiDepth = 125
+
+// bidiNeeded reports whether the Unicode Bidirectional Algorithm could change
+// the order of the characters in s.
+func bidiNeeded(s string, dir Direction) bool {
+	if dir == RightToLeft {
+		return true
+	}
+	for _, r := range s {
+		switch bidiClassOf(r) {
+		case bidiR, bidiAL, bidiAN, bidiRLE, bidiRLO, bidiRLI, bidiFSI:
+			return true
+		}
+	}
+	return false
+}
+
+// bidiVisual returns s in the order that it is displayed.  s is treated as a
+// single line.
+func bidiVisual(s string, dir Direction) string {
+	if !bidiNeeded(s, dir) {
+		return s
+	}
+	p := newBidiParagraph(s, dir)
+	var visual []rune
+	for _, run := range p.runs(0, len(p.text)) {
+		for k := range run.indices {
+			if run.rtl {
+				k = len(run.indices) - 1 - k
+			}
+			visual = append(visual, p.glyph(run.indices[k]))
+		}
+	}
+	return string(visual)
+}
+
+// bidiParagraph is a paragraph of text with resolved embedding levels.
+type bidiParagraph struct {
+	text    []rune
+	classes []bidiClass // original types of the characters
+	types   []bidiClass // resolved types of the characters
+	levels  []uint8
+	level   uint8 // paragraph embedding level
+
+	// matchingPDI gives the index of the PDI that closes each isolate
+	// initiator, or -1 if it is unmatched.
+	matchingPDI []int
+}
+
+// newBidiParagraph resolves the embedding levels of a paragraph using the
+// Unicode Bidirectional Algorithm.
+func newBidiParagraph(s string, dir Direction) *bidiParagraph {
+	p := &bidiParagraph{text: []rune(s)}
+	n := len(p.text)
+	p.classes = make([]bidiClass, n)
+	p.types = make([]bidiClass, n)
+	p.levels = make([]uint8, n)
+	for i, r := range p.text {
+		p.classes[i] = bidiClassOf(r)
+		p.types[i] = p.classes[i]
+	}
+	p.matchIsolates()
+
+	switch dir {
+	case RightToLeft:
+		p.level = 1
+	case DirectionAuto:
+		if p.firstStrong(0, n) == bidiR {
+			p.level = 1
+		}
+	}
+
+	p.explicitLevels()
+	for _, seq := range p.isolatingRunSequences() {
+		p.resolveSequence(seq)
+	}
+
+	// I1 and I2: Resolve the implicit levels.  Characters removed by rule
+	// X9 take the level of the character before them.
+	for i, c := range p.classes {
+		level := p.levels[i]
+		switch t := p.types[i]; {
+		case c.removed():
+			if i > 0 {
+				p.levels[i] = p.levels[i-1]
+			} else {
+				p.levels[i] = p.level
+			}
+		case level%2 == 0 && t == bidiR:
+			p.levels[i] = level + 1
+		case level%2 == 0 && (t == bidiAN || t == bidiEN):
+			p.levels[i] = level + 2
+		case level%2 == 1 && (t == bidiL || t == bidiAN || t == bidiEN):
+			p.levels[i] = level + 1
+		}
+	}
+	return p
+}
+
+// matchIsolates pairs isolate initiators with their PDIs (rule BD9).
+func (p *bidiParagraph) matchIsolates() {
+	p.matchingPDI = make([]int, len(p.text))
+	var stack []int
+	for i, c := range p.classes {
+		p.matchingPDI[i] = -1
+		switch {
+		case c.isolateInitiator():
+			stack = append(stack, i)
+		case c == bidiPDI && len(stack) > 0:
+			open := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			p.matchingPDI[open] = i
+		}
+	}
+}
+
+// firstStrong returns the direction of the first strong character between
+// start and end, skipping over isolates (rules P2 and P3).  It returns bidiON
+// if there is no strong character.
+func (p *bidiParagraph) firstStrong(start, end int) bidiClass {
+	for i := start; i < end; i++ {
+		switch c := p.classes[i]; {
+		case c == bidiL:
+			return bidiL
+		case c == bidiR || c == bidiAL:
+			return bidiR
+		case c.isolateInitiator():
+			if p.matchingPDI[i] == -1 {
+				return bidiON
+			}
+			i = p.matchingPDI[i]
+		}
+	}
+	return bidiON
+}
+
+// bidiStatus is an entry in the directional status stack.
+type bidiStatus struct {
+	level    uint8
+	override bidiClass // bidiL, bidiR or bidiON for no override
+	isolate  bool
+}
+
+// explicitLevels applies the explicit embeddings, overrides and isolates
+// (rules X1 through X8).
+func (p *bidiParagraph) explicitLevels() {
+	stack := []bidiStatus{{p.level, bidiON, false}}
+	overflowIsolates, overflowEmbeddings, validIsolates := 0, 0, 0
+	for i, c := range p.classes {
+		top := stack[len(stack)-1]
+		switch c {
+		case bidiRLE, bidiLRE, bidiRLO, bidiLRO:
+			p.levels[i] = top.level
+			next := nextLevel(top.level, c == bidiRLE || c == bidiRLO)
+			if next <= maxBidiDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				status := bidiStatus{next, bidiON, false}
+				switch c {
+				case bidiRLO:
+					status.override = bidiR
+				case bidiLRO:
+					status.override = bidiL
+				}
+				stack = append(stack, status)
+			} else if overflowIsolates == 0 {
+				overflowEmbeddings++
+			}
+		case bidiRLI, bidiLRI, bidiFSI:
+			p.levels[i] = top.level
+			if top.override != bidiON {
+				p.types[i] = top.override
+			}
+			rtl := c == bidiRLI
+			if c == bidiFSI {
+				end := p.matchingPDI[i]
+				if end == -1 {
+					end = len(p.text)
+				}
+				rtl = p.firstStrong(i+1, end) == bidiR
+			}
+			next := nextLevel(top.level, rtl)
+			if next <= maxBidiDepth && overflowIsolates == 0 && overflowEmbeddings == 0 {
+				validIsolates++
+				stack = append(stack, bidiStatus{next, bidiON, true})
+			} else {
+				overflowIsolates++
+			}
+		case bidiPDI:
+			if overflowIsolates > 0 {
+				overflowIsolates--
+			} else if validIsolates > 0 {
+				overflowEmbeddings = 0
+				for !stack[len(stack)-1].isolate {
+					stack = stack[:len(stack)-1]
+				}
+				stack = stack[:len(stack)-1]
+				validIsolates--
+			}
+			top = stack[len(stack)-1]
+			p.levels[i] = top.level
+			if top.override != bidiON {
+				p.types[i] = top.override
+			}
+		case bidiPDF:
+			p.levels[i] = top.level
+			if overflowIsolates > 0 {
+				// Do nothing.
+			} else if overflowEmbeddings > 0 {
+				overflowEmbeddings--
+			} else if !top.isolate && len(stack) >= 2 {
+				stack = stack[:len(stack)-1]
+			}
+		case bidiB:
+			p.levels[i] = p.level
+		case bidiBN:
+			p.levels[i] = top.level
+		default:
+			p.levels[i] = top.level
+			if top.override != bidiON {
+				p.types[i] = top.override
+			}
+		}
+	}
+}
+
+// nextLevel returns the least odd (if rtl is true) or even level greater than
+// level.
+func nextLevel(level uint8, rtl bool) uint8 {
+	if rtl {
+		return (level + 1) | 1
+	}
+	return (level + 2) &^ 1
+}
+
+// isolatingRunSequences returns the indices of the characters in each
+// isolating run sequence (rule X10).  Characters removed by rule X9 are not
+// included.
+func (p *bidiParagraph) isolatingRunSequences() [][]int {
+	var runs [][]int
+	runStart := make(map[int]int) // character index -> run index
+	var run []int
+	for i, c := range p.classes {
+		if c.removed() {
+			continue
+		}
+		if len(run) > 0 && p.levels[run[len(run)-1]] != p.levels[i] {
+			runs = append(runs, run)
+			run = nil
+		}
+		if len(run) == 0 {
+			runStart[i] = len(runs)
+		}
+		run = append(run, i)
+	}
+	if len(run) > 0 {
+		runs = append(runs, run)
+	}
+
+	var seqs [][]int
+	appended := make([]bool, len(runs))
+	for k, run := range runs {
+		if appended[k] {
+			continue
+		}
+		seq := append([]int(nil), run...)
+		for {
+			last := seq[len(seq)-1]
+			if !p.classes[last].isolateInitiator() || p.matchingPDI[last] == -1 {
+				break
+			}
+			next, ok := runStart[p.matchingPDI[last]]
+			if !ok {
+				break
+			}
+			appended[next] = true
+			seq = append(seq, runs[next]...)
+		}
+		seqs = append(seqs, seq)
+	}
+	return seqs
+}
+
+// resolveSequence resolves the types of the characters in an isolating run
+// sequence (rules W1 through N2).
+func (p *bidiParagraph) resolveSequence(seq []int) {
+	n := len(seq)
+	t := make([]bidiClass, n)
+	for k, i := range seq {
+		t[k] = p.types[i]
+	}
+	level := p.levels[seq[0]]
+	embedding := levelDirection(level)
+
+	// Find the types at the start and end of the sequence.
+	before, after := p.level, p.level
+	for i := seq[0] - 1; i >= 0; i-- {
+		if !p.classes[i].removed() {
+			before = p.levels[i]
+			break
+		}
+	}
+	if last := seq[n-1]; !p.classes[last].isolateInitiator() {
+		for i := last + 1; i < len(p.text); i++ {
+			if !p.classes[i].removed() {
+				after = p.levels[i]
+				break
+			}
+		}
+	}
+	sos := levelDirection(maxLevel(before, level))
+	eos := levelDirection(maxLevel(after, level))
+
+	// W1: Nonspacing marks take the type of the previous character.
+	for k := range t {
+		if t[k] != bidiNSM {
+			continue
+		}
+		switch {
+		case k == 0:
+			t[k] = sos
+		case t[k-1].isolateInitiator() || t[k-1] == bidiPDI:
+			t[k] = bidiON
+		default:
+			t[k] = t[k-1]
+		}
+	}
+	// W2: European numbers after Arabic letters are Arabic numbers.
+	// W3: Arabic letters are right-to-left.
+	lastStrong := sos
+	for k := range t {
+		switch t[k] {
+		case bidiL, bidiR, bidiAL:
+			lastStrong = t[k]
+		case bidiEN:
+			if lastStrong == bidiAL {
+				t[k] = bidiAN
+			}
+		}
+	}
+	for k := range t {
+		if t[k] == bidiAL {
+			t[k] = bidiR
+		}
+	}
+	// W4: A single separator between two numbers of the same type takes
+	// their type.
+	for k := 1; k+1 < n; k++ {
+		switch {
+		case t[k] == bidiES && t[k-1] == bidiEN && t[k+1] == bidiEN:
+			t[k] = bidiEN
+		case t[k] == bidiCS && t[k-1] == t[k+1] && (t[k-1] == bidiEN || t[k-1] == bidiAN):
+			t[k] = t[k-1]
+		}
+	}
+	// W5: Terminators next to European numbers are European numbers.
+	for k := 0; k < n; {
+		if t[k] != bidiET {
+			k++
+			continue
+		}
+		end := k
+		for end < n && t[end] == bidiET {
+			end++
+		}
+		if k > 0 && t[k-1] == bidiEN || end < n && t[end] == bidiEN {
+			for j := k; j < end; j++ {
+				t[j] = bidiEN
+			}
+		}
+		k = end
+	}
+	// W6: Other separators and terminators are neutral.
+	for k := range t {
+		switch t[k] {
+		case bidiES, bidiET, bidiCS:
+			t[k] = bidiON
+		}
+	}
+	// W7: European numbers in left-to-right text are left-to-right.
+	lastStrong = sos
+	for k := range t {
+		switch t[k] {
+		case bidiL, bidiR:
+			lastStrong = t[k]
+		case bidiEN:
+			if lastStrong == bidiL {
+				t[k] = bidiL
+			}
+		}
+	}
+
+	p.resolveBrackets(seq, t, sos, embedding)
+
+	// N1 and N2: Neutrals take the direction of the text around them if it
+	// agrees, and the embedding direction otherwise.
+	for k := 0; k < n; {
+		if !t[k].neutral() {
+			k++
+			continue
+		}
+		end := k
+		for end < n && t[end].neutral() {
+			end++
+		}
+		before, after := sos, eos
+		if k > 0 {
+			before = t[k-1].strong()
+		}
+		if end < n {
+			after = t[end].strong()
+		}
+		dir := embedding
+		if before == after {
+			dir = before
+		}
+		for j := k; j < end; j++ {
+			t[j] = dir
+		}
+		k = end
+	}
+
+	for k, i := range seq {
+		p.types[i] = t[k]
+	}
+}
+
+func maxLevel(a, b uint8) uint8 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// maxBracketDepth is the number of nested brackets that are paired.
+const maxBracketDepth = 63
+
+// resolveBrackets gives pairs of brackets in an isolating run sequence the
+// direction of the text inside them (rule N0).  t holds the types of the
+// sequence's characters.
+func (p *bidiParagraph) resolveBrackets(seq []int, t []bidiClass, sos, embedding bidiClass) {
+	type bracket struct {
+		closing rune
+		pos     int
+	}
+	var stack []bracket
+	var pairs [][2]int
+pairing:
+	for k, i := range seq {
+		if t[k] != bidiON {
+			continue
+		}
+		r := p.text[i]
+		mirror, ok := bidiMirrors[r]
+		if !ok {
+			continue
+		}
+		switch {
+		case unicode.Is(unicode.Ps, r):
+			if len(stack) == maxBracketDepth {
+				break pairing
+			}
+			stack = append(stack, bracket{canonicalBracket(mirror), k})
+		case unicode.Is(unicode.Pe, r):
+			r = canonicalBracket(r)
+			for j := len(stack) - 1; j >= 0; j-- {
+				if stack[j].closing == r {
+					pairs = append(pairs, [2]int{stack[j].pos, k})
+					stack = stack[:j]
+					break
+				}
+			}
+		}
+	}
+	sort.Sort(bracketPairs(pairs))
+
+	for _, pair := range pairs {
+		found := bidiON
+		for k := pair[0] + 1; k < pair[1]; k++ {
+			if s := t[k].strong(); s == embedding {
+				found = s
+				break
+			} else if s != bidiON {
+				found = s
+			}
+		}
+		if found == bidiON {
+			continue
+		}
+		if found != embedding {
+			// Use the opposite direction only if the text before the
+			// brackets has it too.
+			context := sos
+			for k := pair[0] - 1; k >= 0; k-- {
+				if s := t[k].strong(); s != bidiON {
+					context = s
+					break
+				}
+			}
+			if context != found {
+				found = embedding
+			}
+		}
+		for _, k := range pair {
+			t[k] = found
+			for j := k + 1; j < len(seq) && p.classes[seq[j]] == bidiNSM; j++ {
+				t[j] = found
+			}
+		}
+	}
+}
+
+// canonicalBracket returns the canonical equivalent of a bracket.
+func canonicalBracket(r rune) rune {
+	switch r {
+	case 0x2329:
+		return 0x3008
+	case 0x232A:
+		return 0x3009
+	}
+	return r
+}
+
+type bracketPairs [][2]int
+
+func (p bracketPairs) Len() int           { return len(p) }
+func (p bracketPairs) Less(i, j int) bool { return p[i][0] < p[j][0] }
+func (p bracketPairs) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// bidiRun is a sequence of characters in a line that have the same embedding
+// level.
+type bidiRun struct {
+	indices []int // indices of the characters in logical order
+	rtl     bool
+}
+
+// runText returns the characters of a run in logical order, with mirrored
+// characters replaced.
+func (p *bidiParagraph) runText(run bidiRun) string {
+	s := make([]rune, len(run.indices))
+	for k, i := range run.indices {
+		s[k] = p.glyph(i)
+	}
+	return string(s)
+}
+
+// runs returns the level runs of the characters between start and end in the
+// order that they are displayed when the characters form a line (rules L1 and
+// L2).  Formatting characters that are not displayed are left out.
+func (p *bidiParagraph) runs(start, end int) []bidiRun {
+	n := end - start
+	order := make([]int, n)
+	levels := make([]uint8, n)
+	copy(levels, p.levels[start:end])
+	trailing := true
+	for k := n - 1; k >= 0; k-- {
+		order[k] = start + k
+		switch c := p.classes[start+k]; {
+		case c == bidiS || c == bidiB:
+			levels[k] = p.level
+			trailing = true
+		case trailing && (c == bidiWS || c.isolateInitiator() || c == bidiPDI || c.removed()):
+			levels[k] = p.level
+		default:
+			trailing = false
+		}
+	}
+
+	var highest, lowestOdd uint8 = 0, maxBidiDepth + 2
+	for _, l := range levels {
+		if l > highest {
+			highest = l
+		}
+		if l%2 == 1 && l < lowestOdd {
+			lowestOdd = l
+		}
+	}
+	for l := highest; l >= lowestOdd; l-- {
+		for k := 0; k < n; {
+			if levels[k] < l {
+				k++
+				continue
+			}
+			j := k
+			for j < n && levels[j] >= l {
+				j++
+			}
+			for a, b := k, j-1; a < b; a, b = a+1, b-1 {
+				order[a], order[b] = order[b], order[a]
+				levels[a], levels[b] = levels[b], levels[a]
+			}
+			k = j
+		}
+	}
+
+	// Characters next to each other with the same level are also next to
+	// each other in logical order.
+	var runs []bidiRun
+	for k, i := range order {
+		if p.hidden(i) {
+			continue
+		}
+		if k == 0 || len(runs) == 0 || levels[k] != levels[k-1] {
+			runs = append(runs, bidiRun{rtl: levels[k]%2 == 1})
+		}
+		run := &runs[len(runs)-1]
+		run.indices = append(run.indices, i)
+	}
+	for _, run := range runs {
+		if run.rtl {
+			for a, b := 0, len(run.indices)-1; a < b; a, b = a+1, b-1 {
+				run.indices[a], run.indices[b] = run.indices[b], run.indices[a]
+			}
+		}
+	}
+	return runs
+}
+
+// hidden reports whether a character only controls the direction of text and
+// is not displayed.
+func (p *bidiParagraph) hidden(i int) bool {
+	switch p.text[i] {
+	case 0x061C, 0x200E, 0x200F: // ALM, LRM, RLM
+		return true
+	}
+	switch c := p.classes[i]; {
+	case c.isolateInitiator(), c == bidiPDI:
+		return true
+	case c == bidiBN:
+		return false
+	}
+	return p.classes[i].removed()
+}
+
+// glyph returns the character that is displayed for the i-th character of the
+// paragraph, which is its mirror image if it is in right-to-left text (rule
+// L4).
+func (p *bidiParagraph) glyph(i int) rune {
+	if p.levels[i]%2 == 1 {
+		if m, ok := bidiMirrors[p.text[i]]; ok {
+			return m
+		}
+	}
+	return p.text[i]
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"testing"
+)
+
+var bidiVisualTests = []struct {
+	s        string
+	dir      Direction
+	expected string
+}{
+	{"", DirectionAuto, ""},
+	{"abc", DirectionAuto, "abc"},
+	{"אבג", DirectionAuto, "גבא"},
+	{"abc אבג def", DirectionAuto, "abc גבא def"},
+	{"אבג abc דהו", DirectionAuto, "והד abc גבא"},
+	{"אבג 123", DirectionAuto, "123 גבא"},
+	{"abc!", RightToLeft, "!abc"},
+	{"abc def", RightToLeft, "abc def"},
+	{"عدد ١٢٣", DirectionAuto, "١٢٣ ددع"},
+	{"א(ב)", DirectionAuto, "(ב)א"},
+	{"a (אב) c", DirectionAuto, "a (בא) c"},
+	{"a‮bcd‬e", LeftToRight, "adcbe"},
+	{"⁧abc⁩ ד", LeftToRight, "abc ד"},
+	{"a‏b", DirectionAuto, "ab"},
+}
+
+func TestBidiVisual(t *testing.T) {
+	for _, tt := range bidiVisualTests {
+		if result := bidiVisual(tt.s, tt.dir); result != tt.expected {
+			t.Errorf("bidiVisual(%q, %d) = %q; want %q", tt.s, tt.dir, result, tt.expected)
+		}
+	}
+}
+
+func TestBidiClassOf(t *testing.T) {
+	tests := []struct {
+		r     rune
+		class bidiClass
+	}{
+		{'a', bidiL},
+		{'א', bidiR},
+		{'ع', bidiAL},
+		{'1', bidiEN},
+		{'١', bidiAN},
+		{' ', bidiWS},
+		{'$', bidiET},
+		{'(', bidiON},
+		{'́', bidiNSM},
+		{'‮', bidiRLO},
+		{'世', bidiL},
+	}
+	for _, tt := range tests {
+		if class := bidiClassOf(tt.r); class != tt.class {
+			t.Errorf("bidiClassOf(%q) = %d; want %d", tt.r, class, tt.class)
+		}
+	}
+}
@@ -3,7 +3,7 @@
 package pdf
 
 import (
-	"bytes"
+	"errors"
 	"fmt"
 	"image"
 	"io"
@@ -32,13 +32,20 @@ func writeCommand(w io.Writer, op string, args ...interface{}) error {
 }
 
 // Canvas is a two-dimensional drawing region on a single page.  You can obtain
-// a canvas once you have created a document.
+// a canvas once you have created a document.  The glyphs of a Type3Font are
+// also drawn on canvases, which have no page.
 type Canvas struct {
 	doc          *Document
 	page         *pageDict
 	ref          Reference
 	contents     *stream
+	resources    *resources
 	imageCounter uint
+
+	// ctm is the current transformation matrix, and ctmStack holds the
+	// matrices saved by Push.
+	ctm      Matrix
+	ctmStack []Matrix
 }
 
 // Document returns the document the canvas is attached to.
@@ -52,24 +59,40 @@ func (canvas *Canvas) Close() error {
 	return canvas.contents.Close()
 }
 
-// Size returns the page's media box (the size of the physical medium).
+// Size returns the page's media box (the size of the physical medium).  It
+// returns zero for a glyph's canvas.
 func (canvas *Canvas) Size() (width, height Unit) {
+	if canvas.page == nil {
+		return 0, 0
+	}
 	mbox := canvas.page.MediaBox
 	return mbox.Dx(), mbox.Dy()
 }
 
-// SetSize changes the page's media box (the size of the physical medium).
+// SetSize changes the page's media box (the size of the physical medium).  It
+// has no effect on a glyph's canvas.
 func (canvas *Canvas) SetSize(width, height Unit) {
+	if canvas.page == nil {
+		return
+	}
 	canvas.page.MediaBox = Rectangle{Point{0, 0}, Point{width, height}}
 }
 
-// CropBox returns the page's crop box.
+// CropBox returns the page's crop box.  It returns the zero rectangle for a
+// glyph's canvas.
 func (canvas *Canvas) CropBox() Rectangle {
+	if canvas.page == nil {
+		return Rectangle{}
+	}
 	return canvas.page.CropBox
 }
 
-// SetCropBox changes the page's crop box.
+// SetCropBox changes the page's crop box.  It has no effect on a glyph's
+// canvas.
 func (canvas *Canvas) SetCropBox(crop Rectangle) {
+	if canvas.page == nil {
+		return
+	}
 	canvas.page.CropBox = crop
 }
 
@@ -77,20 +100,77 @@ func (canvas *Canvas) SetCropBox(crop Rectangle) {
 // effect as performing a fill then a stroke, but does not repeat the path in
 // the file.
 func (canvas *Canvas) FillStroke(p *Path) {
-	io.Copy(canvas.contents, &p.buf)
-	writeCommand(canvas.contents, "B")
+	canvas.paintPath(p, "B")
+}
+
+// FillStrokeEvenOdd fills then strokes the given path, using the even-odd rule
+// to determine the area enclosed by the path.
+func (canvas *Canvas) FillStrokeEvenOdd(p *Path) {
+	canvas.paintPath(p, "B*")
 }
 
 // Fill paints the area enclosed by the given path using the current fill color.
+// The enclosed area is determined with the nonzero winding number rule, so
+// overlapping subpaths drawn in opposite directions leave a hole.
 func (canvas *Canvas) Fill(p *Path) {
-	io.Copy(canvas.contents, &p.buf)
-	writeCommand(canvas.contents, "f")
+	canvas.paintPath(p, "f")
+}
+
+// FillEvenOdd paints the area enclosed by the given path using the current
+// fill color.  The enclosed area is determined with the even-odd rule, so
+// regions inside an even number of subpaths, like the hole of a donut, are not
+// painted regardless of the subpaths' directions.
+func (canvas *Canvas) FillEvenOdd(p *Path) {
+	canvas.paintPath(p, "f*")
 }
 
 // Stroke paints a line along the given path using the current stroke color.
 func (canvas *Canvas) Stroke(p *Path) {
-	io.Copy(canvas.contents, &p.buf)
-	writeCommand(canvas.contents, "S")
+	canvas.paintPath(p, "S")
+}
+
+// CloseStroke closes the last subpath of the given path, then strokes it.
+func (canvas *Canvas) CloseStroke(p *Path) {
+	canvas.paintPath(p, "s")
+}
+
+// CloseFillStroke closes the last subpath of the given path, then fills and
+// strokes it.
+func (canvas *Canvas) CloseFillStroke(p *Path) {
+	canvas.paintPath(p, "b")
+}
+
+// CloseFillStrokeEvenOdd closes the last subpath of the given path, then fills
+// it using the even-odd rule and strokes it.
+func (canvas *Canvas) CloseFillStrokeEvenOdd(p *Path) {
+	canvas.paintPath(p, "b*")
+}
+
+// Clip intersects the clipping path with the area enclosed by the given path,
+// using the nonzero winding number rule.  Nothing is painted outside of the
+// clipping path until the graphics state is restored with Pop, so clipping is
+// usually done between Push and Pop:
+//
+//   canvas.Push()
+//   canvas.Clip(path)
+//   canvas.DrawImage(img, rect)
+//   canvas.Pop()
+func (canvas *Canvas) Clip(p *Path) {
+	canvas.paintPath(p, "W")
+	writeCommand(canvas.contents, "n")
+}
+
+// ClipEvenOdd intersects the clipping path with the area enclosed by the given
+// path, using the even-odd rule.
+func (canvas *Canvas) ClipEvenOdd(p *Path) {
+	canvas.paintPath(p, "W*")
+	writeCommand(canvas.contents, "n")
+}
+
+// paintPath writes a path followed by a path-painting operator.
+func (canvas *Canvas) paintPath(p *Path, op string) {
+	p.writeTo(canvas.contents)
+	writeCommand(canvas.contents, op)
 }
 
 // SetLineWidth changes the stroke width to the given value.
@@ -109,65 +189,280 @@ func (canvas *Canvas) SetLineDash(phase Unit, dash []Unit) {
 	writeCommand(canvas.contents, "d", dash, phase)
 }
 
+// LineCap is the shape at the ends of open subpaths when they are stroked.
+type LineCap int
+
+// Line cap styles
+const (
+	// ButtCap squares off the line at its endpoint.
+	ButtCap LineCap = iota
+	// RoundCap ends the line with a semicircle centered on its endpoint.
+	RoundCap
+	// ProjectingSquareCap extends the line half the line width beyond
+	// its endpoint and squares it off.
+	ProjectingSquareCap
+)
+
+// SetLineCap changes the shape at the ends of stroked lines.  The default is
+// ButtCap.
+func (canvas *Canvas) SetLineCap(c LineCap) {
+	writeCommand(canvas.contents, "J", int(c))
+}
+
+// LineJoin is the shape of the corners where stroked segments meet.
+type LineJoin int
+
+// Line join styles
+const (
+	// MiterJoin extends the outer edges of the segments until they meet.
+	// Joins that would be too long are beveled instead; see
+	// SetMiterLimit.
+	MiterJoin LineJoin = iota
+	// RoundJoin rounds the corner with a circle whose diameter is the line
+	// width.
+	RoundJoin
+	// BevelJoin cuts the corner off with a straight line.
+	BevelJoin
+)
+
+// SetLineJoin changes the shape of corners in stroked paths.  The default is
+// MiterJoin.
+func (canvas *Canvas) SetLineJoin(j LineJoin) {
+	writeCommand(canvas.contents, "j", int(j))
+}
+
+// SetMiterLimit changes the longest miter join that is drawn, as a ratio of
+// the length of the miter to the line width.  Sharper corners are beveled
+// instead.  The default is 10, which bevels corners of less than about 11.5
+// degrees.
+func (canvas *Canvas) SetMiterLimit(limit float32) {
+	writeCommand(canvas.contents, "M", limit)
+}
+
+// SetFlatness changes how closely curves are approximated with straight lines
+// when they are rendered, as the largest distance in device pixels between the
+// curve and its approximation.  A flatness of 0 uses the device's default.
+func (canvas *Canvas) SetFlatness(flatness float32) {
+	writeCommand(canvas.contents, "i", flatness)
+}
+
+// SetAlpha changes the opacity that shapes and text are filled with, from 0
+// (invisible) to 1 (opaque).  Values outside that range are clamped to it.
+// The default is 1.
+func (canvas *Canvas) SetAlpha(alpha float32) {
+	canvas.setExtGState(extGStateDict{Ca: clampAlpha(alpha)})
+}
+
+// SetStrokeAlpha changes the opacity that paths are stroked with, from 0
+// (invisible) to 1 (opaque).  Values outside that range are clamped to it.
+// The default is 1.
+func (canvas *Canvas) SetStrokeAlpha(alpha float32) {
+	canvas.setExtGState(extGStateDict{CA: clampAlpha(alpha)})
+}
+
+func clampAlpha(alpha float32) float32 {
+	switch {
+	case alpha < 0:
+		return 0
+	case alpha > 1:
+		return 1
+	}
+	return alpha
+}
+
+// BlendMode is the way that painted colors are combined with the colors that
+// are already on the page.
+type BlendMode int
+
+// Blend modes.  For their exact definitions, see Section 11.3.5 of
+// ISO 32000-1.
+const (
+	// NormalBlend paints over the backdrop.
+	NormalBlend BlendMode = iota
+	// MultiplyBlend multiplies the painted and backdrop colors, which
+	// darkens like overlapping inks.
+	MultiplyBlend
+	// ScreenBlend multiplies the complements of the colors, which lightens
+	// like overlapping projections.
+	ScreenBlend
+	// OverlayBlend multiplies or screens depending on the backdrop color.
+	OverlayBlend
+	// DarkenBlend keeps the darker of the colors.
+	DarkenBlend
+	// LightenBlend keeps the lighter of the colors.
+	LightenBlend
+	// ColorDodgeBlend brightens the backdrop to reflect the painted color.
+	ColorDodgeBlend
+	// ColorBurnBlend darkens the backdrop to reflect the painted color.
+	ColorBurnBlend
+	// HardLightBlend multiplies or screens depending on the painted color.
+	HardLightBlend
+	// SoftLightBlend darkens or lightens depending on the painted color.
+	SoftLightBlend
+	// DifferenceBlend subtracts the darker of the colors from the lighter.
+	DifferenceBlend
+	// ExclusionBlend is like DifferenceBlend with lower contrast.
+	ExclusionBlend
+	// HueBlend uses the hue of the painted color with the saturation and
+	// luminosity of the backdrop.
+	HueBlend
+	// SaturationBlend uses the saturation of the painted color with the hue
+	// and luminosity of the backdrop.
+	SaturationBlend
+	// ColorBlend uses the hue and saturation of the painted color with the
+	// luminosity of the backdrop.
+	ColorBlend
+	// LuminosityBlend uses the luminosity of the painted color with the hue
+	// and saturation of the backdrop.
+	LuminosityBlend
+)
+
+var blendModeNames = [...]name{
+	NormalBlend:     "Normal",
+	MultiplyBlend:   "Multiply",
+	ScreenBlend:     "Screen",
+	OverlayBlend:    "Overlay",
+	DarkenBlend:     "Darken",
+	LightenBlend:    "Lighten",
+	ColorDodgeBlend: "ColorDodge",
+	ColorBurnBlend:  "ColorBurn",
+	HardLightBlend:  "HardLight",
+	SoftLightBlend:  "SoftLight",
+	DifferenceBlend: "Difference",
+	ExclusionBlend:  "Exclusion",
+	HueBlend:        "Hue",
+	SaturationBlend: "Saturation",
+	ColorBlend:      "Color",
+	LuminosityBlend: "Luminosity",
+}
+
+// SetBlendMode changes how the shapes and text painted afterward are combined
+// with the page.  The default is NormalBlend, which is also used for values
+// that are not one of the blend modes above.
+func (canvas *Canvas) SetBlendMode(mode BlendMode) {
+	if mode < 0 || int(mode) >= len(blendModeNames) {
+		mode = NormalBlend
+	}
+	canvas.setExtGState(extGStateDict{BM: blendModeNames[mode]})
+}
+
+// setExtGState sets graphics state parameters that have no operators of their
+// own, using a graphics state parameter dictionary shared by the document.
+func (canvas *Canvas) setExtGState(dict extGStateDict) {
+	gs := canvas.doc.extGState(dict)
+	if canvas.resources.ExtGState == nil {
+		canvas.resources.ExtGState = make(map[name]interface{})
+	}
+	canvas.resources.ExtGState[gs.resName] = gs.ref
+	writeCommand(canvas.contents, "gs", gs.resName)
+}
+
 // SetColor changes the current fill color to the given RGB triple (in device
-// RGB space).
+// RGB space).  It is the same as SetFillColor(DeviceRGB{r, g, b}), so it does
+// nothing on a Type3 glyph canvas.
 func (canvas *Canvas) SetColor(r, g, b float32) {
-	writeCommand(canvas.contents, "rg", r, g, b)
+	canvas.SetFillColor(DeviceRGB{r, g, b})
 }
 
-// SetStrokeColor changes the current stroke color to the given RGB triple (in
-// device RGB space).
-func (canvas *Canvas) SetStrokeColor(r, g, b float32) {
-	writeCommand(canvas.contents, "RG", r, g, b)
+// errGlyphColor is returned by the color setters of a Type3 glyph canvas.
+var errGlyphColor = errors.New("pdf: Type3 glyphs cannot set colors")
+
+// SetFillColor changes the current fill color.  It returns an error, and
+// leaves the fill color unchanged, if c cannot be painted or the canvas draws
+// a Type3 glyph.
+func (canvas *Canvas) SetFillColor(c Color) error {
+	if canvas.page == nil {
+		return errGlyphColor
+	}
+	if err := c.check(); err != nil {
+		return err
+	}
+	canvas.resources.addColorSpace(c.colorSpace())
+	return c.writeFill(canvas.contents)
+}
+
+// SetStrokeColor changes the current stroke color.  It returns an error, and
+// leaves the stroke color unchanged, if c cannot be painted or the canvas
+// draws a Type3 glyph.
+func (canvas *Canvas) SetStrokeColor(c Color) error {
+	if canvas.page == nil {
+		return errGlyphColor
+	}
+	if err := c.check(); err != nil {
+		return err
+	}
+	canvas.resources.addColorSpace(c.colorSpace())
+	return c.writeStroke(canvas.contents)
 }
 
 // Push saves a copy of the current graphics state.  The state can later be
 // restored using Pop.
 func (canvas *Canvas) Push() {
 	writeCommand(canvas.contents, "q")
+	canvas.ctmStack = append(canvas.ctmStack, canvas.ctm)
 }
 
 // Pop restores the most recently saved graphics state by popping it from the
 // stack.
 func (canvas *Canvas) Pop() {
 	writeCommand(canvas.contents, "Q")
+	if n := len(canvas.ctmStack); n > 0 {
+		canvas.ctm = canvas.ctmStack[n-1]
+		canvas.ctmStack = canvas.ctmStack[:n-1]
+	}
 }
 
 // Translate moves the canvas's coordinates system by the given offset.
 func (canvas *Canvas) Translate(x, y Unit) {
 	writeCommand(canvas.contents, "cm", 1, 0, 0, 1, x, y)
+	canvas.concat(Matrix{1, 0, 0, 1, float32(x), float32(y)})
 }
 
 // Rotate rotates the canvas's coordinate system by a given angle (in radians).
 func (canvas *Canvas) Rotate(theta float32) {
 	s, c := math.Sin(float64(theta)), math.Cos(float64(theta))
 	writeCommand(canvas.contents, "cm", c, s, -s, c, 0, 0)
+	canvas.concat(Matrix{float32(c), float32(s), float32(-s), float32(c), 0, 0})
 }
 
 // Scale multiplies the canvas's coordinate system by the given scalars.
 func (canvas *Canvas) Scale(x, y float32) {
 	writeCommand(canvas.contents, "cm", x, 0, 0, y, 0, 0)
+	canvas.concat(Matrix{x, 0, 0, y, 0, 0})
 }
 
-// Transform concatenates a 3x3 matrix with the current transformation matrix.
-// The arguments map to values in the matrix as shown below:
-//
-//  / a b 0 \
-//  | c d 0 |
-//  \ e f 1 /
-//
-// For more information, see Section 8.3.4 of ISO 32000-1.
-func (canvas *Canvas) Transform(a, b, c, d, e, f float32) {
-	writeCommand(canvas.contents, "cm", a, b, c, d, e, f)
+// Transform concatenates a matrix with the current transformation matrix, so
+// that points drawn afterward are transformed by m and then by the previous
+// transformation.
+func (canvas *Canvas) Transform(m Matrix) {
+	writeCommand(canvas.contents, "cm", m[0], m[1], m[2], m[3], m[4], m[5])
+	canvas.concat(m)
+}
+
+// concat records that m was concatenated with the current transformation
+// matrix.
+func (canvas *Canvas) concat(m Matrix) {
+	canvas.ctm = m.Multiply(canvas.ctm)
+}
+
+// CurrentTransform returns the current transformation matrix, which maps the
+// canvas's coordinate system to the page's default coordinate system, where a
+// unit is 1/72 of an inch and the origin is the lower-left corner of the media
+// box.  Its inverse maps points on the page back to the canvas's coordinates.
+func (canvas *Canvas) CurrentTransform() Matrix {
+	return canvas.ctm
 }
 
 // DrawText paints a text object onto the canvas.
 func (canvas *Canvas) DrawText(text *Text) {
-	for fontName := range text.fonts {
-		if _, ok := canvas.page.Resources.Font[fontName]; !ok {
-			canvas.page.Resources.Font[fontName] = canvas.doc.standardFont(fontName)
+	for fontName, font := range text.faces {
+		if _, ok := canvas.resources.Font[fontName]; !ok {
+			canvas.resources.Font[fontName] = font.reference(canvas.doc)
 		}
 	}
+	for _, cs := range text.colorSpaces {
+		canvas.resources.addColorSpace(cs)
+	}
 	writeCommand(canvas.contents, "BT")
 	io.Copy(canvas.contents, &text.buf)
 	writeCommand(canvas.contents, "ET")
@@ -184,10 +479,10 @@ func (canvas *Canvas) DrawImage(img image.Image, rect Rectangle) {
 // given location and scaled to the given dimensions.
 func (canvas *Canvas) DrawImageReference(ref Reference, rect Rectangle) {
 	name := canvas.nextImageName()
-	canvas.page.Resources.XObject[name] = ref
+	canvas.resources.XObject[name] = ref
 
 	canvas.Push()
-	canvas.Transform(float32(rect.Dx()), 0, 0, float32(rect.Dy()), float32(rect.Min.X), float32(rect.Min.Y))
+	canvas.Transform(Matrix{float32(rect.Dx()), 0, 0, float32(rect.Dy()), float32(rect.Min.X), float32(rect.Min.Y)})
 	writeCommand(canvas.contents, "Do", name)
 	canvas.Pop()
 }
@@ -208,41 +503,9 @@ func (canvas *Canvas) nextImageName() name {
 	for {
 		n = name(fmt.Sprintf(anonymousImageFormat, canvas.imageCounter))
 		canvas.imageCounter++
-		if _, ok := canvas.page.Resources.XObject[n]; !ok {
+		if _, ok := canvas.resources.XObject[n]; !ok {
 			break
 		}
 	}
 	return n
 }
-
-// Path is a shape that can be painted on a canvas.  The zero value is an empty
-// path.
-type Path struct {
-	buf bytes.Buffer
-}
-
-// Move begins a new subpath by moving the current point to the given location.
-func (path *Path) Move(pt Point) {
-	writeCommand(&path.buf, "m", pt.X, pt.Y)
-}
-
-// Line appends a line segment from the current point to the given location.
-func (path *Path) Line(pt Point) {
-	writeCommand(&path.buf, "l", pt.X, pt.Y)
-}
-
-// Curve appends a cubic Bezier curve to the path.
-func (path *Path) Curve(pt1, pt2, pt3 Point) {
-	writeCommand(&path.buf, "c", pt1.X, pt1.Y, pt2.X, pt2.Y, pt3.X, pt3.Y)
-}
-
-// Rectangle appends a complete rectangle to the path.
-func (path *Path) Rectangle(rect Rectangle) {
-	writeCommand(&path.buf, "re", rect.Min.X, rect.Min.Y, rect.Dx(), rect.Dy())
-}
-
-// Close appends a line segment from the current point to the starting point of
-// the subpath.
-func (path *Path) Close() {
-	writeCommand(&path.buf, "h")
-}
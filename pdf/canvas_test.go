@@ -3,23 +3,132 @@
 package pdf
 
 import (
+	"bytes"
+	"io/ioutil"
+	"strings"
 	"testing"
 )
 
-const pathExpectedOutput = `12.00000 34.00000 m
--56.00000 78.00000 l
-h
-3.10000 -5.90000 21.10000 80.90000 re
+// canvasOutput closes a canvas and returns its content stream.
+func canvasOutput(t *testing.T, canvas *Canvas) string {
+	if err := canvas.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	data, err := ioutil.ReadAll(flateReader(t, canvas.contents.Bytes()))
+	if err != nil {
+		t.Fatalf("reading contents: %v", err)
+	}
+	return string(data)
+}
+
+const lineStyleExpectedOutput = `1 J
+2 j
+4.00000 M
+0.50000 i
 `
 
-func TestPath(t *testing.T) {
-	path := new(Path)
-	path.Move(Point{12, 34})
-	path.Line(Point{-56, 78})
-	path.Close()
-	path.Rectangle(Rectangle{Point{3.1, -5.9}, Point{24.2, 75.0}})
+func TestLineStyle(t *testing.T) {
+	canvas := New().NewPage(USLetterWidth, USLetterHeight)
+	canvas.SetLineCap(RoundCap)
+	canvas.SetLineJoin(BevelJoin)
+	canvas.SetMiterLimit(4)
+	canvas.SetFlatness(0.5)
+	if out := canvasOutput(t, canvas); out != lineStyleExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, lineStyleExpectedOutput)
+	}
+}
 
-	if path.buf.String() != pathExpectedOutput {
-		t.Errorf("Output was %q, expected %q", path.buf.String(), pathExpectedOutput)
+const paintExpectedOutput = `0.00000 0.00000 10.00000 10.00000 re
+W*
+n
+0.00000 0.00000 10.00000 10.00000 re
+f*
+0.00000 0.00000 10.00000 10.00000 re
+B*
+0.00000 0.00000 10.00000 10.00000 re
+b
+`
+
+func TestPaintPath(t *testing.T) {
+	canvas := New().NewPage(USLetterWidth, USLetterHeight)
+	rect := func() *Path {
+		path := new(Path)
+		path.Rectangle(Rectangle{Point{0, 0}, Point{10, 10}})
+		return path
+	}
+	canvas.ClipEvenOdd(rect())
+	canvas.FillEvenOdd(rect())
+	canvas.FillStrokeEvenOdd(rect())
+	canvas.CloseFillStroke(rect())
+	if out := canvasOutput(t, canvas); out != paintExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, paintExpectedOutput)
+	}
+}
+
+func TestCurrentTransform(t *testing.T) {
+	canvas := New().NewPage(USLetterWidth, USLetterHeight)
+	if m := canvas.CurrentTransform(); m != IdentityMatrix {
+		t.Errorf("new canvas has transform %v", m)
+	}
+	canvas.Translate(10, 20)
+	canvas.Push()
+	canvas.Scale(2, 2)
+	canvas.Transform(Matrix{1, 0, 0, 1, 1, 1})
+	if p := canvas.CurrentTransform().Apply(Point{1, 1}); p != (Point{14, 24}) {
+		t.Errorf("(1, 1) maps to %v; want (14, 24)", p)
+	}
+	canvas.Pop()
+	if m := canvas.CurrentTransform(); m != (Matrix{1, 0, 0, 1, 10, 20}) {
+		t.Errorf("after Pop, transform is %v", m)
+	}
+}
+
+const transparencyExpectedOutput = `/__gs0__ gs
+/__gs1__ gs
+/__gs2__ gs
+/__gs0__ gs
+`
+
+func TestTransparency(t *testing.T) {
+	doc := New()
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	canvas.SetAlpha(0.5)
+	canvas.SetStrokeAlpha(0.5)
+	canvas.SetBlendMode(MultiplyBlend)
+	canvas.SetAlpha(0.5)
+	if out := canvasOutput(t, canvas); out != transparencyExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, transparencyExpectedOutput)
+	}
+	if n := len(canvas.resources.ExtGState); n != 3 {
+		t.Errorf("len(ExtGState) = %d; want 3", n)
+	}
+
+	other := doc.NewPage(USLetterWidth, USLetterHeight)
+	other.SetAlpha(0)
+	other.SetBlendMode(MultiplyBlend)
+	other.SetStrokeAlpha(1.5)
+	other.SetAlpha(-1)
+	other.SetBlendMode(BlendMode(-1))
+	other.SetBlendMode(LuminosityBlend + 1)
+	const otherOutput = "/__gs3__ gs\n/__gs2__ gs\n/__gs4__ gs\n/__gs3__ gs\n/__gs5__ gs\n/__gs5__ gs\n"
+	if out := canvasOutput(t, other); out != otherOutput {
+		t.Errorf("Output was %q, expected %q", out, otherOutput)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	for _, want := range []string{
+		"<< /Type /ExtGState /ca 0.50000 >>",
+		"<< /Type /ExtGState /CA 0.50000 >>",
+		"<< /Type /ExtGState /BM /Multiply >>",
+		"<< /Type /ExtGState /ca 0.00000 >>",
+		"<< /Type /ExtGState /CA 1.00000 >>",
+		"<< /Type /ExtGState /BM /Normal >>",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("document does not contain %q", want)
+		}
 	}
 }
@@ -0,0 +1,533 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+var errCFFMalformed = errors.New("pdf: malformed CFF font")
+
+// cffIndex is a parsed CFF INDEX structure: an array of variable-sized
+// objects.
+type cffIndex [][]byte
+
+// parseCFFIndex parses the INDEX at the beginning of b and returns it along
+// with the number of bytes it occupies.
+func parseCFFIndex(b []byte) (cffIndex, int, error) {
+	if len(b) < 2 {
+		return nil, 0, errCFFMalformed
+	}
+	count := int(u16(b, 0))
+	if count == 0 {
+		return cffIndex{}, 2, nil
+	}
+	if len(b) < 3 {
+		return nil, 0, errCFFMalformed
+	}
+	offSize := int(b[2])
+	if offSize < 1 || offSize > 4 || len(b) < 3+(count+1)*offSize {
+		return nil, 0, errCFFMalformed
+	}
+	readOffset := func(i int) int {
+		var off int
+		for _, c := range b[3+i*offSize : 3+(i+1)*offSize] {
+			off = off<<8 | int(c)
+		}
+		return off
+	}
+	dataStart := 3 + (count+1)*offSize - 1
+	idx := make(cffIndex, count)
+	for i := range idx {
+		start, end := readOffset(i), readOffset(i+1)
+		if start < 1 || start > end || dataStart+end > len(b) {
+			return nil, 0, errCFFMalformed
+		}
+		idx[i] = b[dataStart+start : dataStart+end]
+	}
+	return idx, dataStart + readOffset(count), nil
+}
+
+// cffDict maps a DICT operator to its operands.  Two-byte operators are
+// stored as 1200 plus the second byte.
+type cffDict map[int][]float64
+
+// cffDictEntry is a single operator in a DICT.
+type cffDictEntry struct {
+	op       int
+	operands []float64
+	raw      []byte // encoded operands
+}
+
+// CFF DICT operators
+const (
+	cffCharset     = 15
+	cffEncoding    = 16
+	cffCharStrings = 17
+	cffPrivate     = 18
+	cffROS         = 1230
+	cffFDArray     = 1236
+	cffFDSelect    = 1237
+)
+
+// parseCFFDict parses DICT data.
+func parseCFFDict(b []byte) (cffDict, error) {
+	entries, err := parseCFFDictEntries(b)
+	if err != nil {
+		return nil, err
+	}
+	d := make(cffDict, len(entries))
+	for _, e := range entries {
+		d[e.op] = e.operands
+	}
+	return d, nil
+}
+
+// parseCFFDictEntries parses DICT data, preserving the order and encoding of
+// its entries.
+func parseCFFDictEntries(b []byte) ([]cffDictEntry, error) {
+	var entries []cffDictEntry
+	var operands []float64
+	start := 0
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c <= 21:
+			e := cffDictEntry{op: int(c), operands: operands, raw: b[start:i]}
+			i++
+			if c == 12 {
+				if i >= len(b) {
+					return nil, errCFFMalformed
+				}
+				e.op = 1200 + int(b[i])
+				i++
+			}
+			entries = append(entries, e)
+			operands, start = nil, i
+		case c == 28:
+			if i+3 > len(b) {
+				return nil, errCFFMalformed
+			}
+			operands = append(operands, float64(i16(b, i+1)))
+			i += 3
+		case c == 29:
+			if i+5 > len(b) {
+				return nil, errCFFMalformed
+			}
+			v := int32(b[i+1])<<24 | int32(b[i+2])<<16 | int32(b[i+3])<<8 | int32(b[i+4])
+			operands = append(operands, float64(v))
+			i += 5
+		case c == 30:
+			v, n, err := parseCFFReal(b[i+1:])
+			if err != nil {
+				return nil, err
+			}
+			operands = append(operands, v)
+			i += 1 + n
+		case c >= 32 && c <= 246:
+			operands = append(operands, float64(int(c)-139))
+			i++
+		case c >= 247 && c <= 250:
+			if i+2 > len(b) {
+				return nil, errCFFMalformed
+			}
+			operands = append(operands, float64((int(c)-247)*256+int(b[i+1])+108))
+			i += 2
+		case c >= 251 && c <= 254:
+			if i+2 > len(b) {
+				return nil, errCFFMalformed
+			}
+			operands = append(operands, float64(-(int(c)-251)*256-int(b[i+1])-108))
+			i += 2
+		default:
+			return nil, errCFFMalformed
+		}
+	}
+	return entries, nil
+}
+
+// appendCFFDictEntry encodes a DICT entry.  If ints is not nil, the operands
+// are replaced by the given integers, which are always encoded in five bytes
+// so that the size of the DICT does not depend on their values.
+func appendCFFDictEntry(dst []byte, e cffDictEntry, ints []int) []byte {
+	if ints == nil {
+		dst = append(dst, e.raw...)
+	}
+	for _, v := range ints {
+		dst = append(dst, 29, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	if e.op >= 1200 {
+		return append(dst, 12, byte(e.op-1200))
+	}
+	return append(dst, byte(e.op))
+}
+
+// encodeCFFIndex returns the encoding of an INDEX structure.
+func encodeCFFIndex(idx cffIndex) []byte {
+	if len(idx) == 0 {
+		return []byte{0, 0}
+	}
+	size := 1
+	for _, obj := range idx {
+		size += len(obj)
+	}
+	offSize := 1
+	for max := 0xff; size > max; max = max<<8 | 0xff {
+		offSize++
+	}
+	b := make([]byte, 3, 3+(len(idx)+1)*offSize+size-1)
+	binary.BigEndian.PutUint16(b, uint16(len(idx)))
+	b[2] = byte(offSize)
+	off := 1
+	for i := 0; i <= len(idx); i++ {
+		for j := offSize - 1; j >= 0; j-- {
+			b = append(b, byte(off>>(8*uint(j))))
+		}
+		if i < len(idx) {
+			off += len(idx[i])
+		}
+	}
+	for _, obj := range idx {
+		b = append(b, obj...)
+	}
+	return b
+}
+
+// parseCFFReal parses the nibbles of a real number operand.  It returns the
+// number of bytes consumed.
+func parseCFFReal(b []byte) (float64, int, error) {
+	var mant, frac float64
+	var exp int
+	neg, negExp, inFrac, inExp := false, false, false, false
+	scale := 1.0
+	for i, c := range b {
+		for _, nib := range [2]byte{c >> 4, c & 0xf} {
+			switch {
+			case nib <= 9:
+				switch {
+				case inExp:
+					exp = exp*10 + int(nib)
+				case inFrac:
+					scale /= 10
+					frac += float64(nib) * scale
+				default:
+					mant = mant*10 + float64(nib)
+				}
+			case nib == 0xa:
+				inFrac = true
+			case nib == 0xb:
+				inExp = true
+			case nib == 0xc:
+				inExp, negExp = true, true
+			case nib == 0xe:
+				neg = true
+			case nib == 0xf:
+				v := mant + frac
+				for ; exp > 0; exp-- {
+					if negExp {
+						v /= 10
+					} else {
+						v *= 10
+					}
+				}
+				if neg {
+					v = -v
+				}
+				return v, i + 1, nil
+			}
+		}
+	}
+	return 0, 0, errCFFMalformed
+}
+
+// parseCFFTopDict returns the Top DICT of the first font in a CFF font
+// program.
+func parseCFFTopDict(data []byte) (cffDict, error) {
+	if len(data) < 4 {
+		return nil, errCFFMalformed
+	}
+	off := int(data[2])
+	if off > len(data) {
+		return nil, errCFFMalformed
+	}
+	_, n, err := parseCFFIndex(data[off:])
+	if err != nil {
+		return nil, err
+	}
+	off += n
+	topDicts, _, err := parseCFFIndex(data[off:])
+	if err != nil {
+		return nil, err
+	}
+	if len(topDicts) == 0 {
+		return nil, errCFFMalformed
+	}
+	return parseCFFDict(topDicts[0])
+}
+
+// isCIDKeyed reports whether a Top DICT belongs to a CID-keyed font.
+func (top cffDict) isCIDKeyed() bool {
+	_, ok := top[cffROS]
+	return ok
+}
+
+// cffCharsetCIDs reads the charset of a CID-keyed font and returns the CID of
+// each glyph.
+func cffCharsetCIDs(data []byte, top cffDict, numGlyphs int) ([]uint16, error) {
+	operands := top[cffCharset]
+	if len(operands) != 1 || int(operands[0]) <= 2 || int(operands[0]) >= len(data) {
+		return nil, errCFFMalformed
+	}
+	b := data[int(operands[0]):]
+	cids := make([]uint16, 1, numGlyphs)
+	switch b[0] {
+	case 0:
+		if len(b) < 1+2*(numGlyphs-1) {
+			return nil, errCFFMalformed
+		}
+		for i := 1; i < numGlyphs; i++ {
+			cids = append(cids, u16(b, 1+2*(i-1)))
+		}
+	case 1, 2:
+		size := 3
+		if b[0] == 2 {
+			size = 4
+		}
+		for off := 1; len(cids) < numGlyphs; off += size {
+			if off+size > len(b) {
+				return nil, errCFFMalformed
+			}
+			first, nLeft := int(u16(b, off)), int(b[off+2])
+			if size == 4 {
+				nLeft = int(u16(b, off+2))
+			}
+			for i := 0; i <= nLeft && len(cids) < numGlyphs; i++ {
+				cids = append(cids, uint16(first+i))
+			}
+		}
+	default:
+		return nil, errCFFMalformed
+	}
+	return cids, nil
+}
+
+// cffEndchar is the Type 2 charstring operator that ends a glyph.
+const cffEndchar = 14
+
+// cffRange is a range of bytes in a CFF font program.
+type cffRange struct {
+	start, end int
+}
+
+type cffRangeSlice []cffRange
+
+func (p cffRangeSlice) Len() int           { return len(p) }
+func (p cffRangeSlice) Less(i, j int) bool { return p[i].start < p[j].start }
+func (p cffRangeSlice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// subsetCFF returns a copy of a CFF font program in which the charstrings of
+// glyphs not in the set are replaced with empty glyphs.  Glyph indices are
+// preserved, so the charset and any FDSelect remain valid.
+//
+// The structures that follow the fixed-position INDEXes are copied as-is,
+// except for the CharStrings and FDArray INDEXes, which are rebuilt and
+// moved to the end of the font program.  Offsets that point into the copied
+// data are relocated accordingly.
+func subsetCFF(data []byte, glyphs glyphSet) ([]byte, error) {
+	if len(data) < 4 || int(data[2]) > len(data) {
+		return nil, errCFFMalformed
+	}
+	hdrSize := int(data[2])
+	_, n, err := parseCFFIndex(data[hdrSize:])
+	if err != nil {
+		return nil, err
+	}
+	topStart := hdrSize + n
+	topDicts, n, err := parseCFFIndex(data[topStart:])
+	if err != nil {
+		return nil, err
+	}
+	if len(topDicts) != 1 {
+		return nil, errors.New("pdf: CFF font sets are not supported")
+	}
+	stringsStart := topStart + n
+	_, n, err = parseCFFIndex(data[stringsStart:])
+	if err != nil {
+		return nil, err
+	}
+	_, m, err := parseCFFIndex(data[stringsStart+n:])
+	if err != nil {
+		return nil, err
+	}
+	tailStart := stringsStart + n + m
+
+	entries, err := parseCFFDictEntries(topDicts[0])
+	if err != nil {
+		return nil, err
+	}
+	top := make(cffDict, len(entries))
+	for _, e := range entries {
+		top[e.op] = e.operands
+	}
+
+	// Locate the INDEXes that are rebuilt.
+	indexAt := func(op int) (cffIndex, cffRange, error) {
+		operands := top[op]
+		if len(operands) != 1 || int(operands[0]) < tailStart || int(operands[0]) >= len(data) {
+			return nil, cffRange{}, errCFFMalformed
+		}
+		off := int(operands[0])
+		idx, n, err := parseCFFIndex(data[off:])
+		return idx, cffRange{off, off + n}, err
+	}
+	charStrings, csRange, err := indexAt(cffCharStrings)
+	if err != nil {
+		return nil, err
+	}
+	removed := []cffRange{csRange}
+	var fdArray cffIndex
+	if _, ok := top[cffFDArray]; ok {
+		var fdRange cffRange
+		fdArray, fdRange, err = indexAt(cffFDArray)
+		if err != nil {
+			return nil, err
+		}
+		removed = append(removed, fdRange)
+	}
+	sort.Sort(cffRangeSlice(removed))
+
+	newCharStrings := make(cffIndex, len(charStrings))
+	for i, cs := range charStrings {
+		if glyphs[uint16(i)] {
+			newCharStrings[i] = cs
+		} else {
+			newCharStrings[i] = []byte{cffEndchar}
+		}
+	}
+	csData := encodeCFFIndex(newCharStrings)
+
+	var tail []byte
+	prev := tailStart
+	for _, r := range removed {
+		if r.start < prev {
+			return nil, errCFFMalformed
+		}
+		tail = append(tail, data[prev:r.start]...)
+		prev = r.end
+	}
+	tail = append(tail, data[prev:]...)
+
+	// All offsets are written in fixed-size integers, so the Top DICT size
+	// can be computed before the offsets are known.
+	encodeTop := func(relocate func(int) (int, error), csOff, fdOff int) ([]byte, error) {
+		var dict []byte
+		for _, e := range entries {
+			var ints []int
+			switch e.op {
+			case cffCharset, cffEncoding:
+				// Small values select predefined charsets and encodings.
+				if len(e.operands) == 1 && e.operands[0] > 2 {
+					off, err := relocate(int(e.operands[0]))
+					if err != nil {
+						return nil, err
+					}
+					ints = []int{off}
+				}
+			case cffCharStrings:
+				ints = []int{csOff}
+			case cffFDArray:
+				ints = []int{fdOff}
+			case cffFDSelect:
+				if len(e.operands) != 1 {
+					return nil, errCFFMalformed
+				}
+				off, err := relocate(int(e.operands[0]))
+				if err != nil {
+					return nil, err
+				}
+				ints = []int{off}
+			case cffPrivate:
+				ints, err = relocatePrivate(e.operands, relocate)
+				if err != nil {
+					return nil, err
+				}
+			}
+			dict = appendCFFDictEntry(dict, e, ints)
+		}
+		return dict, nil
+	}
+	zero := func(int) (int, error) { return 0, nil }
+	dummyTop, err := encodeTop(zero, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	newTailStart := topStart + len(encodeCFFIndex(cffIndex{dummyTop})) + tailStart - stringsStart
+	relocate := func(off int) (int, error) {
+		if off < tailStart || off > len(data) {
+			return 0, errCFFMalformed
+		}
+		shift := 0
+		for _, r := range removed {
+			if off >= r.end {
+				shift += r.end - r.start
+			} else if off >= r.start {
+				return 0, errCFFMalformed
+			}
+		}
+		return off - tailStart + newTailStart - shift, nil
+	}
+	csOff := newTailStart + len(tail)
+	fdOff := csOff + len(csData)
+	newTop, err := encodeTop(relocate, csOff, fdOff)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, fdOff)
+	out = append(out, data[:topStart]...)
+	out = append(out, encodeCFFIndex(cffIndex{newTop})...)
+	out = append(out, data[stringsStart:tailStart]...)
+	out = append(out, tail...)
+	out = append(out, csData...)
+	if fdArray != nil {
+		newFDArray := make(cffIndex, len(fdArray))
+		for i, fd := range fdArray {
+			fdEntries, err := parseCFFDictEntries(fd)
+			if err != nil {
+				return nil, err
+			}
+			var dict []byte
+			for _, e := range fdEntries {
+				var ints []int
+				if e.op == cffPrivate {
+					if ints, err = relocatePrivate(e.operands, relocate); err != nil {
+						return nil, err
+					}
+				}
+				dict = appendCFFDictEntry(dict, e, ints)
+			}
+			newFDArray[i] = dict
+		}
+		out = append(out, encodeCFFIndex(newFDArray)...)
+	}
+	return out, nil
+}
+
+// relocatePrivate returns the operands of a Private DICT operator (size and
+// offset) with the offset relocated.
+func relocatePrivate(operands []float64, relocate func(int) (int, error)) ([]int, error) {
+	if len(operands) != 2 {
+		return nil, errCFFMalformed
+	}
+	size := int(operands[0])
+	if size == 0 {
+		return []int{0, 0}, nil
+	}
+	off, err := relocate(int(operands[1]))
+	if err != nil {
+		return nil, err
+	}
+	return []int{size, off}, nil
+}
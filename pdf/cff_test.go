@@ -0,0 +1,129 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// buildTestCFF returns a minimal CFF font program with the given charstrings.
+// The Private DICT is placed before the CharStrings INDEX.  Each of extra is
+// an operator followed by its operands, which is added to the Top DICT.
+func buildTestCFF(charStrings cffIndex, extra ...[]int) []byte {
+	private := []byte{139 + 50, 20} // 50 defaultWidthX
+	fixed := func(ops ...[]int) []byte {
+		var d []byte
+		for _, op := range ops {
+			d = appendCFFDictEntry(d, cffDictEntry{op: op[0]}, op[1:])
+		}
+		return d
+	}
+
+	header := []byte{1, 0, 4, 1}
+	names := encodeCFFIndex(cffIndex{[]byte("Test")})
+	strs := encodeCFFIndex(nil)
+	gsubrs := encodeCFFIndex(nil)
+	topLen := len(encodeCFFIndex(cffIndex{append(fixed([]int{cffPrivate, 0, 0}, []int{cffCharStrings, 0}), fixed(extra...)...)}))
+	privOff := len(header) + len(names) + topLen + len(strs) + len(gsubrs)
+	csOff := privOff + len(private)
+	top := fixed([]int{cffPrivate, len(private), privOff}, []int{cffCharStrings, csOff})
+	top = append(top, fixed(extra...)...)
+
+	var b []byte
+	b = append(b, header...)
+	b = append(b, names...)
+	b = append(b, encodeCFFIndex(cffIndex{top})...)
+	b = append(b, strs...)
+	b = append(b, gsubrs...)
+	b = append(b, private...)
+	b = append(b, encodeCFFIndex(charStrings)...)
+	return b
+}
+
+func TestCFFIndexRoundTrip(t *testing.T) {
+	tests := []cffIndex{
+		{},
+		{[]byte("a")},
+		{[]byte("foo"), []byte(""), []byte("bar")},
+		{make([]byte, 300), []byte("x")},
+	}
+	for i, idx := range tests {
+		data := encodeCFFIndex(idx)
+		result, n, err := parseCFFIndex(data)
+		if err != nil {
+			t.Errorf("%d. parseCFFIndex error: %v", i, err)
+			continue
+		}
+		if n != len(data) {
+			t.Errorf("%d. parseCFFIndex read %d bytes; want %d", i, n, len(data))
+		}
+		if len(result) != len(idx) {
+			t.Errorf("%d. len = %d; want %d", i, len(result), len(idx))
+			continue
+		}
+		for j := range idx {
+			if !bytes.Equal(result[j], idx[j]) {
+				t.Errorf("%d. object %d = %q; want %q", i, j, result[j], idx[j])
+			}
+		}
+	}
+}
+
+func TestSubsetCFF(t *testing.T) {
+	charStrings := cffIndex{
+		{cffEndchar},
+		{139, 139, 21, 139 + 100, 6, cffEndchar},
+		{139, 139, 21, 139 + 50, 7, cffEndchar},
+	}
+	data := buildTestCFF(charStrings)
+
+	subset, err := subsetCFF(data, glyphSet{0: true, 2: true})
+	if err != nil {
+		t.Fatalf("subsetCFF error: %v", err)
+	}
+	_, n, _ := parseCFFIndex(subset[4:])
+	topDicts, _, err := parseCFFIndex(subset[4+n:])
+	if err != nil || len(topDicts) != 1 {
+		t.Fatalf("subset Top DICT INDEX: %v", err)
+	}
+	top, err := parseCFFDict(topDicts[0])
+	if err != nil {
+		t.Fatalf("subset Top DICT: %v", err)
+	}
+
+	result, _, err := parseCFFIndex(subset[int(top[cffCharStrings][0]):])
+	if err != nil {
+		t.Fatalf("subset CharStrings: %v", err)
+	}
+	want := cffIndex{charStrings[0], {cffEndchar}, charStrings[2]}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("subset CharStrings = %v; want %v", result, want)
+	}
+
+	priv := top[cffPrivate]
+	if len(priv) != 2 {
+		t.Fatalf("subset Private operands = %v", priv)
+	}
+	size, off := int(priv[0]), int(priv[1])
+	if got := subset[off : off+size]; !bytes.Equal(got, []byte{139 + 50, 20}) {
+		t.Errorf("subset Private DICT = %v; want %v", got, []byte{139 + 50, 20})
+	}
+}
+
+func TestSubsetCFFMalformed(t *testing.T) {
+	charStrings := cffIndex{{cffEndchar}, {cffEndchar}}
+	tests := []struct {
+		Name string
+		Data []byte
+	}{
+		{"truncated", buildTestCFF(charStrings)[:3]},
+		{"FDSelect without operands", buildTestCFF(charStrings, []int{cffFDSelect})},
+	}
+	for _, test := range tests {
+		if _, err := subsetCFF(test.Data, glyphSet{0: true}); err != errCFFMalformed {
+			t.Errorf("subsetCFF(%s) error = %v; want %v", test.Name, err, errCFFMalformed)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"io"
+	"io/ioutil"
+	"sort"
+)
+
+// AddUnicodeFont embeds a TrueType or OpenType font program in the document as
+// a composite font.  The returned font can be used by text objects drawn on any
+// of the document's canvases.
+//
+// Unlike fonts added with AddFont, text shown in a Unicode font is encoded as
+// glyph identifiers, so every character that the font has a glyph for can be
+// displayed.  The font includes a ToUnicode CMap so that text can still be
+// extracted from the document.  When the document is encoded, the font program
+// is reduced to the glyphs used by text objects.
+//
+// Text shown in the font is shaped using the font's GSUB and GPOS tables, so
+// ligatures, contextual forms and mark positioning are applied for the scripts
+// that the font supports.  Ligatures are mapped back to the characters they
+// replaced in the ToUnicode CMap.
+func (doc *Document) AddUnicodeFont(r io.Reader) (Font, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	f, err := parseSFNT(data)
+	if err != nil {
+		return nil, err
+	}
+
+	font := &compositeFont{
+		doc:       doc,
+		sfnt:      f,
+		resName:   doc.nextFontName(),
+		used:      make(map[uint16]bool),
+		glyphText: make(map[uint16]string),
+	}
+	cidFont := &cidFontDict{
+		Type:     fontType,
+		Subtype:  fontCIDFontType2Subtype,
+		BaseFont: name(f.postscriptName),
+		CIDSystemInfo: cidSystemInfo{
+			Registry:   "Adobe",
+			Ordering:   "Identity",
+			Supplement: 0,
+		},
+		CIDToGIDMap: identityName,
+	}
+	dict := &type0FontDict{
+		Type:     fontType,
+		Subtype:  fontType0Subtype,
+		BaseFont: cidFont.BaseFont,
+		Encoding: identityHEncoding,
+	}
+	if f.isCFF() {
+		cidFont.Subtype = fontCIDFontType0Subtype
+		cidFont.CIDToGIDMap = ""
+		top, err := parseCFFTopDict(f.tables["CFF "])
+		if err != nil {
+			return nil, err
+		}
+		if top.isCIDKeyed() {
+			if font.cids, err = cffCharsetCIDs(f.tables["CFF "], top, f.numGlyphs); err != nil {
+				return nil, err
+			}
+			font.gids = make(map[uint16]uint16, len(font.cids))
+			for g, cid := range font.cids {
+				font.gids[cid] = uint16(g)
+			}
+		}
+	}
+
+	fd := newFontDescriptor(f, cidFont.BaseFont)
+	// Glyphs are selected by identifier, so the font is always symbolic.
+	fd.Flags = fd.Flags&^fontFlagNonsymbolic | fontFlagSymbolic
+	font.file = doc.addFontProgram(fd, f)
+	cidFont.FontDescriptor = doc.add(fd)
+	font.toUnicode = new(cmapStream)
+	dict.ToUnicode = doc.add(font.toUnicode)
+	dict.DescendantFonts = []Reference{doc.add(cidFont)}
+	font.dict, font.cidFont, font.descriptor = dict, cidFont, fd
+	font.ref = doc.add(dict)
+	doc.embeddedFonts = append(doc.embeddedFonts, font)
+	return font, nil
+}
+
+type type0FontDict struct {
+	Type            name
+	Subtype         name
+	BaseFont        name
+	Encoding        name
+	DescendantFonts []Reference
+	ToUnicode       Reference
+}
+
+type cidFontDict struct {
+	Type           name
+	Subtype        name
+	BaseFont       name
+	CIDSystemInfo  cidSystemInfo
+	FontDescriptor Reference
+	W              []interface{} `pdf:",omitempty"`
+	DW2            []int         `pdf:",omitempty"`
+	W2             []interface{} `pdf:",omitempty"`
+	CIDToGIDMap    name          `pdf:",omitempty"`
+}
+
+type cidSystemInfo struct {
+	Registry   string
+	Ordering   string
+	Supplement int
+}
+
+// compositeFont is an embedded font that uses two-byte character codes.  For
+// TrueType outlines and CFF outlines that are not CID-keyed, the character
+// codes are glyph indices.  For CID-keyed CFF outlines, the character codes are
+// the CIDs of the glyphs.
+type compositeFont struct {
+	doc     *Document
+	sfnt    *sfnt
+	resName name
+	ref     Reference
+	used    map[uint16]bool
+
+	// glyphText holds the text shown by glyphs that text was shaped to,
+	// for glyphs like ligatures that the font's cmap does not map to.
+	glyphText map[uint16]string
+
+	// cids maps glyph indices to CIDs and gids maps CIDs to glyph indices.
+	// Both are nil unless the font has CID-keyed CFF outlines.
+	cids []uint16
+	gids map[uint16]uint16
+
+	dict       *type0FontDict
+	cidFont    *cidFontDict
+	descriptor *fontDescriptor
+	file       *fontFileStream
+	toUnicode  *cmapStream
+
+	// vertical is the font's vertical version, or nil if the font has not
+	// been written vertically.
+	vertical *verticalFont
+}
+
+func (font *compositeFont) Name() string {
+	return font.sfnt.postscriptName
+}
+
+func (font *compositeFont) Metrics() FontMetrics {
+	return font.sfnt.metrics()
+}
+
+func (font *compositeFont) resourceName() name {
+	return font.resName
+}
+
+func (font *compositeFont) reference(doc *Document) Reference {
+	return font.ref
+}
+
+// cid returns the CID of a glyph.
+func (font *compositeFont) cid(g uint16) uint16 {
+	if font.cids == nil {
+		return g
+	}
+	if int(g) >= len(font.cids) {
+		return 0
+	}
+	return font.cids[g]
+}
+
+// glyphIndex returns the glyph shown for a CID.
+func (font *compositeFont) glyphIndex(cid uint16) uint16 {
+	if font.gids == nil {
+		return cid
+	}
+	return font.gids[cid]
+}
+
+func (font *compositeFont) encode(s string) string {
+	b := make([]byte, 0, 2*len(s))
+	for _, r := range s {
+		cid := font.cid(font.sfnt.glyphIndex(r))
+		b = append(b, byte(cid>>8), byte(cid))
+	}
+	return string(b)
+}
+
+func (font *compositeFont) hasGlyph(r rune) bool {
+	return font.sfnt.glyphIndex(r) != 0
+}
+
+func (font *compositeFont) stringWidth(codes string, size Unit) Unit {
+	width := 0
+	for i := 0; i+1 < len(codes); i += 2 {
+		cid := uint16(codes[i])<<8 | uint16(codes[i+1])
+		width += font.sfnt.advance(font.glyphIndex(cid))
+	}
+	return Unit(width) * size / 1000
+}
+
+// spaceAdjustments returns adjustments of the given amount after each space
+// in a string of character codes.
+func (font *compositeFont) spaceAdjustments(codes string, amount int) []kernAdjustment {
+	space := font.cid(font.sfnt.glyphIndex(' '))
+	if space == 0 {
+		return nil
+	}
+	var adjust []kernAdjustment
+	for i := 0; i+1 < len(codes); i += 2 {
+		if uint16(codes[i])<<8|uint16(codes[i+1]) == space {
+			adjust = append(adjust, kernAdjustment{i + 2, amount})
+		}
+	}
+	return adjust
+}
+
+func (font *compositeFont) useCodes(codes string) {
+	for i := 0; i+1 < len(codes); i += 2 {
+		font.used[uint16(codes[i])<<8|uint16(codes[i+1])] = true
+	}
+}
+
+func (font *compositeFont) writeFontProgram() error {
+	glyphs := make(glyphSet)
+	for cid := range font.used {
+		glyphs[font.glyphIndex(cid)] = true
+	}
+
+	// Use the smallest code point that maps to each glyph as its text.
+	cmap := make(map[rune]uint16)
+	text := make(map[int]string)
+	for r, g := range font.sfnt.cmap {
+		if !glyphs[g] || g == 0 {
+			continue
+		}
+		cmap[r] = g
+		cid := int(font.cid(g))
+		if prev, ok := text[cid]; !ok || r < []rune(prev)[0] {
+			text[cid] = string(r)
+		}
+	}
+	// Glyphs that text was shaped to, like ligatures, map to the text that
+	// they replaced.
+	for cid, s := range font.glyphText {
+		if _, ok := text[int(cid)]; !ok && font.used[cid] {
+			text[int(cid)] = s
+		}
+	}
+
+	tag, err := writeFontFile(font.file, font.sfnt, glyphs, cmap, cmapEncodingUnicode, true)
+	if err != nil {
+		return err
+	}
+	font.cidFont.BaseFont = name(tag + "+" + font.sfnt.postscriptName)
+	font.descriptor.FontName = font.cidFont.BaseFont
+	font.dict.BaseFont = font.cidFont.BaseFont
+	if font.sfnt.isCFF() {
+		font.dict.BaseFont += "-" + identityHEncoding
+	}
+	font.cidFont.W = font.widthArray()
+	if vf := font.vertical; vf != nil {
+		vf.dict.BaseFont = font.cidFont.BaseFont
+		if font.sfnt.isCFF() {
+			vf.dict.BaseFont += "-" + identityVEncoding
+		}
+		font.cidFont.DW2, font.cidFont.W2 = font.verticalMetrics()
+	}
+
+	font.toUnicode.stream = newStream(streamFlateDecode)
+	if err := writeToUnicodeCMap(font.toUnicode, 2, text); err != nil {
+		return err
+	}
+	return font.toUnicode.Close()
+}
+
+// widthArray returns the widths of the used CIDs in the format of a CIDFont's
+// W entry.  Consecutive CIDs are grouped together.
+func (font *compositeFont) widthArray() []interface{} {
+	cids := font.usedCIDs()
+	var w []interface{}
+	var run []int
+	for i, cid := range cids {
+		if i == 0 || cid != cids[i-1]+1 {
+			if run != nil {
+				w = append(w, run)
+			}
+			w = append(w, cid)
+			run = nil
+		}
+		run = append(run, font.sfnt.advance(font.glyphIndex(uint16(cid))))
+	}
+	if run != nil {
+		w = append(w, run)
+	}
+	return w
+}
+
+// usedCIDs returns the CIDs that have been shown, in increasing order.
+func (font *compositeFont) usedCIDs() []int {
+	cids := make([]int, 0, len(font.used))
+	for cid := range font.used {
+		cids = append(cids, int(cid))
+	}
+	sort.Ints(cids)
+	return cids
+}
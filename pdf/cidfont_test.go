@@ -0,0 +1,104 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestAddUnicodeFont(t *testing.T) {
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	cf := font.(*compositeFont)
+
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.Text("Hi Ω")
+
+	var code string
+	for _, r := range "Hi Ω" {
+		code += fmt.Sprintf("%04X", cf.sfnt.glyphIndex(r))
+	}
+	wantOutput := "12.00000 TL\n/__font0__ 10.00000 Tf\n<" + code + "> Tj\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+	canvas.DrawText(text)
+	canvas.Close()
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	for _, s := range []string{"/Subtype /Type0", "/Encoding /Identity-H", "/Subtype /CIDFontType2", "/CIDToGIDMap /Identity", "/ToUnicode", "+GoRegular"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("document does not contain %q", s)
+		}
+	}
+
+	data, err := ioutil.ReadAll(flateReader(t, cf.toUnicode.Bytes()))
+	if err != nil {
+		t.Fatalf("reading ToUnicode: %v", err)
+	}
+	for _, r := range "Hi Ω" {
+		s := fmt.Sprintf("<%04X> <%04X>\n", cf.sfnt.glyphIndex(r), r)
+		if !bytes.Contains(data, []byte(s)) {
+			t.Errorf("ToUnicode CMap does not contain %q", s)
+		}
+	}
+}
+
+func TestCompositeFontWidths(t *testing.T) {
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	cf := font.(*compositeFont)
+	cf.useCodes("\x00\x05\x00\x06\x00\x09")
+	w := cf.widthArray()
+	if len(w) != 4 {
+		t.Fatalf("widthArray() = %v; want 4 elements", w)
+	}
+	if w[0] != 5 || w[2] != 9 {
+		t.Errorf("widthArray() starts runs at %v and %v; want 5 and 9", w[0], w[2])
+	}
+	if run := w[1].([]int); len(run) != 2 || run[0] != cf.sfnt.advance(5) || run[1] != cf.sfnt.advance(6) {
+		t.Errorf("widthArray()[1] = %v; want widths of glyphs 5 and 6", run)
+	}
+}
+
+var toUnicodeTests = []struct {
+	CodeBytes int
+	Map       map[int]string
+	Want      []string
+}{
+	{1, map[int]string{0x41: "A"}, []string{"<00> <FF>", "1 beginbfchar\n<41> <0041>\nendbfchar"}},
+	{2, map[int]string{0x102: "fi"}, []string{"<0000> <FFFF>", "<0102> <00660069>"}},
+	{2, map[int]string{7: "\U0001D11E"}, []string{"<0007> <D834DD1E>"}},
+}
+
+func TestToUnicodeCMap(t *testing.T) {
+	for i, tt := range toUnicodeTests {
+		var buf bytes.Buffer
+		if err := writeToUnicodeCMap(&buf, tt.CodeBytes, tt.Map); err != nil {
+			t.Errorf("%d. writeToUnicodeCMap error: %v", i, err)
+			continue
+		}
+		for _, s := range tt.Want {
+			if !strings.Contains(buf.String(), s) {
+				t.Errorf("%d. CMap does not contain %q:\n%s", i, s, buf.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"unicode/utf16"
+)
+
+// cmapStream is a stream that holds a CMap.  The stream is filled in when the
+// document is encoded.
+type cmapStream struct {
+	*stream
+}
+
+// maxBFCharEntries is the largest number of mappings allowed in a single
+// beginbfchar section.
+const maxBFCharEntries = 100
+
+// writeToUnicodeCMap writes a CMap that maps character codes of the given
+// width in bytes to the Unicode text they represent.
+func writeToUnicodeCMap(w io.Writer, codeBytes int, m map[int]string) error {
+	codes := make([]int, 0, len(m))
+	for c := range m {
+		codes = append(codes, c)
+	}
+	sort.Ints(codes)
+
+	_, err := fmt.Fprintf(w, "/CIDInit /ProcSet findresource begin\n"+
+		"12 dict begin\n"+
+		"begincmap\n"+
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n"+
+		"/CMapName /Adobe-Identity-UCS def\n"+
+		"/CMapType 2 def\n"+
+		"1 begincodespacerange\n"+
+		"<%0*X> <%0*X>\n"+
+		"endcodespacerange\n", codeBytes*2, 0, codeBytes*2, 1<<(8*uint(codeBytes))-1)
+	if err != nil {
+		return err
+	}
+	for len(codes) > 0 {
+		n := len(codes)
+		if n > maxBFCharEntries {
+			n = maxBFCharEntries
+		}
+		if _, err := fmt.Fprintf(w, "%d beginbfchar\n", n); err != nil {
+			return err
+		}
+		for _, c := range codes[:n] {
+			if _, err := fmt.Fprintf(w, "<%0*X> <%s>\n", codeBytes*2, c, utf16Hex(m[c])); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "endbfchar\n"); err != nil {
+			return err
+		}
+		codes = codes[n:]
+	}
+	_, err = io.WriteString(w, "endcmap\n"+
+		"CMapName currentdict /CMap defineresource pop\n"+
+		"end\n"+
+		"end\n")
+	return err
+}
+
+// utf16Hex returns the UTF-16BE encoding of s in hexadecimal.
+func utf16Hex(s string) string {
+	var b []byte
+	for _, u := range utf16.Encode([]rune(s)) {
+		b = append(b, fmt.Sprintf("%04X", u)...)
+	}
+	return string(b)
+}
@@ -0,0 +1,233 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// A Color is a color that text and graphics can be painted with.  Colors that
+// are not in a device color space refer to a color space in the document; a
+// Separation or ICCBased color without one, or an ICCBased color with the
+// wrong number of components, cannot be painted.  Setting such a color returns
+// an error and leaves the current color unchanged.
+type Color interface {
+	// check returns an error if the color cannot be painted.
+	check() error
+
+	// writeFill writes the operators that make the color the current fill
+	// color.
+	writeFill(w io.Writer) error
+
+	// writeStroke writes the operators that make the color the current stroke
+	// color.
+	writeStroke(w io.Writer) error
+
+	// colorSpace returns the color space resource that the color's operators
+	// refer to, or nil if the color is in a device color space.
+	colorSpace() colorSpace
+}
+
+// A colorSpace is a color space that is stored in the document and selected
+// by name from a canvas's resources.
+type colorSpace interface {
+	resourceName() name
+	reference() Reference
+}
+
+// Device color spaces
+const (
+	deviceGrayColorSpace name = "DeviceGray"
+	deviceRGBColorSpace  name = "DeviceRGB"
+	deviceCMYKColorSpace name = "DeviceCMYK"
+)
+
+// DeviceGray is a color in the device's grayscale color space.  The level
+// ranges from 0 (black) to 1 (white).
+type DeviceGray float32
+
+func (c DeviceGray) writeFill(w io.Writer) error {
+	return writeCommand(w, "g", float32(c))
+}
+
+func (c DeviceGray) writeStroke(w io.Writer) error {
+	return writeCommand(w, "G", float32(c))
+}
+
+func (c DeviceGray) check() error {
+	return nil
+}
+
+func (c DeviceGray) colorSpace() colorSpace {
+	return nil
+}
+
+// DeviceRGB is a color in the device's RGB color space.  Each component ranges
+// from 0 to 1.
+type DeviceRGB struct {
+	R, G, B float32
+}
+
+func (c DeviceRGB) writeFill(w io.Writer) error {
+	return writeCommand(w, "rg", c.R, c.G, c.B)
+}
+
+func (c DeviceRGB) writeStroke(w io.Writer) error {
+	return writeCommand(w, "RG", c.R, c.G, c.B)
+}
+
+func (c DeviceRGB) check() error {
+	return nil
+}
+
+func (c DeviceRGB) colorSpace() colorSpace {
+	return nil
+}
+
+// DeviceCMYK is a color in the device's CMYK color space, which is the usual
+// choice for process color printing.  Each component is the amount of an ink,
+// from 0 to 1.
+type DeviceCMYK struct {
+	C, M, Y, K float32
+}
+
+func (c DeviceCMYK) writeFill(w io.Writer) error {
+	return writeCommand(w, "k", c.C, c.M, c.Y, c.K)
+}
+
+func (c DeviceCMYK) writeStroke(w io.Writer) error {
+	return writeCommand(w, "K", c.C, c.M, c.Y, c.K)
+}
+
+func (c DeviceCMYK) check() error {
+	return nil
+}
+
+func (c DeviceCMYK) colorSpace() colorSpace {
+	return nil
+}
+
+// A SeparationSpace is a color space for a single colorant, such as a spot
+// color ink.  Devices that cannot produce the colorant show an alternate color
+// instead.  SeparationSpaces are created with Document.AddSeparation.
+type SeparationSpace struct {
+	colorant string
+	resName  name
+	ref      Reference
+}
+
+// AddSeparation adds a color space for the named colorant to the document.
+// alternate is the appearance of the colorant at full tint on devices that
+// cannot produce it, and must be a DeviceGray, DeviceRGB or DeviceCMYK color.
+// Lesser tints blend linearly between no ink and alternate.
+func (doc *Document) AddSeparation(colorant string, alternate Color) (*SeparationSpace, error) {
+	var (
+		space  name
+		c0, c1 []float32
+	)
+	switch c := alternate.(type) {
+	case DeviceGray:
+		space, c0, c1 = deviceGrayColorSpace, []float32{1}, []float32{float32(c)}
+	case DeviceRGB:
+		space, c0, c1 = deviceRGBColorSpace, []float32{1, 1, 1}, []float32{c.R, c.G, c.B}
+	case DeviceCMYK:
+		space, c0, c1 = deviceCMYKColorSpace, []float32{0, 0, 0, 0}, []float32{c.C, c.M, c.Y, c.K}
+	default:
+		return nil, errors.New("pdf: separation alternate must be a device color")
+	}
+	sep := &SeparationSpace{
+		colorant: colorant,
+		resName:  doc.nextColorSpaceName(),
+	}
+	sep.ref = doc.add([]interface{}{
+		separationFamily,
+		name(colorant),
+		space,
+		exponentialFunction{
+			FunctionType: 2,
+			Domain:       []float32{0, 1},
+			C0:           c0,
+			C1:           c1,
+			N:            1,
+		},
+	})
+	return sep, nil
+}
+
+// Colorant returns the name of the colorant that the color space was created
+// with.
+func (sep *SeparationSpace) Colorant() string {
+	return sep.colorant
+}
+
+// Tint returns the color that applies the colorant with the given amount,
+// from 0 (none) to 1 (full).
+func (sep *SeparationSpace) Tint(tint float32) Separation {
+	return Separation{Space: sep, Tint: tint}
+}
+
+func (sep *SeparationSpace) resourceName() name {
+	return sep.resName
+}
+
+func (sep *SeparationSpace) reference() Reference {
+	return sep.ref
+}
+
+// Separation is a tint of a spot colorant.  A Separation without a Space,
+// like the zero value, cannot be painted.
+type Separation struct {
+	Space *SeparationSpace
+	Tint  float32
+}
+
+func (c Separation) check() error {
+	if c.Space == nil {
+		return errors.New("pdf: separation color has no color space")
+	}
+	return nil
+}
+
+func (c Separation) writeFill(w io.Writer) error {
+	if err := writeCommand(w, "cs", c.Space.resName); err != nil {
+		return err
+	}
+	return writeCommand(w, "scn", c.Tint)
+}
+
+func (c Separation) writeStroke(w io.Writer) error {
+	if err := writeCommand(w, "CS", c.Space.resName); err != nil {
+		return err
+	}
+	return writeCommand(w, "SCN", c.Tint)
+}
+
+func (c Separation) colorSpace() colorSpace {
+	return c.Space
+}
+
+// Color space families
+const (
+	separationFamily name = "Separation"
+	iccBasedFamily   name = "ICCBased"
+)
+
+// exponentialFunction is a Type 2 function, which interpolates between C0 and
+// C1.  For more information, see Section 7.10.3 of ISO 32000-1.
+type exponentialFunction struct {
+	FunctionType int
+	Domain       []float32
+	C0           []float32
+	C1           []float32
+	N            float32
+}
+
+const anonymousColorSpaceFormat = "__cs%d__"
+
+func (doc *Document) nextColorSpaceName() name {
+	n := name(fmt.Sprintf(anonymousColorSpaceFormat, doc.colorSpaceCounter))
+	doc.colorSpaceCounter++
+	return n
+}
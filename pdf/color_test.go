@@ -0,0 +1,104 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"testing"
+)
+
+const colorExpectedOutput = `0.25000 g
+0.10000 0.20000 0.30000 0.40000 K
+1.00000 0.00000 0.00000 rg
+/__cs0__ cs
+0.50000 scn
+/__cs0__ CS
+1.00000 SCN
+`
+
+func TestColors(t *testing.T) {
+	doc := New()
+	spot, err := doc.AddSeparation("PANTONE 185 C", DeviceCMYK{0, 0.9, 0.8, 0})
+	if err != nil {
+		t.Fatalf("AddSeparation error: %v", err)
+	}
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	canvas.SetFillColor(DeviceGray(0.25))
+	canvas.SetStrokeColor(DeviceCMYK{0.1, 0.2, 0.3, 0.4})
+	canvas.SetColor(1, 0, 0)
+	if canvas.resources.ColorSpace != nil {
+		t.Errorf("device colors added color spaces: %v", canvas.resources.ColorSpace)
+	}
+	canvas.SetFillColor(spot.Tint(0.5))
+	canvas.SetStrokeColor(Separation{Space: spot, Tint: 1})
+	if out := canvasOutput(t, canvas); out != colorExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, colorExpectedOutput)
+	}
+	if ref := canvas.resources.ColorSpace["__cs0__"]; ref != spot.ref {
+		t.Errorf("ColorSpace[__cs0__] = %v; want %v", ref, spot.ref)
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	want := "[ /Separation /PANTONE#20185#20C /DeviceCMYK << /FunctionType 2 /Domain [ 0.00000 1.00000 ] " +
+		"/C0 [ 0.00000 0.00000 0.00000 0.00000 ] /C1 [ 0.00000 0.90000 0.80000 0.00000 ] /N 1.00000 >> ]"
+	if !bytes.Contains(buf.Bytes(), []byte(want)) {
+		t.Errorf("document does not contain %q", want)
+	}
+}
+
+func TestSeparationAlternate(t *testing.T) {
+	doc := New()
+	spot, err := doc.AddSeparation("Gold", DeviceRGB{1, 0.8, 0})
+	if err != nil {
+		t.Fatalf("AddSeparation error: %v", err)
+	}
+	if _, err := doc.AddSeparation("Silver", spot.Tint(1)); err == nil {
+		t.Error("AddSeparation with a separation alternate did not return an error")
+	}
+}
+
+func TestZeroSeparation(t *testing.T) {
+	canvas := New().NewPage(USLetterWidth, USLetterHeight)
+	if err := canvas.SetFillColor(Separation{}); err == nil {
+		t.Error("SetFillColor(Separation{}) did not return an error")
+	}
+	if err := canvas.SetStrokeColor(Separation{Tint: 1}); err == nil {
+		t.Error("SetStrokeColor(Separation{Tint: 1}) did not return an error")
+	}
+	text := new(Text)
+	if err := text.SetFillColor(Separation{}); err == nil {
+		t.Error("Text.SetFillColor(Separation{}) did not return an error")
+	}
+	if err := text.TextSpans([]Span{{Text: "a"}, {Text: "b", Color: Separation{}}}); err == nil {
+		t.Error("TextSpans with a zero separation did not return an error")
+	}
+	if text.buf.String() != "(a) Tj\n" {
+		t.Errorf("text output was %q, expected %q", text.buf.String(), "(a) Tj\n")
+	}
+	text = new(Text)
+	canvas.DrawText(text)
+	if canvas.resources.ColorSpace != nil {
+		t.Errorf("zero separation added color spaces: %v", canvas.resources.ColorSpace)
+	}
+	if out := canvasOutput(t, canvas); out != "BT\nET\n" {
+		t.Errorf("Output was %q, expected %q", out, "BT\nET\n")
+	}
+}
+
+func TestTextColorSpace(t *testing.T) {
+	doc := New()
+	spot, err := doc.AddSeparation("Gold", DeviceGray(0.5))
+	if err != nil {
+		t.Fatalf("AddSeparation error: %v", err)
+	}
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFillColor(spot.Tint(0.75))
+	canvas.DrawText(text)
+	if ref := canvas.resources.ColorSpace[spot.resName]; ref != spot.ref {
+		t.Errorf("ColorSpace[%v] = %v; want %v", spot.resName, ref, spot.ref)
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+// winAnsiHigh maps WinAnsiEncoding character codes 128 through 159 to Unicode
+// code points.  Codes that are undefined in the encoding map to zero.  Codes
+// 160 through 255 are identical to ISO Latin-1.
+var winAnsiHigh = [32]rune{
+	0x20ac, 0, 0x201a, 0x0192, 0x201e, 0x2026, 0x2020, 0x2021,
+	0x02c6, 0x2030, 0x0160, 0x2039, 0x0152, 0, 0x017d, 0,
+	0, 0x2018, 0x2019, 0x201c, 0x201d, 0x2022, 0x2013, 0x2014,
+	0x02dc, 0x2122, 0x0161, 0x203a, 0x0153, 0, 0x017e, 0x0178,
+}
+
+// winAnsiReplacement is the character code used for runes that cannot be
+// represented in WinAnsiEncoding.
+const winAnsiReplacement = '?'
+
+// winAnsiRune returns the Unicode code point for a WinAnsiEncoding character
+// code, or zero if the code is undefined.
+func winAnsiRune(c byte) rune {
+	switch {
+	case c >= 128 && c < 160:
+		return winAnsiHigh[c-128]
+	case c == 127:
+		return 0
+	}
+	return rune(c)
+}
+
+// winAnsiCode returns the WinAnsiEncoding character code for r.
+func winAnsiCode(r rune) (byte, bool) {
+	switch {
+	case r < 0:
+		return 0, false
+	case r < 127 || r >= 160 && r < 256:
+		return byte(r), true
+	}
+	for i, hr := range winAnsiHigh {
+		if hr == r && r != 0 {
+			return byte(128 + i), true
+		}
+	}
+	return 0, false
+}
+
+// winAnsiEncode converts a UTF-8 string to WinAnsiEncoding.
+func winAnsiEncode(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		c, ok := winAnsiCode(r)
+		if !ok {
+			c = winAnsiReplacement
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+// byteEncode converts a UTF-8 string to the character codes of a font with a
+// built-in encoding, like Symbol.  Runes in the Unicode private use area
+// U+F000 to U+F0FF select the code of their low byte, which is how symbolic
+// TrueType fonts map their characters.
+func byteEncode(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if r >= 0xf000 && r <= 0xf0ff {
+			r -= 0xf000
+		}
+		if r < 0 || r >= 256 {
+			r = winAnsiReplacement
+		}
+		b = append(b, byte(r))
+	}
+	return string(b)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"testing"
+)
+
+var winAnsiTests = []struct {
+	s        string
+	expected string
+}{
+	{"", ""},
+	{"Hello, World!", "Hello, World!"},
+	{"café", "caf\xe9"},
+	{"€100", "\x80100"},
+	{"“quoted”", "\x93quoted\x94"},
+	{"世界", "??"},
+}
+
+func TestWinAnsiEncode(t *testing.T) {
+	for _, tt := range winAnsiTests {
+		if result := winAnsiEncode(tt.s); result != tt.expected {
+			t.Errorf("winAnsiEncode(%q) = %q; want %q", tt.s, result, tt.expected)
+		}
+	}
+}
+
+func TestWinAnsiRune(t *testing.T) {
+	for c := 0; c < 256; c++ {
+		r := winAnsiRune(byte(c))
+		if r == 0 {
+			continue
+		}
+		if code, ok := winAnsiCode(r); !ok || code != byte(c) {
+			t.Errorf("winAnsiCode(winAnsiRune(%#02x)) = %#02x, %t", c, code, ok)
+		}
+	}
+}
+
+func TestWinAnsiCodeNegative(t *testing.T) {
+	for _, r := range []rune{-1, -128, -0x7fffffff} {
+		if code, ok := winAnsiCode(r); ok {
+			t.Errorf("winAnsiCode(%d) = %#02x, true; want false", r, code)
+		}
+	}
+}
+
+var byteEncodeTests = []struct {
+	s        string
+	expected string
+}{
+	{"abc", "abc"},
+	{"\uf061\uf0e0", "a\xe0"},
+	{"é", "\xe9"},
+	{"€", "?"},
+}
+
+func TestByteEncode(t *testing.T) {
+	for _, tt := range byteEncodeTests {
+		if result := byteEncode(tt.s); result != tt.expected {
+			t.Errorf("byteEncode(%q) = %q; want %q", tt.s, result, tt.expected)
+		}
+	}
+}
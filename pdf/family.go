@@ -0,0 +1,128 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"unicode"
+)
+
+// A FontFamily is a font made up of a primary font and a chain of fallback
+// fonts.  It can be used wherever a font is set.  Text shown in a family is
+// split into runs that each use the first font in the chain that has a glyph
+// for their characters, and the text object switches between the fonts as
+// needed.  Characters that no font has a glyph for are shown in the primary
+// font.
+//
+// The family's name and metrics are those of its primary font.  Families must
+// be created with NewFontFamily; the zero value has no primary font and cannot
+// be used.
+type FontFamily struct {
+	fonts []Font
+}
+
+// NewFontFamily returns a family that shows characters in primary, falling
+// back to each of fallbacks in order.  Fonts that are themselves families are
+// replaced with their fonts, and nil fallbacks are skipped.  NewFontFamily
+// panics if primary is nil or an empty family.
+func NewFontFamily(primary Font, fallbacks ...Font) *FontFamily {
+	if f, ok := primary.(*FontFamily); primary == nil || ok && len(f.fonts) == 0 {
+		panic("pdf: font family has no primary font")
+	}
+	fam := new(FontFamily)
+	for _, font := range append([]Font{primary}, fallbacks...) {
+		if font == nil {
+			continue
+		}
+		if f, ok := font.(*FontFamily); ok {
+			fam.fonts = append(fam.fonts, f.fonts...)
+		} else {
+			fam.fonts = append(fam.fonts, font)
+		}
+	}
+	return fam
+}
+
+// Fonts returns the fonts in the family, starting with the primary font.
+func (fam *FontFamily) Fonts() []Font {
+	return append([]Font(nil), fam.fonts...)
+}
+
+func (fam *FontFamily) Name() string {
+	return fam.fonts[0].Name()
+}
+
+func (fam *FontFamily) Metrics() FontMetrics {
+	return fam.fonts[0].Metrics()
+}
+
+func (fam *FontFamily) resourceName() name {
+	return fam.fonts[0].resourceName()
+}
+
+func (fam *FontFamily) reference(doc *Document) Reference {
+	return fam.fonts[0].reference(doc)
+}
+
+// encode uses the primary font.  Text and MeasureString split strings into runs
+// before they are encoded.
+func (fam *FontFamily) encode(s string) string {
+	return fam.fonts[0].encode(s)
+}
+
+func (fam *FontFamily) stringWidth(codes string, size Unit) Unit {
+	return fam.fonts[0].stringWidth(codes, size)
+}
+
+func (fam *FontFamily) hasGlyph(r rune) bool {
+	for _, font := range fam.fonts {
+		if font.hasGlyph(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// fontFor returns the first font in the family that has a glyph for r.
+func (fam *FontFamily) fontFor(r rune) Font {
+	for _, font := range fam.fonts {
+		if font.hasGlyph(r) {
+			return font
+		}
+	}
+	return fam.fonts[0]
+}
+
+// fontRun is part of a string that is shown in a single font.
+type fontRun struct {
+	font Font
+	s    string
+}
+
+// runs splits s into runs of characters that are shown in the same font.
+// Combining marks and joiners stay in the font of the character before them,
+// and characters that are shared by many scripts, like spaces and punctuation,
+// stay in the current font if it has them.
+func (fam *FontFamily) runs(s string) []fontRun {
+	var runs []fontRun
+	var cur Font
+	start := 0
+	for i, r := range s {
+		font := cur
+		switch {
+		case cur != nil && (unicode.In(r, unicode.Mn, unicode.Me) || r == 0x200C || r == 0x200D):
+		case cur != nil && unicode.Is(unicode.Common, r) && cur.hasGlyph(r):
+		default:
+			font = fam.fontFor(r)
+		}
+		if font != cur {
+			if cur != nil {
+				runs = append(runs, fontRun{cur, s[start:i]})
+			}
+			cur, start = font, i
+		}
+	}
+	if cur != nil {
+		runs = append(runs, fontRun{cur, s[start:]})
+	}
+	return runs
+}
@@ -0,0 +1,528 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// A Font is a typeface that text can be shown in.  The standard 14 fonts are
+// available through StandardFont.  Other fonts can be embedded in a document
+// with Document.AddFont.
+type Font interface {
+	// Name returns the PostScript name of the font.
+	Name() string
+
+	// Metrics returns the font's dimensions in thousandths of an em.
+	Metrics() FontMetrics
+
+	// resourceName returns the name used to select the font in a content
+	// stream.
+	resourceName() name
+
+	// reference returns a reference to the font's dictionary in doc.
+	reference(doc *Document) Reference
+
+	// encode converts a UTF-8 string to the font's character codes.
+	encode(s string) string
+
+	// stringWidth returns the width of a string of character codes at the
+	// given font size.
+	stringWidth(codes string, size Unit) Unit
+
+	// hasGlyph reports whether the font can show a character.
+	hasGlyph(r rune) bool
+}
+
+// MeasureString returns the width of s when shown in a font at the given size,
+// without kerning.  This is the same distance that Text.Text would advance the
+// text cursor.
+func MeasureString(font Font, size Unit, s string) Unit {
+	if fam, ok := font.(*FontFamily); ok {
+		var w Unit
+		for _, run := range fam.runs(s) {
+			w += MeasureString(run.font, size, run.s)
+		}
+		return w
+	}
+	sf, ok := font.(shapingFont)
+	if !ok {
+		return font.stringWidth(font.encode(s), size)
+	}
+	codes, adjust, _ := sf.shape(s, false, false)
+	w := font.stringWidth(codes, size)
+	for _, a := range adjust {
+		w += Unit(a.amount) * size / 1000
+	}
+	return w
+}
+
+// FontMetrics holds the vertical dimensions of a font.  Each font has its
+// metrics in glyph space, where an em is 1000 units; use Scale to convert them
+// to a given font size.
+type FontMetrics struct {
+	// Ascender is the height above the baseline of the tallest letters,
+	// like "d".  Descender is the (negative) position of the bottom of
+	// letters that extend below the baseline, like "p".
+	Ascender  Unit
+	Descender Unit
+
+	// CapHeight is the height of flat capital letters, like "H".  XHeight
+	// is the height of flat lowercase letters, like "x".  They are zero if
+	// the font does not specify them.
+	CapHeight Unit
+	XHeight   Unit
+
+	// ItalicAngle is the angle of the font's vertical strokes in degrees
+	// counterclockwise from vertical.  It is negative for fonts that slope
+	// to the right.
+	ItalicAngle float64
+
+	// FontBBox is the smallest rectangle that encloses every glyph when they
+	// are drawn on top of each other at the origin.
+	FontBBox Rectangle
+}
+
+// Scale returns the metrics of the font at the given size.
+func (m FontMetrics) Scale(size Unit) FontMetrics {
+	s := func(u Unit) Unit { return u * size / 1000 }
+	m.Ascender, m.Descender = s(m.Ascender), s(m.Descender)
+	m.CapHeight, m.XHeight = s(m.CapHeight), s(m.XHeight)
+	m.FontBBox = Rectangle{
+		Point{s(m.FontBBox.Min.X), s(m.FontBBox.Min.Y)},
+		Point{s(m.FontBBox.Max.X), s(m.FontBBox.Max.Y)},
+	}
+	return m
+}
+
+// StandardFont returns one of the standard 14 fonts, which every PDF viewer is
+// required to provide.  The name should be one of the font name constants,
+// like Helvetica.
+func StandardFont(fontName string) Font {
+	return standardFont(fontName)
+}
+
+// standardFont is a font referred to by name and not embedded in the file.
+type standardFont name
+
+func (f standardFont) Name() string {
+	return string(f)
+}
+
+func (f standardFont) Metrics() FontMetrics {
+	return getFontMetrics(name(f))
+}
+
+func (f standardFont) resourceName() name {
+	return name(f)
+}
+
+func (f standardFont) reference(doc *Document) Reference {
+	return doc.standardFont(name(f))
+}
+
+func (f standardFont) encode(s string) string {
+	if f.symbolic() {
+		return byteEncode(s)
+	}
+	return winAnsiEncode(s)
+}
+
+func (f standardFont) hasGlyph(r rune) bool {
+	if f.symbolic() {
+		return r >= 0 && r < 256 || r >= 0xf000 && r <= 0xf0ff
+	}
+	_, ok := winAnsiCode(r)
+	return ok
+}
+
+func (f standardFont) kern(codes string) []kernAdjustment {
+	table := getFontKerning(name(f))
+	if table == nil {
+		return nil
+	}
+	var adjust []kernAdjustment
+	for i := 1; i < len(codes); i++ {
+		if amount := table[kernPair{codes[i-1], codes[i]}]; amount != 0 {
+			adjust = append(adjust, kernAdjustment{i, int(amount)})
+		}
+	}
+	return adjust
+}
+
+// symbolic reports whether the font uses its built-in encoding instead of
+// WinAnsiEncoding.
+func (f standardFont) symbolic() bool {
+	return f == Symbol || f == ZapfDingbats
+}
+
+func (f standardFont) stringWidth(codes string, size Unit) Unit {
+	if widths := getFontWidths(name(f)); widths != nil {
+		return computeStringWidth(codes, widths, size)
+	}
+	return 0
+}
+
+// A kerningFont is a font that adjusts the space between pairs of characters.
+type kerningFont interface {
+	Font
+
+	// kern returns the kerning adjustments for a string of character codes.
+	kern(codes string) []kernAdjustment
+}
+
+// A kernAdjustment moves the character that starts at a byte offset in a
+// string of character codes.  The amount is in thousandths of an em, and
+// negative amounts move the character closer to the one before it.
+type kernAdjustment struct {
+	index  int
+	amount int
+}
+
+// Font descriptor flags
+const (
+	fontFlagFixedPitch  = 1 << 0
+	fontFlagSerif       = 1 << 1
+	fontFlagSymbolic    = 1 << 2
+	fontFlagScript      = 1 << 3
+	fontFlagNonsymbolic = 1 << 5
+	fontFlagItalic      = 1 << 6
+)
+
+// sFamilyClass values from the OS/2 table
+const (
+	familyClassSansSerif = 8
+	familyClassScripts   = 10
+)
+
+type fontDescriptor struct {
+	Type        name
+	FontName    name
+	Flags       int
+	FontBBox    Rectangle
+	ItalicAngle float64
+	Ascent      int
+	Descent     int
+	CapHeight   int
+	XHeight     int `pdf:",omitempty"`
+	StemV       int
+	FontFile2   interface{} `pdf:",omitempty"`
+	FontFile3   interface{} `pdf:",omitempty"`
+}
+
+// newFontDescriptor returns a descriptor for an embedded font.  The caller is
+// responsible for attaching the font program.
+func newFontDescriptor(f *sfnt, fontName name) *fontDescriptor {
+	m := f.metrics()
+	fd := &fontDescriptor{
+		Type:        fontDescriptorType,
+		FontName:    fontName,
+		FontBBox:    m.FontBBox,
+		ItalicAngle: m.ItalicAngle,
+		Ascent:      int(m.Ascender),
+		Descent:     int(m.Descender),
+		CapHeight:   int(m.CapHeight),
+		XHeight:     int(m.XHeight),
+		// There is no direct source for the vertical stem width, so
+		// estimate it from the weight class.
+		StemV: 10 + 220*(f.weightClass-50)/900,
+	}
+	if fd.StemV < 10 {
+		fd.StemV = 10
+	}
+	if f.fixedPitch {
+		fd.Flags |= fontFlagFixedPitch
+	}
+	if f.familyClass >= 1 && f.familyClass < familyClassSansSerif {
+		fd.Flags |= fontFlagSerif
+	}
+	if f.familyClass == familyClassScripts {
+		fd.Flags |= fontFlagScript
+	}
+	if f.symbolic {
+		fd.Flags |= fontFlagSymbolic
+	} else {
+		fd.Flags |= fontFlagNonsymbolic
+	}
+	if f.italicAngle != 0 {
+		fd.Flags |= fontFlagItalic
+	}
+	return fd
+}
+
+// fontFileStream is a stream that holds an embedded font program.
+type fontFileStream struct {
+	*stream
+	Length1 int
+	Subtype name
+}
+
+type fontFileStreamInfo struct {
+	Length  int
+	Filter  name `pdf:",omitempty"`
+	Length1 int  `pdf:",omitempty"`
+	Subtype name `pdf:",omitempty"`
+}
+
+// Font file subtypes
+const (
+	fontFileType1C   name = "Type1C"
+	fontFileOpenType name = "OpenType"
+)
+
+func (st *fontFileStream) marshalPDF(dst []byte) ([]byte, error) {
+	return marshalStream(dst, fontFileStreamInfo{
+		Length:  st.Len(),
+		Filter:  st.filter,
+		Length1: st.Length1,
+		Subtype: st.Subtype,
+	}, st.Bytes())
+}
+
+// An embeddedFont is a font whose program is written to the document.  Only
+// the glyphs that were shown are included in the font program, so it cannot
+// be written until the document is encoded.
+type embeddedFont interface {
+	Font
+
+	// useCodes records that a string of character codes has been shown.
+	useCodes(codes string)
+
+	// writeFontProgram writes the subset font program to the document.
+	writeFontProgram() error
+}
+
+// addFontProgram reserves a stream for a font's outlines in the document and
+// attaches it to the font descriptor.  The stream is filled in by
+// writeFontFile.
+func (doc *Document) addFontProgram(fd *fontDescriptor, f *sfnt) *fontFileStream {
+	st := new(fontFileStream)
+	if f.isCFF() {
+		fd.FontFile3 = doc.add(st)
+	} else {
+		fd.FontFile2 = doc.add(st)
+	}
+	return st
+}
+
+// writeFontFile writes a subset of a font program to st.  The cmap is used as
+// the subset's character map, which is encoded with the given Windows platform
+// encoding ID.  CFF outlines are written as a bare CFF font program for simple
+// fonts and wrapped in an OpenType font program for CIDFonts.  It returns the
+// tag that identifies the subset.
+func writeFontFile(st *fontFileStream, f *sfnt, glyphs glyphSet, cmap map[rune]uint16, cmapEncoding uint16, cidFont bool) (string, error) {
+	if err := f.closeGlyphSet(glyphs); err != nil {
+		return "", err
+	}
+	var data []byte
+	var err error
+	switch {
+	case f.isCFF() && cidFont:
+		data, err = f.subsetOpenTypeCFF(glyphs, cmap)
+		st.Subtype = fontFileOpenType
+	case f.isCFF():
+		data, err = subsetCFF(f.tables["CFF "], glyphs)
+		st.Subtype = fontFileType1C
+	default:
+		data, err = f.subsetTrueType(glyphs, cmap, cmapEncoding)
+		st.Length1 = len(data)
+	}
+	if err != nil {
+		return "", err
+	}
+	st.stream = newStream(streamFlateDecode)
+	st.Write(data)
+	if err := st.Close(); err != nil {
+		return "", err
+	}
+	return subsetTag(glyphs), nil
+}
+
+// Windows platform cmap encodings
+const (
+	cmapEncodingSymbol  = 0
+	cmapEncodingUnicode = 1
+)
+
+const anonymousFontFormat = "__font%d__"
+
+// nextFontName returns an unused resource name for an embedded font.
+func (doc *Document) nextFontName() name {
+	n := name(fmt.Sprintf(anonymousFontFormat, doc.fontCounter))
+	doc.fontCounter++
+	return n
+}
+
+// AddFont embeds a TrueType or OpenType font program in the document.  The
+// returned font can be used by text objects drawn on any of the document's
+// canvases.
+//
+// Text shown in the font is encoded with WinAnsiEncoding, so only the
+// characters in that encoding can be displayed.  When the document is encoded,
+// the font program is reduced to the glyphs used by text objects.
+func (doc *Document) AddFont(r io.Reader) (Font, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	f, err := parseSFNT(data)
+	if err != nil {
+		return nil, err
+	}
+	if f.isCFF() {
+		if top, err := parseCFFTopDict(f.tables["CFF "]); err != nil {
+			return nil, err
+		} else if top.isCIDKeyed() {
+			return nil, errors.New("pdf: CID-keyed fonts must be added with AddUnicodeFont")
+		}
+	}
+
+	font := &simpleFont{sfnt: f, resName: doc.nextFontName()}
+	dict := &simpleFontDict{
+		Type:      fontType,
+		Subtype:   fontTrueTypeSubtype,
+		BaseFont:  name(f.postscriptName),
+		FirstChar: simpleFontFirstChar,
+		LastChar:  simpleFontLastChar,
+		Widths:    make([]int, simpleFontLastChar-simpleFontFirstChar+1),
+	}
+	if f.isCFF() {
+		dict.Subtype = fontType1Subtype
+	}
+	if !f.symbolic {
+		dict.Encoding = winAnsiEncoding
+	}
+	for c := 0; c < 256; c++ {
+		font.widths[c] = f.advance(font.glyphIndex(byte(c)))
+		if c >= simpleFontFirstChar && c <= simpleFontLastChar {
+			dict.Widths[c-simpleFontFirstChar] = font.widths[c]
+		}
+	}
+	fd := newFontDescriptor(f, dict.BaseFont)
+	font.file = doc.addFontProgram(fd, f)
+	dict.FontDescriptor = doc.add(fd)
+	font.dict, font.descriptor = dict, fd
+	font.ref = doc.add(dict)
+	doc.embeddedFonts = append(doc.embeddedFonts, font)
+	return font, nil
+}
+
+// Character code range of embedded simple fonts
+const (
+	simpleFontFirstChar = 32
+	simpleFontLastChar  = 255
+)
+
+type simpleFontDict struct {
+	Type           name
+	Subtype        name
+	BaseFont       name
+	FirstChar      int
+	LastChar       int
+	Widths         []int
+	FontDescriptor Reference
+	Encoding       name `pdf:",omitempty"`
+}
+
+// simpleFont is an embedded font that uses single-byte character codes.
+type simpleFont struct {
+	sfnt    *sfnt
+	resName name
+	ref     Reference
+	widths  [256]int
+	used    [256]bool
+
+	dict       *simpleFontDict
+	descriptor *fontDescriptor
+	file       *fontFileStream
+}
+
+func (font *simpleFont) Name() string {
+	return font.sfnt.postscriptName
+}
+
+func (font *simpleFont) Metrics() FontMetrics {
+	return font.sfnt.metrics()
+}
+
+func (font *simpleFont) resourceName() name {
+	return font.resName
+}
+
+func (font *simpleFont) reference(doc *Document) Reference {
+	return font.ref
+}
+
+// glyphIndex returns the glyph shown for a character code.
+func (font *simpleFont) glyphIndex(c byte) uint16 {
+	if font.sfnt.symbolic {
+		return font.sfnt.glyphIndex(rune(c))
+	}
+	if r := winAnsiRune(c); r != 0 {
+		return font.sfnt.glyphIndex(r)
+	}
+	return 0
+}
+
+func (font *simpleFont) encode(s string) string {
+	if font.sfnt.symbolic {
+		return byteEncode(s)
+	}
+	return winAnsiEncode(s)
+}
+
+func (font *simpleFont) hasGlyph(r rune) bool {
+	if font.sfnt.symbolic {
+		if r >= 0xf000 && r <= 0xf0ff {
+			r -= 0xf000
+		}
+		return r >= 0 && r < 256 && font.glyphIndex(byte(r)) != 0
+	}
+	c, ok := winAnsiCode(r)
+	return ok && font.glyphIndex(c) != 0
+}
+
+func (font *simpleFont) stringWidth(codes string, size Unit) Unit {
+	width := 0
+	for i := 0; i < len(codes); i++ {
+		width += font.widths[codes[i]]
+	}
+	return Unit(width) * size / 1000
+}
+
+func (font *simpleFont) useCodes(codes string) {
+	for i := 0; i < len(codes); i++ {
+		font.used[codes[i]] = true
+	}
+}
+
+func (font *simpleFont) writeFontProgram() error {
+	glyphs := make(glyphSet)
+	cmap := make(map[rune]uint16)
+	for c, used := range font.used {
+		g := font.glyphIndex(byte(c))
+		if !used || g == 0 {
+			continue
+		}
+		glyphs[g] = true
+		if font.sfnt.symbolic {
+			cmap[0xf000+rune(c)] = g
+		} else {
+			cmap[winAnsiRune(byte(c))] = g
+		}
+	}
+	cmapEncoding := uint16(cmapEncodingUnicode)
+	if font.sfnt.symbolic {
+		cmapEncoding = cmapEncodingSymbol
+	}
+	tag, err := writeFontFile(font.file, font.sfnt, glyphs, cmap, cmapEncoding, false)
+	if err != nil {
+		return err
+	}
+	font.dict.BaseFont = name(tag + "+" + font.sfnt.postscriptName)
+	font.descriptor.FontName = font.dict.BaseFont
+	return nil
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestAddFont(t *testing.T) {
+	doc := New()
+	font, err := doc.AddFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddFont error: %v", err)
+	}
+	if font.Name() != "GoRegular" {
+		t.Errorf("font.Name() = %q; want %q", font.Name(), "GoRegular")
+	}
+
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(font, 12)
+	text.Text("Hello")
+	canvas.DrawText(text)
+	canvas.Close()
+
+	const want = 2370 * 12 / 1000.0
+	if !floatEq(float64(text.X()), want, 1e-4) {
+		t.Errorf("\"Hello\" has X = %.5f; want %.5f", text.X(), want)
+	}
+	if _, ok := canvas.page.Resources.Font[font.resourceName()]; !ok {
+		t.Errorf("page resources missing %v", font.resourceName())
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	for _, s := range []string{"/Subtype /TrueType", "+GoRegular", "/FontFile2", "/Encoding /WinAnsiEncoding"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("document does not contain %q", s)
+		}
+	}
+}
+
+func TestAddFontEncoding(t *testing.T) {
+	doc := New()
+	font, err := doc.AddFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddFont error: %v", err)
+	}
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.Text("café €5")
+
+	const wantOutput = "/__font0__ 10.00000 Tf\n12.00000 TL\n(caf\xe9 \x805) Tj\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+}
+
+func TestMeasureString(t *testing.T) {
+	doc := New()
+	font, err := doc.AddFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddFont error: %v", err)
+	}
+	tests := []struct {
+		font Font
+		s    string
+	}{
+		{StandardFont(Helvetica), "Hello, World!"},
+		{StandardFont(Times), "café €5"},
+		{StandardFont(Courier), ""},
+		{font, "Hello"},
+	}
+	for _, tt := range tests {
+		text := new(Text)
+		text.SetFontFace(tt.font, 12)
+		text.Text(tt.s)
+		if w := MeasureString(tt.font, 12, tt.s); w != text.X() {
+			t.Errorf("MeasureString(%s, 12, %q) = %.5f; want %.5f", tt.font.Name(), tt.s, w, text.X())
+		}
+	}
+	if w := MeasureString(StandardFont(Helvetica), 10, "Hello!"); !floatEq(float64(w), 25.56, 1e-4) {
+		t.Errorf("MeasureString(Helvetica, 10, \"Hello!\") = %.5f; want 25.56", w)
+	}
+}
+
+func TestFontFamily(t *testing.T) {
+	doc := New()
+	uf, err := doc.AddUnicodeFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	fam := NewFontFamily(StandardFont(Helvetica), uf)
+
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(fam, 10)
+	text.Text("Hi Ω")
+	text.Text("!")
+	omega := uf.(*compositeFont).sfnt.glyphIndex('Ω')
+	want := "/Helvetica 10.00000 Tf\n12.00000 TL\n(Hi ) Tj\n" +
+		"/__font0__ 10.00000 Tf\n<" + fmt.Sprintf("%04X", omega) + "> Tj\n" +
+		"/Helvetica 10.00000 Tf\n(!) Tj\n"
+	if text.buf.String() != want {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), want)
+	}
+	if w := MeasureString(fam, 10, "Hi Ω!"); !floatEq(float64(w), float64(text.X()), 1e-4) {
+		t.Errorf("MeasureString = %.5f; want %.5f", w, text.X())
+	}
+	canvas.DrawText(text)
+	for _, name := range []name{Helvetica, uf.resourceName()} {
+		if _, ok := canvas.page.Resources.Font[name]; !ok {
+			t.Errorf("page resources do not include font %s", name)
+		}
+	}
+}
+
+func TestNewFontFamilyPrimary(t *testing.T) {
+	helvetica := StandardFont(Helvetica)
+	if fonts := NewFontFamily(helvetica, nil).Fonts(); len(fonts) != 1 || fonts[0] != helvetica {
+		t.Errorf("NewFontFamily(Helvetica, nil).Fonts() = %v; want [Helvetica]", fonts)
+	}
+	for _, primary := range []Font{nil, new(FontFamily)} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewFontFamily(%#v, Helvetica) did not panic", primary)
+				}
+			}()
+			NewFontFamily(primary, helvetica)
+		}()
+	}
+}
+
+func TestAddFontMetrics(t *testing.T) {
+	doc := New()
+	font, err := doc.AddFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddFont error: %v", err)
+	}
+	m := font.Metrics()
+	if m.Ascender <= 0 || m.Descender >= 0 || m.CapHeight <= m.XHeight || m.XHeight <= 0 {
+		t.Errorf("GoRegular metrics = %+v; want ascender > cap height > x-height > 0 > descender", m)
+	}
+	fd := font.(*simpleFont).descriptor
+	if m.Ascender != Unit(fd.Ascent) || m.Descender != Unit(fd.Descent) || m.FontBBox != fd.FontBBox {
+		t.Errorf("metrics %+v do not match font descriptor %+v", m, fd)
+	}
+}
+
+func TestAddFontBadData(t *testing.T) {
+	doc := New()
+	if _, err := doc.AddFont(strings.NewReader("Hello, World!")); err == nil {
+		t.Error("AddFont did not return an error")
+	}
+}
+
+func TestFontSubset(t *testing.T) {
+	doc := New()
+	font, err := doc.AddFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddFont error: %v", err)
+	}
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(font, 12)
+	text.Text("Hello")
+	canvas.DrawText(text)
+	canvas.Close()
+	if err := doc.Encode(ioutil.Discard); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+
+	sf := font.(*simpleFont)
+	baseFont := string(sf.dict.BaseFont)
+	if len(baseFont) != len("ABCDEF+GoRegular") || !strings.HasSuffix(baseFont, "+GoRegular") {
+		t.Errorf("BaseFont = %q; want subset tag followed by +GoRegular", baseFont)
+	}
+	if sf.descriptor.FontName != sf.dict.BaseFont {
+		t.Errorf("FontName = %q; want %q", sf.descriptor.FontName, sf.dict.BaseFont)
+	}
+	if sf.file.Length1 >= len(goregular.TTF)/4 {
+		t.Errorf("subset font is %d bytes; original font is %d bytes", sf.file.Length1, len(goregular.TTF))
+	}
+
+	data, err := ioutil.ReadAll(flateReader(t, sf.file.Bytes()))
+	if err != nil {
+		t.Fatalf("reading font file: %v", err)
+	}
+	subset, err := parseSFNT(data)
+	if err != nil {
+		t.Fatalf("parsing subset: %v", err)
+	}
+	for _, r := range "Helo" {
+		g := subset.glyphIndex(r)
+		if g != sf.sfnt.glyphIndex(r) {
+			t.Errorf("subset glyphIndex(%q) = %d; want %d", r, g, sf.sfnt.glyphIndex(r))
+		}
+		if glyph, err := subset.glyphData(g); err != nil || len(glyph) == 0 {
+			t.Errorf("subset glyph for %q is missing (err=%v)", r, err)
+		}
+	}
+	if g := subset.glyphIndex('Z'); g != 0 {
+		t.Errorf("subset glyphIndex('Z') = %d; want 0", g)
+	}
+}
+
+func flateReader(t *testing.T, b []byte) io.Reader {
+	r, err := zlib.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("zlib.NewReader: %v", err)
+	}
+	return r
+}
@@ -0,0 +1,164 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// A Hyphenator finds the places where a word can be broken across lines.
+type Hyphenator interface {
+	// Hyphenate returns the byte offsets in word at which a hyphen may be
+	// inserted, in increasing order.  Offsets that are not strictly inside
+	// the word are ignored.
+	Hyphenate(word string) []int
+}
+
+// PatternHyphenator hyphenates words using Liang's algorithm, the method used
+// by TeX.  Any language that has TeX hyphenation patterns can be hyphenated by
+// creating a PatternHyphenator with them.
+type PatternHyphenator struct {
+	// MinLeft and MinRight are the smallest number of letters that may be
+	// left before and after a hyphen.
+	MinLeft, MinRight int
+
+	patterns   map[string][]byte
+	maxLen     int // length of the longest pattern in runes
+	exceptions map[string][]int
+}
+
+// NewPatternHyphenator creates a hyphenator from TeX hyphenation patterns and
+// a list of exceptions, both separated by whitespace.  A pattern is a sequence
+// of letters with digits between them, like "hy3ph", where an odd digit allows
+// a break and an even digit prevents one; a period matches the start or end of
+// a word.  An exception is a word with hyphens at each place that it may be
+// broken, like "ta-ble".  MinLeft and MinRight are set to 2 and 3.
+func NewPatternHyphenator(patterns, exceptions string) (*PatternHyphenator, error) {
+	h := &PatternHyphenator{
+		MinLeft:    2,
+		MinRight:   3,
+		patterns:   make(map[string][]byte),
+		exceptions: make(map[string][]int),
+	}
+	for _, pat := range strings.Fields(patterns) {
+		var letters []rune
+		var values []byte
+		for _, r := range pat {
+			if r >= '0' && r <= '9' {
+				if len(values) > len(letters) {
+					return nil, errors.New("pdf: malformed hyphenation pattern " + pat)
+				}
+				values = append(values, byte(r-'0'))
+				continue
+			}
+			if len(values) <= len(letters) {
+				values = append(values, 0)
+			}
+			letters = append(letters, unicode.ToLower(r))
+		}
+		if len(letters) == 0 {
+			return nil, errors.New("pdf: malformed hyphenation pattern " + pat)
+		}
+		if len(values) <= len(letters) {
+			values = append(values, 0)
+		}
+		h.patterns[string(letters)] = values
+		if len(letters) > h.maxLen {
+			h.maxLen = len(letters)
+		}
+	}
+	for _, ex := range strings.Fields(exceptions) {
+		var letters []rune
+		var breaks []int
+		for _, r := range ex {
+			if r == '-' {
+				breaks = append(breaks, len(letters))
+			} else {
+				letters = append(letters, unicode.ToLower(r))
+			}
+		}
+		h.exceptions[string(letters)] = breaks
+	}
+	return h, nil
+}
+
+var englishHyphenator struct {
+	sync.Once
+	h *PatternHyphenator
+}
+
+// EnglishHyphenator returns a hyphenator for US English.
+func EnglishHyphenator() *PatternHyphenator {
+	englishHyphenator.Do(func() {
+		h, err := NewPatternHyphenator(englishPatterns, englishExceptions)
+		if err != nil {
+			panic(err)
+		}
+		englishHyphenator.h = h
+	})
+	return englishHyphenator.h
+}
+
+// Hyphenate returns the places where word may be broken.  Punctuation before
+// and after the word is ignored, but words that contain other characters that
+// are not letters are never broken.
+func (h *PatternHyphenator) Hyphenate(word string) []int {
+	start := strings.IndexFunc(word, unicode.IsLetter)
+	if start == -1 {
+		return nil
+	}
+	end := strings.LastIndexFunc(word, unicode.IsLetter)
+	_, n := utf8.DecodeRuneInString(word[end:])
+	end += n
+
+	// offsets[i] is the byte offset of the i-th letter in word.
+	var offsets []int
+	letters := []rune{'.'}
+	for i, r := range word[start:end] {
+		if !unicode.IsLetter(r) {
+			return nil
+		}
+		offsets = append(offsets, start+i)
+		letters = append(letters, unicode.ToLower(r))
+	}
+	letters = append(letters, '.')
+	if len(offsets) < h.MinLeft+h.MinRight {
+		return nil
+	}
+
+	var breaks []int
+	if ex, ok := h.exceptions[string(letters[1:len(letters)-1])]; ok {
+		breaks = ex
+	} else {
+		values := make([]byte, len(letters)+1)
+		for i := range letters {
+			for j := i + 1; j <= len(letters) && j-i <= h.maxLen; j++ {
+				pat := h.patterns[string(letters[i:j])]
+				for k, v := range pat {
+					if v > values[i+k] {
+						values[i+k] = v
+					}
+				}
+			}
+		}
+		// A break before letter i is given by the value before it in
+		// letters, which has a leading period.
+		for i := 1; i < len(offsets); i++ {
+			if values[i+1]%2 == 1 {
+				breaks = append(breaks, i)
+			}
+		}
+	}
+
+	var result []int
+	for _, i := range breaks {
+		if i >= h.MinLeft && len(offsets)-i >= h.MinRight {
+			result = append(result, offsets[i])
+		}
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// hyphenated returns word with a hyphen inserted at each break.
+func hyphenated(word string, breaks []int) string {
+	var parts []string
+	prev := 0
+	for _, b := range breaks {
+		parts = append(parts, word[prev:b])
+		prev = b
+	}
+	return strings.Join(append(parts, word[prev:]), "-")
+}
+
+var englishHyphenTests = []struct {
+	word     string
+	expected string
+}{
+	{"hyphenation", "hy-phen-a-tion"},
+	{"algorithm", "al-go-rithm"},
+	{"typesetting", "type-set-ting"},
+	{"justification", "jus-ti-fi-ca-tion"},
+	{"Paragraph", "Para-graph"},
+	{"(computers),", "(com-put-ers),"},
+	{"table", "ta-ble"},
+	{"academy", "acad-emy"},
+	{"associate", "as-so-ciate"},
+	{"cat", "cat"},
+	{"e-mail", "e-mail"},
+	{"1234", "1234"},
+	{"", ""},
+}
+
+func TestEnglishHyphenator(t *testing.T) {
+	h := EnglishHyphenator()
+	for _, tt := range englishHyphenTests {
+		if result := hyphenated(tt.word, h.Hyphenate(tt.word)); result != tt.expected {
+			t.Errorf("Hyphenate(%q) = %q; want %q", tt.word, result, tt.expected)
+		}
+	}
+}
+
+func TestPatternHyphenator(t *testing.T) {
+	h, err := NewPatternHyphenator("1ba 1na", "ba-na-na")
+	if err != nil {
+		t.Fatalf("NewPatternHyphenator error: %v", err)
+	}
+	h.MinLeft, h.MinRight = 1, 1
+	if breaks := h.Hyphenate("cabana"); !reflect.DeepEqual(breaks, []int{2, 4}) {
+		t.Errorf("Hyphenate(\"cabana\") = %v; want [2 4]", breaks)
+	}
+	if breaks := h.Hyphenate("Banana"); !reflect.DeepEqual(breaks, []int{2, 4}) {
+		t.Errorf("Hyphenate(\"Banana\") = %v; want [2 4]", breaks)
+	}
+	if breaks := h.Hyphenate("über"); len(breaks) != 0 {
+		t.Errorf("Hyphenate(\"über\") = %v; want none", breaks)
+	}
+
+	for _, pat := range []string{"a12b", "42"} {
+		if _, err := NewPatternHyphenator(pat, ""); err == nil {
+			t.Errorf("NewPatternHyphenator(%q) did not return an error", pat)
+		}
+	}
+}
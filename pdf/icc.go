@@ -0,0 +1,208 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// An ICCSpace is a color space defined by an ICC profile, which lets
+// color-managed devices reproduce its colors accurately.  ICCSpaces are
+// created with Document.AddICCProfile.
+type ICCSpace struct {
+	n       int
+	resName name
+	ref     Reference
+	profile Reference
+}
+
+// iccHeaderSize is the length of the fixed header at the start of every ICC
+// profile.
+const iccHeaderSize = 128
+
+// AddICCProfile embeds an ICC profile in the document and returns the color
+// space that it defines.  The profile must describe a gray, RGB or CMYK color
+// space.
+func (doc *Document) AddICCProfile(r io.Reader) (*ICCSpace, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < iccHeaderSize || string(data[36:40]) != "acsp" {
+		return nil, errors.New("pdf: not an ICC profile")
+	}
+	st := &iccStream{stream: newStream(streamFlateDecode)}
+	switch sig := string(data[16:20]); sig {
+	case "GRAY":
+		st.N, st.Alternate = 1, deviceGrayColorSpace
+	case "RGB ":
+		st.N, st.Alternate = 3, deviceRGBColorSpace
+	case "CMYK":
+		st.N, st.Alternate = 4, deviceCMYKColorSpace
+	default:
+		return nil, fmt.Errorf("pdf: unsupported ICC profile color space %q", sig)
+	}
+	if _, err := st.Write(data); err != nil {
+		return nil, err
+	}
+	if err := st.Close(); err != nil {
+		return nil, err
+	}
+
+	space := &ICCSpace{
+		n:       st.N,
+		resName: doc.nextColorSpaceName(),
+		profile: doc.add(st),
+	}
+	space.ref = doc.add([]interface{}{iccBasedFamily, space.profile})
+	return space, nil
+}
+
+// N returns the number of components in the color space's colors.
+func (space *ICCSpace) N() int {
+	return space.n
+}
+
+// Color returns the color in the color space with the given components, which
+// range from 0 to 1.
+func (space *ICCSpace) Color(components ...float32) ICCBased {
+	return ICCBased{Space: space, Components: components}
+}
+
+func (space *ICCSpace) resourceName() name {
+	return space.resName
+}
+
+func (space *ICCSpace) reference() Reference {
+	return space.ref
+}
+
+// ICCBased is a color in an ICC profile's color space.  There must be as many
+// components as the color space's N.  A color without a Space, like the zero
+// value, or with the wrong number of components cannot be painted.
+type ICCBased struct {
+	Space      *ICCSpace
+	Components []float32
+}
+
+func (c ICCBased) check() error {
+	if c.Space == nil {
+		return errors.New("pdf: ICC-based color has no color space")
+	}
+	if len(c.Components) != c.Space.n {
+		return fmt.Errorf("pdf: ICC-based color has %d components; its color space has %d", len(c.Components), c.Space.n)
+	}
+	return nil
+}
+
+func (c ICCBased) writeFill(w io.Writer) error {
+	if err := writeCommand(w, "cs", c.Space.resName); err != nil {
+		return err
+	}
+	return writeCommand(w, "scn", c.args()...)
+}
+
+func (c ICCBased) writeStroke(w io.Writer) error {
+	if err := writeCommand(w, "CS", c.Space.resName); err != nil {
+		return err
+	}
+	return writeCommand(w, "SCN", c.args()...)
+}
+
+func (c ICCBased) args() []interface{} {
+	args := make([]interface{}, len(c.Components))
+	for i := range c.Components {
+		args[i] = c.Components[i]
+	}
+	return args
+}
+
+func (c ICCBased) colorSpace() colorSpace {
+	return c.Space
+}
+
+// SetImageColorSpace changes the color space that images added afterward are
+// in.  The color space must be an RGB profile.  A nil color space restores the
+// default, which is the device's RGB color space.
+func (doc *Document) SetImageColorSpace(space *ICCSpace) error {
+	if space != nil && space.n != 3 {
+		return errors.New("pdf: image color space must be RGB")
+	}
+	doc.imageColorSpace = space
+	return nil
+}
+
+// An OutputIntent describes the printing condition that a document's colors
+// are intended for.  Standards like PDF/X and PDF/A require one.
+type OutputIntent struct {
+	// Subtype identifies the standard the intent is for: "GTS_PDFX" for
+	// PDF/X or "GTS_PDFA1" for PDF/A.
+	Subtype string
+
+	// OutputConditionIdentifier names the printing condition, usually as it
+	// is listed in a registry, such as "FOGRA39".
+	OutputConditionIdentifier string
+
+	// OutputCondition, RegistryName and Info are optional.  They are a
+	// readable description of the printing condition, the URL of the registry
+	// that lists it and further information about it.
+	OutputCondition string
+	RegistryName    string
+	Info            string
+
+	// Profile is the ICC profile of the printing condition.  It may be nil if
+	// the condition is listed in a registry.
+	Profile *ICCSpace
+}
+
+// AddOutputIntent adds an output intent to the document's catalog.
+func (doc *Document) AddOutputIntent(intent OutputIntent) {
+	dict := outputIntentDict{
+		Type:                      outputIntentType,
+		S:                         name(intent.Subtype),
+		OutputConditionIdentifier: intent.OutputConditionIdentifier,
+		OutputCondition:           intent.OutputCondition,
+		RegistryName:              intent.RegistryName,
+		Info:                      intent.Info,
+	}
+	if intent.Profile != nil {
+		dict.DestOutputProfile = intent.Profile.profile
+	}
+	doc.catalog.OutputIntents = append(doc.catalog.OutputIntents, dict)
+}
+
+type outputIntentDict struct {
+	Type                      name
+	S                         name
+	OutputConditionIdentifier string
+	OutputCondition           string      `pdf:",omitempty"`
+	RegistryName              string      `pdf:",omitempty"`
+	Info                      string      `pdf:",omitempty"`
+	DestOutputProfile         interface{} `pdf:",omitempty"`
+}
+
+// iccStream is a stream that holds an ICC profile.
+type iccStream struct {
+	*stream
+	N         int
+	Alternate name
+}
+
+type iccStreamInfo struct {
+	Length    int
+	Filter    name `pdf:",omitempty"`
+	N         int
+	Alternate name
+}
+
+func (st *iccStream) marshalPDF(dst []byte) ([]byte, error) {
+	return marshalStream(dst, iccStreamInfo{
+		Length:    st.Len(),
+		Filter:    st.filter,
+		N:         st.N,
+		Alternate: st.Alternate,
+	}, st.Bytes())
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"strings"
+	"testing"
+)
+
+// testICCProfile returns the header of an ICC profile for the given color
+// space signature.
+func testICCProfile(sig string) []byte {
+	data := make([]byte, iccHeaderSize)
+	copy(data[16:], sig)
+	copy(data[36:], "acsp")
+	return data
+}
+
+func TestICCProfile(t *testing.T) {
+	tests := []struct {
+		Sig       string
+		N         int
+		Alternate name
+	}{
+		{"GRAY", 1, deviceGrayColorSpace},
+		{"RGB ", 3, deviceRGBColorSpace},
+		{"CMYK", 4, deviceCMYKColorSpace},
+	}
+	for _, test := range tests {
+		doc := New()
+		space, err := doc.AddICCProfile(bytes.NewReader(testICCProfile(test.Sig)))
+		if err != nil {
+			t.Errorf("AddICCProfile(%q) error: %v", test.Sig, err)
+			continue
+		}
+		if space.N() != test.N {
+			t.Errorf("AddICCProfile(%q).N() = %d; want %d", test.Sig, space.N(), test.N)
+		}
+		var buf bytes.Buffer
+		if err := doc.Encode(&buf); err != nil {
+			t.Fatalf("Encode error: %v", err)
+		}
+		want := fmt.Sprintf("/N %d /Alternate /%v", test.N, test.Alternate)
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("AddICCProfile(%q) stream does not contain %q", test.Sig, want)
+		}
+	}
+
+	if _, err := New().AddICCProfile(bytes.NewReader(testICCProfile("Lab "))); err == nil {
+		t.Error("AddICCProfile with a Lab profile did not return an error")
+	}
+	if _, err := New().AddICCProfile(strings.NewReader("not a profile")); err == nil {
+		t.Error("AddICCProfile with garbage did not return an error")
+	}
+}
+
+const iccColorExpectedOutput = `/__cs0__ cs
+0.10000 0.20000 0.30000 scn
+/__cs0__ CS
+1.00000 1.00000 1.00000 SCN
+`
+
+func TestICCColor(t *testing.T) {
+	doc := New()
+	space, err := doc.AddICCProfile(bytes.NewReader(testICCProfile("RGB ")))
+	if err != nil {
+		t.Fatalf("AddICCProfile error: %v", err)
+	}
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	canvas.SetFillColor(space.Color(0.1, 0.2, 0.3))
+	canvas.SetStrokeColor(ICCBased{Space: space, Components: []float32{1, 1, 1}})
+	if out := canvasOutput(t, canvas); out != iccColorExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, iccColorExpectedOutput)
+	}
+	if ref := canvas.resources.ColorSpace[space.resName]; ref != space.ref {
+		t.Errorf("ColorSpace[%v] = %v; want %v", space.resName, ref, space.ref)
+	}
+}
+
+func TestInvalidICCColor(t *testing.T) {
+	doc := New()
+	space, err := doc.AddICCProfile(bytes.NewReader(testICCProfile("RGB ")))
+	if err != nil {
+		t.Fatalf("AddICCProfile error: %v", err)
+	}
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	if err := canvas.SetFillColor(ICCBased{}); err == nil {
+		t.Error("SetFillColor(ICCBased{}) did not return an error")
+	}
+	if err := canvas.SetFillColor(space.Color(0.5)); err == nil {
+		t.Error("SetFillColor with 1 of 3 components did not return an error")
+	}
+	if err := canvas.SetStrokeColor(space.Color(0.1, 0.2, 0.3, 0.4)); err == nil {
+		t.Error("SetStrokeColor with 4 of 3 components did not return an error")
+	}
+	if err := new(Text).SetFillColor(space.Color()); err == nil {
+		t.Error("Text.SetFillColor with no components did not return an error")
+	}
+	if canvas.resources.ColorSpace != nil {
+		t.Errorf("invalid colors added color spaces: %v", canvas.resources.ColorSpace)
+	}
+	if out := canvasOutput(t, canvas); out != "" {
+		t.Errorf("Output was %q, expected %q", out, "")
+	}
+}
+
+func TestImageColorSpace(t *testing.T) {
+	doc := New()
+	cmyk, err := doc.AddICCProfile(bytes.NewReader(testICCProfile("CMYK")))
+	if err != nil {
+		t.Fatalf("AddICCProfile error: %v", err)
+	}
+	if err := doc.SetImageColorSpace(cmyk); err == nil {
+		t.Error("SetImageColorSpace with a CMYK profile did not return an error")
+	}
+	rgb, err := doc.AddICCProfile(bytes.NewReader(testICCProfile("RGB ")))
+	if err != nil {
+		t.Fatalf("AddICCProfile error: %v", err)
+	}
+	if err := doc.SetImageColorSpace(rgb); err != nil {
+		t.Fatalf("SetImageColorSpace error: %v", err)
+	}
+	ref := doc.AddImage(image.NewRGBA(image.Rect(0, 0, 1, 1)))
+	if err := doc.SetImageColorSpace(nil); err != nil {
+		t.Fatalf("SetImageColorSpace(nil) error: %v", err)
+	}
+	defaultRef := doc.AddImage(image.NewRGBA(image.Rect(0, 0, 1, 1)))
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	if cs := doc.objects[ref.Number-1].(*imageStream).ColorSpace; cs != rgb.ref {
+		t.Errorf("image ColorSpace = %v; want %v", cs, rgb.ref)
+	}
+	if cs := doc.objects[defaultRef.Number-1].(*imageStream).ColorSpace; cs != deviceRGBColorSpace {
+		t.Errorf("default image ColorSpace = %v; want %v", cs, deviceRGBColorSpace)
+	}
+}
+
+func TestOutputIntent(t *testing.T) {
+	doc := New()
+	space, err := doc.AddICCProfile(bytes.NewReader(testICCProfile("CMYK")))
+	if err != nil {
+		t.Fatalf("AddICCProfile error: %v", err)
+	}
+	doc.AddOutputIntent(OutputIntent{
+		Subtype:                   "GTS_PDFX",
+		OutputConditionIdentifier: "FOGRA39",
+		RegistryName:              "http://www.color.org",
+		Profile:                   space,
+	})
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	want := "/OutputIntents [ << /Type /OutputIntent /S /GTS_PDFX /OutputConditionIdentifier (FOGRA39) " +
+		fmt.Sprintf("/RegistryName (http://www.color.org) /DestOutputProfile %d 0 R >> ]", space.profile.Number)
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("catalog does not contain %q", want)
+	}
+}
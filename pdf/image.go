@@ -8,16 +8,12 @@ import (
 	"io"
 )
 
-const (
-	deviceRGBColorSpace name = "DeviceRGB"
-)
-
 type imageStream struct {
 	*stream
 	Width            int
 	Height           int
 	BitsPerComponent int
-	ColorSpace       name
+	ColorSpace       interface{}
 }
 
 type imageStreamInfo struct {
@@ -28,16 +24,18 @@ type imageStreamInfo struct {
 	Width            int
 	Height           int
 	BitsPerComponent int
-	ColorSpace       name
+	ColorSpace       interface{}
 }
 
-func newImageStream(filter name, w, h int) *imageStream {
+// newImageStream returns an empty stream for an RGB image.  colorSpace is
+// the name or reference of the image's color space.
+func newImageStream(filter name, w, h int, colorSpace interface{}) *imageStream {
 	return &imageStream{
 		stream:           newStream(filter),
 		Width:            w,
 		Height:           h,
 		BitsPerComponent: 8,
-		ColorSpace:       deviceRGBColorSpace,
+		ColorSpace:       colorSpace,
 	}
 }
 
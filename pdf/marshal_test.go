@@ -31,6 +31,8 @@ var marshalTests = []marshalTest{
 	{float64(1e9), "1000000000.00000"},
 	{name(""), "/"},
 	{name("foo"), "/foo"},
+	{name("PANTONE 185 C"), "/PANTONE#20185#20C"},
+	{name("a#b/c"), "/a#23b#2Fc"},
 	{[]interface{}{}, `[ ]`},
 	{[]string{"foo", "(parens)"}, `[ (foo) (\(parens\)) ]`},
 	{map[name]string{}, `<< >>`},
@@ -0,0 +1,55 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+// Matrix is an affine transformation, such as a translation, rotation or
+// scaling.  The elements of Matrix{a, b, c, d, e, f} map to values in the 3x3
+// matrix as shown below:
+//
+//  / a b 0 \
+//  | c d 0 |
+//  \ e f 1 /
+//
+// For more information, see Section 8.3.4 of ISO 32000-1.
+type Matrix [6]float32
+
+// IdentityMatrix is the transformation that leaves points where they are.
+var IdentityMatrix = Matrix{1, 0, 0, 1, 0, 0}
+
+// Apply returns the point that m transforms pt to.
+func (m Matrix) Apply(pt Point) Point {
+	x, y := float64(pt.X), float64(pt.Y)
+	return Point{
+		Unit(float64(m[0])*x + float64(m[2])*y + float64(m[4])),
+		Unit(float64(m[1])*x + float64(m[3])*y + float64(m[5])),
+	}
+}
+
+// Multiply returns the matrix that transforms a point by m and then by n.
+func (m Matrix) Multiply(n Matrix) Matrix {
+	return Matrix{
+		m[0]*n[0] + m[1]*n[2],
+		m[0]*n[1] + m[1]*n[3],
+		m[2]*n[0] + m[3]*n[2],
+		m[2]*n[1] + m[3]*n[3],
+		m[4]*n[0] + m[5]*n[2] + n[4],
+		m[4]*n[1] + m[5]*n[3] + n[5],
+	}
+}
+
+// Invert returns the matrix that undoes m.  ok is false if m collapses the
+// plane onto a line or a point and so cannot be undone.
+func (m Matrix) Invert() (inv Matrix, ok bool) {
+	det := float64(m[0])*float64(m[3]) - float64(m[1])*float64(m[2])
+	if det == 0 {
+		return Matrix{}, false
+	}
+	a, b := float64(m[0])/det, float64(m[1])/det
+	c, d := float64(m[2])/det, float64(m[3])/det
+	e, f := float64(m[4]), float64(m[5])
+	return Matrix{
+		float32(d), float32(-b),
+		float32(-c), float32(a),
+		float32(c*f - d*e), float32(b*e - a*f),
+	}, true
+}
@@ -0,0 +1,49 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"testing"
+)
+
+func matrixEq(a, b Matrix) bool {
+	for i := range a {
+		if !floatEq(float64(a[i]), float64(b[i]), 1e-5) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMatrixMultiply(t *testing.T) {
+	scale := Matrix{2, 0, 0, 3, 0, 0}
+	translate := Matrix{1, 0, 0, 1, 10, 20}
+	m := scale.Multiply(translate)
+	if p := m.Apply(Point{1, 1}); p != (Point{12, 23}) {
+		t.Errorf("scale then translate maps (1, 1) to %v; want (12, 23)", p)
+	}
+	m = translate.Multiply(scale)
+	if p := m.Apply(Point{1, 1}); p != (Point{22, 63}) {
+		t.Errorf("translate then scale maps (1, 1) to %v; want (22, 63)", p)
+	}
+	if m := IdentityMatrix.Multiply(scale); m != scale {
+		t.Errorf("IdentityMatrix.Multiply(%v) = %v", scale, m)
+	}
+}
+
+func TestMatrixInvert(t *testing.T) {
+	m := Matrix{0, 2, -2, 0, 5, 7}
+	inv, ok := m.Invert()
+	if !ok {
+		t.Fatalf("%v.Invert() failed", m)
+	}
+	if prod := m.Multiply(inv); !matrixEq(prod, IdentityMatrix) {
+		t.Errorf("m.Multiply(m.Invert()) = %v; want identity", prod)
+	}
+	if p := inv.Apply(m.Apply(Point{3, 4})); !floatEq(float64(p.X), 3, 1e-5) || !floatEq(float64(p.Y), 4, 1e-5) {
+		t.Errorf("inverse maps point back to %v; want (3, 4)", p)
+	}
+	if _, ok := (Matrix{1, 2, 2, 4, 0, 0}).Invert(); ok {
+		t.Error("singular matrix was inverted")
+	}
+}
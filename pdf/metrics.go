@@ -1,6 +1,15 @@
 package pdf
 
 // Courier
+var courierMetrics = FontMetrics{
+	Ascender:    629,
+	Descender:   -157,
+	CapHeight:   562,
+	XHeight:     426,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-23, -250}, Point{715, 805}},
+}
+
 var courierWidths = []uint16{
 	32:  600, // space
 	33:  600, // exclam
@@ -9,7 +18,7 @@ var courierWidths = []uint16{
 	36:  600, // dollar
 	37:  600, // percent
 	38:  600, // ampersand
-	39:  600, // quoteright
+	39:  600, // quotesingle
 	40:  600, // parenleft
 	41:  600, // parenright
 	42:  600, // asterisk
@@ -66,7 +75,7 @@ var courierWidths = []uint16{
 	93:  600, // bracketright
 	94:  600, // asciicircum
 	95:  600, // underscore
-	96:  600, // quoteleft
+	96:  600, // grave
 	97:  600, // a
 	98:  600, // b
 	99:  600, // c
@@ -97,9 +106,141 @@ var courierWidths = []uint16{
 	124: 600, // bar
 	125: 600, // braceright
 	126: 600, // asciitilde
+	128: 600, // Euro
+	130: 600, // quotesinglbase
+	131: 600, // florin
+	132: 600, // quotedblbase
+	133: 600, // ellipsis
+	134: 600, // dagger
+	135: 600, // daggerdbl
+	136: 600, // circumflex
+	137: 600, // perthousand
+	138: 600, // Scaron
+	139: 600, // guilsinglleft
+	140: 600, // OE
+	142: 600, // Zcaron
+	145: 600, // quoteleft
+	146: 600, // quoteright
+	147: 600, // quotedblleft
+	148: 600, // quotedblright
+	149: 600, // bullet
+	150: 600, // endash
+	151: 600, // emdash
+	152: 600, // tilde
+	153: 600, // trademark
+	154: 600, // scaron
+	155: 600, // guilsinglright
+	156: 600, // oe
+	158: 600, // zcaron
+	159: 600, // Ydieresis
+	160: 600, // space
+	161: 600, // exclamdown
+	162: 600, // cent
+	163: 600, // sterling
+	164: 600, // currency
+	165: 600, // yen
+	166: 600, // brokenbar
+	167: 600, // section
+	168: 600, // dieresis
+	169: 600, // copyright
+	170: 600, // ordfeminine
+	171: 600, // guillemotleft
+	172: 600, // logicalnot
+	173: 600, // hyphen
+	174: 600, // registered
+	175: 600, // macron
+	176: 600, // degree
+	177: 600, // plusminus
+	178: 600, // twosuperior
+	179: 600, // threesuperior
+	180: 600, // acute
+	181: 600, // mu
+	182: 600, // paragraph
+	183: 600, // periodcentered
+	184: 600, // cedilla
+	185: 600, // onesuperior
+	186: 600, // ordmasculine
+	187: 600, // guillemotright
+	188: 600, // onequarter
+	189: 600, // onehalf
+	190: 600, // threequarters
+	191: 600, // questiondown
+	192: 600, // Agrave
+	193: 600, // Aacute
+	194: 600, // Acircumflex
+	195: 600, // Atilde
+	196: 600, // Adieresis
+	197: 600, // Aring
+	198: 600, // AE
+	199: 600, // Ccedilla
+	200: 600, // Egrave
+	201: 600, // Eacute
+	202: 600, // Ecircumflex
+	203: 600, // Edieresis
+	204: 600, // Igrave
+	205: 600, // Iacute
+	206: 600, // Icircumflex
+	207: 600, // Idieresis
+	208: 600, // Eth
+	209: 600, // Ntilde
+	210: 600, // Ograve
+	211: 600, // Oacute
+	212: 600, // Ocircumflex
+	213: 600, // Otilde
+	214: 600, // Odieresis
+	215: 600, // multiply
+	216: 600, // Oslash
+	217: 600, // Ugrave
+	218: 600, // Uacute
+	219: 600, // Ucircumflex
+	220: 600, // Udieresis
+	221: 600, // Yacute
+	222: 600, // Thorn
+	223: 600, // germandbls
+	224: 600, // agrave
+	225: 600, // aacute
+	226: 600, // acircumflex
+	227: 600, // atilde
+	228: 600, // adieresis
+	229: 600, // aring
+	230: 600, // ae
+	231: 600, // ccedilla
+	232: 600, // egrave
+	233: 600, // eacute
+	234: 600, // ecircumflex
+	235: 600, // edieresis
+	236: 600, // igrave
+	237: 600, // iacute
+	238: 600, // icircumflex
+	239: 600, // idieresis
+	240: 600, // eth
+	241: 600, // ntilde
+	242: 600, // ograve
+	243: 600, // oacute
+	244: 600, // ocircumflex
+	245: 600, // otilde
+	246: 600, // odieresis
+	247: 600, // divide
+	248: 600, // oslash
+	249: 600, // ugrave
+	250: 600, // uacute
+	251: 600, // ucircumflex
+	252: 600, // udieresis
+	253: 600, // yacute
+	254: 600, // thorn
+	255: 600, // ydieresis
 }
 
 // Courier-Bold
+var courierBoldMetrics = FontMetrics{
+	Ascender:    629,
+	Descender:   -157,
+	CapHeight:   562,
+	XHeight:     439,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-113, -250}, Point{749, 801}},
+}
+
 var courierBoldWidths = []uint16{
 	32:  600, // space
 	33:  600, // exclam
@@ -108,7 +249,7 @@ var courierBoldWidths = []uint16{
 	36:  600, // dollar
 	37:  600, // percent
 	38:  600, // ampersand
-	39:  600, // quoteright
+	39:  600, // quotesingle
 	40:  600, // parenleft
 	41:  600, // parenright
 	42:  600, // asterisk
@@ -165,7 +306,7 @@ var courierBoldWidths = []uint16{
 	93:  600, // bracketright
 	94:  600, // asciicircum
 	95:  600, // underscore
-	96:  600, // quoteleft
+	96:  600, // grave
 	97:  600, // a
 	98:  600, // b
 	99:  600, // c
@@ -196,9 +337,141 @@ var courierBoldWidths = []uint16{
 	124: 600, // bar
 	125: 600, // braceright
 	126: 600, // asciitilde
+	128: 600, // Euro
+	130: 600, // quotesinglbase
+	131: 600, // florin
+	132: 600, // quotedblbase
+	133: 600, // ellipsis
+	134: 600, // dagger
+	135: 600, // daggerdbl
+	136: 600, // circumflex
+	137: 600, // perthousand
+	138: 600, // Scaron
+	139: 600, // guilsinglleft
+	140: 600, // OE
+	142: 600, // Zcaron
+	145: 600, // quoteleft
+	146: 600, // quoteright
+	147: 600, // quotedblleft
+	148: 600, // quotedblright
+	149: 600, // bullet
+	150: 600, // endash
+	151: 600, // emdash
+	152: 600, // tilde
+	153: 600, // trademark
+	154: 600, // scaron
+	155: 600, // guilsinglright
+	156: 600, // oe
+	158: 600, // zcaron
+	159: 600, // Ydieresis
+	160: 600, // space
+	161: 600, // exclamdown
+	162: 600, // cent
+	163: 600, // sterling
+	164: 600, // currency
+	165: 600, // yen
+	166: 600, // brokenbar
+	167: 600, // section
+	168: 600, // dieresis
+	169: 600, // copyright
+	170: 600, // ordfeminine
+	171: 600, // guillemotleft
+	172: 600, // logicalnot
+	173: 600, // hyphen
+	174: 600, // registered
+	175: 600, // macron
+	176: 600, // degree
+	177: 600, // plusminus
+	178: 600, // twosuperior
+	179: 600, // threesuperior
+	180: 600, // acute
+	181: 600, // mu
+	182: 600, // paragraph
+	183: 600, // periodcentered
+	184: 600, // cedilla
+	185: 600, // onesuperior
+	186: 600, // ordmasculine
+	187: 600, // guillemotright
+	188: 600, // onequarter
+	189: 600, // onehalf
+	190: 600, // threequarters
+	191: 600, // questiondown
+	192: 600, // Agrave
+	193: 600, // Aacute
+	194: 600, // Acircumflex
+	195: 600, // Atilde
+	196: 600, // Adieresis
+	197: 600, // Aring
+	198: 600, // AE
+	199: 600, // Ccedilla
+	200: 600, // Egrave
+	201: 600, // Eacute
+	202: 600, // Ecircumflex
+	203: 600, // Edieresis
+	204: 600, // Igrave
+	205: 600, // Iacute
+	206: 600, // Icircumflex
+	207: 600, // Idieresis
+	208: 600, // Eth
+	209: 600, // Ntilde
+	210: 600, // Ograve
+	211: 600, // Oacute
+	212: 600, // Ocircumflex
+	213: 600, // Otilde
+	214: 600, // Odieresis
+	215: 600, // multiply
+	216: 600, // Oslash
+	217: 600, // Ugrave
+	218: 600, // Uacute
+	219: 600, // Ucircumflex
+	220: 600, // Udieresis
+	221: 600, // Yacute
+	222: 600, // Thorn
+	223: 600, // germandbls
+	224: 600, // agrave
+	225: 600, // aacute
+	226: 600, // acircumflex
+	227: 600, // atilde
+	228: 600, // adieresis
+	229: 600, // aring
+	230: 600, // ae
+	231: 600, // ccedilla
+	232: 600, // egrave
+	233: 600, // eacute
+	234: 600, // ecircumflex
+	235: 600, // edieresis
+	236: 600, // igrave
+	237: 600, // iacute
+	238: 600, // icircumflex
+	239: 600, // idieresis
+	240: 600, // eth
+	241: 600, // ntilde
+	242: 600, // ograve
+	243: 600, // oacute
+	244: 600, // ocircumflex
+	245: 600, // otilde
+	246: 600, // odieresis
+	247: 600, // divide
+	248: 600, // oslash
+	249: 600, // ugrave
+	250: 600, // uacute
+	251: 600, // ucircumflex
+	252: 600, // udieresis
+	253: 600, // yacute
+	254: 600, // thorn
+	255: 600, // ydieresis
 }
 
 // Courier-BoldOblique
+var courierBoldObliqueMetrics = FontMetrics{
+	Ascender:    629,
+	Descender:   -157,
+	CapHeight:   562,
+	XHeight:     439,
+	ItalicAngle: -12,
+	FontBBox:    Rectangle{Point{-57, -250}, Point{869, 801}},
+}
+
 var courierBoldObliqueWidths = []uint16{
 	32:  600, // space
 	33:  600, // exclam
@@ -207,7 +480,7 @@ var courierBoldObliqueWidths = []uint16{
 	36:  600, // dollar
 	37:  600, // percent
 	38:  600, // ampersand
-	39:  600, // quoteright
+	39:  600, // quotesingle
 	40:  600, // parenleft
 	41:  600, // parenright
 	42:  600, // asterisk
@@ -264,7 +537,7 @@ var courierBoldObliqueWidths = []uint16{
 	93:  600, // bracketright
 	94:  600, // asciicircum
 	95:  600, // underscore
-	96:  600, // quoteleft
+	96:  600, // grave
 	97:  600, // a
 	98:  600, // b
 	99:  600, // c
@@ -295,9 +568,141 @@ var courierBoldObliqueWidths = []uint16{
 	124: 600, // bar
 	125: 600, // braceright
 	126: 600, // asciitilde
+	128: 600, // Euro
+	130: 600, // quotesinglbase
+	131: 600, // florin
+	132: 600, // quotedblbase
+	133: 600, // ellipsis
+	134: 600, // dagger
+	135: 600, // daggerdbl
+	136: 600, // circumflex
+	137: 600, // perthousand
+	138: 600, // Scaron
+	139: 600, // guilsinglleft
+	140: 600, // OE
+	142: 600, // Zcaron
+	145: 600, // quoteleft
+	146: 600, // quoteright
+	147: 600, // quotedblleft
+	148: 600, // quotedblright
+	149: 600, // bullet
+	150: 600, // endash
+	151: 600, // emdash
+	152: 600, // tilde
+	153: 600, // trademark
+	154: 600, // scaron
+	155: 600, // guilsinglright
+	156: 600, // oe
+	158: 600, // zcaron
+	159: 600, // Ydieresis
+	160: 600, // space
+	161: 600, // exclamdown
+	162: 600, // cent
+	163: 600, // sterling
+	164: 600, // currency
+	165: 600, // yen
+	166: 600, // brokenbar
+	167: 600, // section
+	168: 600, // dieresis
+	169: 600, // copyright
+	170: 600, // ordfeminine
+	171: 600, // guillemotleft
+	172: 600, // logicalnot
+	173: 600, // hyphen
+	174: 600, // registered
+	175: 600, // macron
+	176: 600, // degree
+	177: 600, // plusminus
+	178: 600, // twosuperior
+	179: 600, // threesuperior
+	180: 600, // acute
+	181: 600, // mu
+	182: 600, // paragraph
+	183: 600, // periodcentered
+	184: 600, // cedilla
+	185: 600, // onesuperior
+	186: 600, // ordmasculine
+	187: 600, // guillemotright
+	188: 600, // onequarter
+	189: 600, // onehalf
+	190: 600, // threequarters
+	191: 600, // questiondown
+	192: 600, // Agrave
+	193: 600, // Aacute
+	194: 600, // Acircumflex
+	195: 600, // Atilde
+	196: 600, // Adieresis
+	197: 600, // Aring
+	198: 600, // AE
+	199: 600, // Ccedilla
+	200: 600, // Egrave
+	201: 600, // Eacute
+	202: 600, // Ecircumflex
+	203: 600, // Edieresis
+	204: 600, // Igrave
+	205: 600, // Iacute
+	206: 600, // Icircumflex
+	207: 600, // Idieresis
+	208: 600, // Eth
+	209: 600, // Ntilde
+	210: 600, // Ograve
+	211: 600, // Oacute
+	212: 600, // Ocircumflex
+	213: 600, // Otilde
+	214: 600, // Odieresis
+	215: 600, // multiply
+	216: 600, // Oslash
+	217: 600, // Ugrave
+	218: 600, // Uacute
+	219: 600, // Ucircumflex
+	220: 600, // Udieresis
+	221: 600, // Yacute
+	222: 600, // Thorn
+	223: 600, // germandbls
+	224: 600, // agrave
+	225: 600, // aacute
+	226: 600, // acircumflex
+	227: 600, // atilde
+	228: 600, // adieresis
+	229: 600, // aring
+	230: 600, // ae
+	231: 600, // ccedilla
+	232: 600, // egrave
+	233: 600, // eacute
+	234: 600, // ecircumflex
+	235: 600, // edieresis
+	236: 600, // igrave
+	237: 600, // iacute
+	238: 600, // icircumflex
+	239: 600, // idieresis
+	240: 600, // eth
+	241: 600, // ntilde
+	242: 600, // ograve
+	243: 600, // oacute
+	244: 600, // ocircumflex
+	245: 600, // otilde
+	246: 600, // odieresis
+	247: 600, // divide
+	248: 600, // oslash
+	249: 600, // ugrave
+	250: 600, // uacute
+	251: 600, // ucircumflex
+	252: 600, // udieresis
+	253: 600, // yacute
+	254: 600, // thorn
+	255: 600, // ydieresis
 }
 
 // Courier-Oblique
+var courierObliqueMetrics = FontMetrics{
+	Ascender:    629,
+	Descender:   -157,
+	CapHeight:   562,
+	XHeight:     426,
+	ItalicAngle: -12,
+	FontBBox:    Rectangle{Point{-27, -250}, Point{849, 805}},
+}
+
 var courierObliqueWidths = []uint16{
 	32:  600, // space
 	33:  600, // exclam
@@ -306,7 +711,7 @@ var courierObliqueWidths = []uint16{
 	36:  600, // dollar
 	37:  600, // percent
 	38:  600, // ampersand
-	39:  600, // quoteright
+	39:  600, // quotesingle
 	40:  600, // parenleft
 	41:  600, // parenright
 	42:  600, // asterisk
@@ -363,7 +768,7 @@ var courierObliqueWidths = []uint16{
 	93:  600, // bracketright
 	94:  600, // asciicircum
 	95:  600, // underscore
-	96:  600, // quoteleft
+	96:  600, // grave
 	97:  600, // a
 	98:  600, // b
 	99:  600, // c
@@ -394,9 +799,141 @@ var courierObliqueWidths = []uint16{
 	124: 600, // bar
 	125: 600, // braceright
 	126: 600, // asciitilde
+	128: 600, // Euro
+	130: 600, // quotesinglbase
+	131: 600, // florin
+	132: 600, // quotedblbase
+	133: 600, // ellipsis
+	134: 600, // dagger
+	135: 600, // daggerdbl
+	136: 600, // circumflex
+	137: 600, // perthousand
+	138: 600, // Scaron
+	139: 600, // guilsinglleft
+	140: 600, // OE
+	142: 600, // Zcaron
+	145: 600, // quoteleft
+	146: 600, // quoteright
+	147: 600, // quotedblleft
+	148: 600, // quotedblright
+	149: 600, // bullet
+	150: 600, // endash
+	151: 600, // emdash
+	152: 600, // tilde
+	153: 600, // trademark
+	154: 600, // scaron
+	155: 600, // guilsinglright
+	156: 600, // oe
+	158: 600, // zcaron
+	159: 600, // Ydieresis
+	160: 600, // space
+	161: 600, // exclamdown
+	162: 600, // cent
+	163: 600, // sterling
+	164: 600, // currency
+	165: 600, // yen
+	166: 600, // brokenbar
+	167: 600, // section
+	168: 600, // dieresis
+	169: 600, // copyright
+	170: 600, // ordfeminine
+	171: 600, // guillemotleft
+	172: 600, // logicalnot
+	173: 600, // hyphen
+	174: 600, // registered
+	175: 600, // macron
+	176: 600, // degree
+	177: 600, // plusminus
+	178: 600, // twosuperior
+	179: 600, // threesuperior
+	180: 600, // acute
+	181: 600, // mu
+	182: 600, // paragraph
+	183: 600, // periodcentered
+	184: 600, // cedilla
+	185: 600, // onesuperior
+	186: 600, // ordmasculine
+	187: 600, // guillemotright
+	188: 600, // onequarter
+	189: 600, // onehalf
+	190: 600, // threequarters
+	191: 600, // questiondown
+	192: 600, // Agrave
+	193: 600, // Aacute
+	194: 600, // Acircumflex
+	195: 600, // Atilde
+	196: 600, // Adieresis
+	197: 600, // Aring
+	198: 600, // AE
+	199: 600, // Ccedilla
+	200: 600, // Egrave
+	201: 600, // Eacute
+	202: 600, // Ecircumflex
+	203: 600, // Edieresis
+	204: 600, // Igrave
+	205: 600, // Iacute
+	206: 600, // Icircumflex
+	207: 600, // Idieresis
+	208: 600, // Eth
+	209: 600, // Ntilde
+	210: 600, // Ograve
+	211: 600, // Oacute
+	212: 600, // Ocircumflex
+	213: 600, // Otilde
+	214: 600, // Odieresis
+	215: 600, // multiply
+	216: 600, // Oslash
+	217: 600, // Ugrave
+	218: 600, // Uacute
+	219: 600, // Ucircumflex
+	220: 600, // Udieresis
+	221: 600, // Yacute
+	222: 600, // Thorn
+	223: 600, // germandbls
+	224: 600, // agrave
+	225: 600, // aacute
+	226: 600, // acircumflex
+	227: 600, // atilde
+	228: 600, // adieresis
+	229: 600, // aring
+	230: 600, // ae
+	231: 600, // ccedilla
+	232: 600, // egrave
+	233: 600, // eacute
+	234: 600, // ecircumflex
+	235: 600, // edieresis
+	236: 600, // igrave
+	237: 600, // iacute
+	238: 600, // icircumflex
+	239: 600, // idieresis
+	240: 600, // eth
+	241: 600, // ntilde
+	242: 600, // ograve
+	243: 600, // oacute
+	244: 600, // ocircumflex
+	245: 600, // otilde
+	246: 600, // odieresis
+	247: 600, // divide
+	248: 600, // oslash
+	249: 600, // ugrave
+	250: 600, // uacute
+	251: 600, // ucircumflex
+	252: 600, // udieresis
+	253: 600, // yacute
+	254: 600, // thorn
+	255: 600, // ydieresis
 }
 
 // Helvetica
+var helveticaMetrics = FontMetrics{
+	Ascender:    718,
+	Descender:   -207,
+	CapHeight:   718,
+	XHeight:     523,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-166, -225}, Point{1000, 931}},
+}
+
 var helveticaWidths = []uint16{
 	32:  278,  // space
 	33:  278,  // exclam
@@ -405,7 +942,7 @@ var helveticaWidths = []uint16{
 	36:  556,  // dollar
 	37:  889,  // percent
 	38:  667,  // ampersand
-	39:  222,  // quoteright
+	39:  191,  // quotesingle
 	40:  333,  // parenleft
 	41:  333,  // parenright
 	42:  389,  // asterisk
@@ -462,7 +999,7 @@ var helveticaWidths = []uint16{
 	93:  278,  // bracketright
 	94:  469,  // asciicircum
 	95:  556,  // underscore
-	96:  222,  // quoteleft
+	96:  333,  // grave
 	97:  556,  // a
 	98:  556,  // b
 	99:  500,  // c
@@ -493,207 +1030,4274 @@ var helveticaWidths = []uint16{
 	124: 260,  // bar
 	125: 334,  // braceright
 	126: 584,  // asciitilde
+	128: 556,  // Euro
+	130: 222,  // quotesinglbase
+	131: 556,  // florin
+	132: 333,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 556,  // dagger
+	135: 556,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 667,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 1000, // OE
+	142: 611,  // Zcaron
+	145: 222,  // quoteleft
+	146: 222,  // quoteright
+	147: 333,  // quotedblleft
+	148: 333,  // quotedblright
+	149: 350,  // bullet
+	150: 556,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 1000, // trademark
+	154: 500,  // scaron
+	155: 333,  // guilsinglright
+	156: 944,  // oe
+	158: 500,  // zcaron
+	159: 667,  // Ydieresis
+	160: 278,  // space
+	161: 333,  // exclamdown
+	162: 556,  // cent
+	163: 556,  // sterling
+	164: 556,  // currency
+	165: 556,  // yen
+	166: 260,  // brokenbar
+	167: 556,  // section
+	168: 333,  // dieresis
+	169: 737,  // copyright
+	170: 370,  // ordfeminine
+	171: 556,  // guillemotleft
+	172: 584,  // logicalnot
+	173: 333,  // hyphen
+	174: 737,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 584,  // plusminus
+	178: 333,  // twosuperior
+	179: 333,  // threesuperior
+	180: 333,  // acute
+	181: 556,  // mu
+	182: 537,  // paragraph
+	183: 278,  // periodcentered
+	184: 333,  // cedilla
+	185: 333,  // onesuperior
+	186: 365,  // ordmasculine
+	187: 556,  // guillemotright
+	188: 834,  // onequarter
+	189: 834,  // onehalf
+	190: 834,  // threequarters
+	191: 611,  // questiondown
+	192: 667,  // Agrave
+	193: 667,  // Aacute
+	194: 667,  // Acircumflex
+	195: 667,  // Atilde
+	196: 667,  // Adieresis
+	197: 667,  // Aring
+	198: 1000, // AE
+	199: 722,  // Ccedilla
+	200: 667,  // Egrave
+	201: 667,  // Eacute
+	202: 667,  // Ecircumflex
+	203: 667,  // Edieresis
+	204: 278,  // Igrave
+	205: 278,  // Iacute
+	206: 278,  // Icircumflex
+	207: 278,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 778,  // Ograve
+	211: 778,  // Oacute
+	212: 778,  // Ocircumflex
+	213: 778,  // Otilde
+	214: 778,  // Odieresis
+	215: 584,  // multiply
+	216: 778,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 667,  // Yacute
+	222: 667,  // Thorn
+	223: 611,  // germandbls
+	224: 556,  // agrave
+	225: 556,  // aacute
+	226: 556,  // acircumflex
+	227: 556,  // atilde
+	228: 556,  // adieresis
+	229: 556,  // aring
+	230: 889,  // ae
+	231: 500,  // ccedilla
+	232: 556,  // egrave
+	233: 556,  // eacute
+	234: 556,  // ecircumflex
+	235: 556,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 556,  // eth
+	241: 556,  // ntilde
+	242: 556,  // ograve
+	243: 556,  // oacute
+	244: 556,  // ocircumflex
+	245: 556,  // otilde
+	246: 556,  // odieresis
+	247: 584,  // divide
+	248: 611,  // oslash
+	249: 556,  // ugrave
+	250: 556,  // uacute
+	251: 556,  // ucircumflex
+	252: 556,  // udieresis
+	253: 500,  // yacute
+	254: 556,  // thorn
+	255: 500,  // ydieresis
+}
+
+var helveticaKerning = map[kernPair]int16{
+	{32, 84}:   -50,  // space T
+	{32, 86}:   -50,  // space V
+	{32, 87}:   -40,  // space W
+	{32, 89}:   -90,  // space Y
+	{32, 145}:  -60,  // space quoteleft
+	{32, 147}:  -30,  // space quotedblleft
+	{32, 159}:  -90,  // space Ydieresis
+	{32, 221}:  -90,  // space Yacute
+	{44, 146}:  -100, // comma quoteright
+	{44, 148}:  -100, // comma quotedblright
+	{46, 32}:   -60,  // period space
+	{46, 146}:  -100, // period quoteright
+	{46, 148}:  -100, // period quotedblright
+	{46, 160}:  -60,  // period space
+	{58, 32}:   -50,  // colon space
+	{58, 160}:  -50,  // colon space
+	{59, 32}:   -50,  // semicolon space
+	{59, 160}:  -50,  // semicolon space
+	{65, 67}:   -30,  // A C
+	{65, 71}:   -30,  // A G
+	{65, 79}:   -30,  // A O
+	{65, 81}:   -30,  // A Q
+	{65, 84}:   -120, // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -70,  // A V
+	{65, 87}:   -50,  // A W
+	{65, 89}:   -100, // A Y
+	{65, 117}:  -30,  // A u
+	{65, 118}:  -40,  // A v
+	{65, 119}:  -40,  // A w
+	{65, 121}:  -40,  // A y
+	{65, 159}:  -100, // A Ydieresis
+	{65, 199}:  -30,  // A Ccedilla
+	{65, 210}:  -30,  // A Ograve
+	{65, 211}:  -30,  // A Oacute
+	{65, 212}:  -30,  // A Ocircumflex
+	{65, 213}:  -30,  // A Otilde
+	{65, 214}:  -30,  // A Odieresis
+	{65, 216}:  -30,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -100, // A Yacute
+	{65, 249}:  -30,  // A ugrave
+	{65, 250}:  -30,  // A uacute
+	{65, 251}:  -30,  // A ucircumflex
+	{65, 252}:  -30,  // A udieresis
+	{65, 253}:  -40,  // A yacute
+	{65, 255}:  -40,  // A ydieresis
+	{66, 44}:   -20,  // B comma
+	{66, 46}:   -20,  // B period
+	{66, 85}:   -10,  // B U
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{67, 44}:   -30,  // C comma
+	{67, 46}:   -30,  // C period
+	{68, 44}:   -70,  // D comma
+	{68, 46}:   -70,  // D period
+	{68, 65}:   -40,  // D A
+	{68, 86}:   -70,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -90,  // D Y
+	{68, 159}:  -90,  // D Ydieresis
+	{68, 192}:  -40,  // D Agrave
+	{68, 193}:  -40,  // D Aacute
+	{68, 194}:  -40,  // D Acircumflex
+	{68, 195}:  -40,  // D Atilde
+	{68, 196}:  -40,  // D Adieresis
+	{68, 197}:  -40,  // D Aring
+	{68, 221}:  -90,  // D Yacute
+	{70, 44}:   -150, // F comma
+	{70, 46}:   -150, // F period
+	{70, 65}:   -80,  // F A
+	{70, 97}:   -50,  // F a
+	{70, 101}:  -30,  // F e
+	{70, 111}:  -30,  // F o
+	{70, 114}:  -45,  // F r
+	{70, 192}:  -80,  // F Agrave
+	{70, 193}:  -80,  // F Aacute
+	{70, 194}:  -80,  // F Acircumflex
+	{70, 195}:  -80,  // F Atilde
+	{70, 196}:  -80,  // F Adieresis
+	{70, 197}:  -80,  // F Aring
+	{70, 224}:  -50,  // F agrave
+	{70, 225}:  -50,  // F aacute
+	{70, 226}:  -50,  // F acircumflex
+	{70, 227}:  -50,  // F atilde
+	{70, 228}:  -50,  // F adieresis
+	{70, 229}:  -50,  // F aring
+	{70, 232}:  -30,  // F egrave
+	{70, 233}:  -30,  // F eacute
+	{70, 234}:  -30,  // F ecircumflex
+	{70, 235}:  -30,  // F edieresis
+	{70, 242}:  -30,  // F ograve
+	{70, 243}:  -30,  // F oacute
+	{70, 244}:  -30,  // F ocircumflex
+	{70, 245}:  -30,  // F otilde
+	{70, 246}:  -30,  // F odieresis
+	{70, 248}:  -30,  // F oslash
+	{74, 44}:   -30,  // J comma
+	{74, 46}:   -30,  // J period
+	{74, 65}:   -20,  // J A
+	{74, 97}:   -20,  // J a
+	{74, 117}:  -20,  // J u
+	{74, 192}:  -20,  // J Agrave
+	{74, 193}:  -20,  // J Aacute
+	{74, 194}:  -20,  // J Acircumflex
+	{74, 195}:  -20,  // J Atilde
+	{74, 196}:  -20,  // J Adieresis
+	{74, 197}:  -20,  // J Aring
+	{74, 224}:  -20,  // J agrave
+	{74, 225}:  -20,  // J aacute
+	{74, 226}:  -20,  // J acircumflex
+	{74, 227}:  -20,  // J atilde
+	{74, 228}:  -20,  // J adieresis
+	{74, 229}:  -20,  // J aring
+	{74, 249}:  -20,  // J ugrave
+	{74, 250}:  -20,  // J uacute
+	{74, 251}:  -20,  // J ucircumflex
+	{74, 252}:  -20,  // J udieresis
+	{75, 79}:   -50,  // K O
+	{75, 101}:  -40,  // K e
+	{75, 111}:  -40,  // K o
+	{75, 117}:  -30,  // K u
+	{75, 121}:  -50,  // K y
+	{75, 210}:  -50,  // K Ograve
+	{75, 211}:  -50,  // K Oacute
+	{75, 212}:  -50,  // K Ocircumflex
+	{75, 213}:  -50,  // K Otilde
+	{75, 214}:  -50,  // K Odieresis
+	{75, 216}:  -50,  // K Oslash
+	{75, 232}:  -40,  // K egrave
+	{75, 233}:  -40,  // K eacute
+	{75, 234}:  -40,  // K ecircumflex
+	{75, 235}:  -40,  // K edieresis
+	{75, 242}:  -40,  // K ograve
+	{75, 243}:  -40,  // K oacute
+	{75, 244}:  -40,  // K ocircumflex
+	{75, 245}:  -40,  // K otilde
+	{75, 246}:  -40,  // K odieresis
+	{75, 248}:  -40,  // K oslash
+	{75, 249}:  -30,  // K ugrave
+	{75, 250}:  -30,  // K uacute
+	{75, 251}:  -30,  // K ucircumflex
+	{75, 252}:  -30,  // K udieresis
+	{75, 253}:  -50,  // K yacute
+	{75, 255}:  -50,  // K ydieresis
+	{76, 84}:   -110, // L T
+	{76, 86}:   -110, // L V
+	{76, 87}:   -70,  // L W
+	{76, 89}:   -140, // L Y
+	{76, 121}:  -30,  // L y
+	{76, 146}:  -160, // L quoteright
+	{76, 148}:  -140, // L quotedblright
+	{76, 159}:  -140, // L Ydieresis
+	{76, 221}:  -140, // L Yacute
+	{76, 253}:  -30,  // L yacute
+	{76, 255}:  -30,  // L ydieresis
+	{79, 44}:   -40,  // O comma
+	{79, 46}:   -40,  // O period
+	{79, 65}:   -20,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -30,  // O W
+	{79, 88}:   -60,  // O X
+	{79, 89}:   -70,  // O Y
+	{79, 159}:  -70,  // O Ydieresis
+	{79, 192}:  -20,  // O Agrave
+	{79, 193}:  -20,  // O Aacute
+	{79, 194}:  -20,  // O Acircumflex
+	{79, 195}:  -20,  // O Atilde
+	{79, 196}:  -20,  // O Adieresis
+	{79, 197}:  -20,  // O Aring
+	{79, 221}:  -70,  // O Yacute
+	{80, 44}:   -180, // P comma
+	{80, 46}:   -180, // P period
+	{80, 65}:   -120, // P A
+	{80, 97}:   -40,  // P a
+	{80, 101}:  -50,  // P e
+	{80, 111}:  -50,  // P o
+	{80, 192}:  -120, // P Agrave
+	{80, 193}:  -120, // P Aacute
+	{80, 194}:  -120, // P Acircumflex
+	{80, 195}:  -120, // P Atilde
+	{80, 196}:  -120, // P Adieresis
+	{80, 197}:  -120, // P Aring
+	{80, 224}:  -40,  // P agrave
+	{80, 225}:  -40,  // P aacute
+	{80, 226}:  -40,  // P acircumflex
+	{80, 227}:  -40,  // P atilde
+	{80, 228}:  -40,  // P adieresis
+	{80, 229}:  -40,  // P aring
+	{80, 232}:  -50,  // P egrave
+	{80, 233}:  -50,  // P eacute
+	{80, 234}:  -50,  // P ecircumflex
+	{80, 235}:  -50,  // P edieresis
+	{80, 242}:  -50,  // P ograve
+	{80, 243}:  -50,  // P oacute
+	{80, 244}:  -50,  // P ocircumflex
+	{80, 245}:  -50,  // P otilde
+	{80, 246}:  -50,  // P odieresis
+	{80, 248}:  -50,  // P oslash
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -20,  // R O
+	{82, 84}:   -30,  // R T
+	{82, 85}:   -40,  // R U
+	{82, 86}:   -50,  // R V
+	{82, 87}:   -30,  // R W
+	{82, 89}:   -50,  // R Y
+	{82, 159}:  -50,  // R Ydieresis
+	{82, 210}:  -20,  // R Ograve
+	{82, 211}:  -20,  // R Oacute
+	{82, 212}:  -20,  // R Ocircumflex
+	{82, 213}:  -20,  // R Otilde
+	{82, 214}:  -20,  // R Odieresis
+	{82, 216}:  -20,  // R Oslash
+	{82, 217}:  -40,  // R Ugrave
+	{82, 218}:  -40,  // R Uacute
+	{82, 219}:  -40,  // R Ucircumflex
+	{82, 220}:  -40,  // R Udieresis
+	{82, 221}:  -50,  // R Yacute
+	{83, 44}:   -20,  // S comma
+	{83, 46}:   -20,  // S period
+	{84, 44}:   -120, // T comma
+	{84, 45}:   -140, // T hyphen
+	{84, 46}:   -120, // T period
+	{84, 58}:   -20,  // T colon
+	{84, 59}:   -20,  // T semicolon
+	{84, 65}:   -120, // T A
+	{84, 79}:   -40,  // T O
+	{84, 97}:   -120, // T a
+	{84, 101}:  -120, // T e
+	{84, 111}:  -120, // T o
+	{84, 114}:  -120, // T r
+	{84, 117}:  -120, // T u
+	{84, 119}:  -120, // T w
+	{84, 121}:  -120, // T y
+	{84, 173}:  -140, // T hyphen
+	{84, 192}:  -120, // T Agrave
+	{84, 193}:  -120, // T Aacute
+	{84, 194}:  -120, // T Acircumflex
+	{84, 195}:  -120, // T Atilde
+	{84, 196}:  -120, // T Adieresis
+	{84, 197}:  -120, // T Aring
+	{84, 210}:  -40,  // T Ograve
+	{84, 211}:  -40,  // T Oacute
+	{84, 212}:  -40,  // T Ocircumflex
+	{84, 213}:  -40,  // T Otilde
+	{84, 214}:  -40,  // T Odieresis
+	{84, 216}:  -40,  // T Oslash
+	{84, 224}:  -120, // T agrave
+	{84, 225}:  -120, // T aacute
+	{84, 226}:  -120, // T acircumflex
+	{84, 227}:  -60,  // T atilde
+	{84, 228}:  -120, // T adieresis
+	{84, 229}:  -120, // T aring
+	{84, 232}:  -60,  // T egrave
+	{84, 233}:  -120, // T eacute
+	{84, 234}:  -120, // T ecircumflex
+	{84, 235}:  -120, // T edieresis
+	{84, 242}:  -120, // T ograve
+	{84, 243}:  -120, // T oacute
+	{84, 244}:  -120, // T ocircumflex
+	{84, 245}:  -60,  // T otilde
+	{84, 246}:  -120, // T odieresis
+	{84, 248}:  -120, // T oslash
+	{84, 249}:  -120, // T ugrave
+	{84, 250}:  -120, // T uacute
+	{84, 251}:  -120, // T ucircumflex
+	{84, 252}:  -120, // T udieresis
+	{84, 253}:  -120, // T yacute
+	{84, 255}:  -60,  // T ydieresis
+	{85, 44}:   -40,  // U comma
+	{85, 46}:   -40,  // U period
+	{85, 65}:   -40,  // U A
+	{85, 192}:  -40,  // U Agrave
+	{85, 193}:  -40,  // U Aacute
+	{85, 194}:  -40,  // U Acircumflex
+	{85, 195}:  -40,  // U Atilde
+	{85, 196}:  -40,  // U Adieresis
+	{85, 197}:  -40,  // U Aring
+	{86, 44}:   -125, // V comma
+	{86, 45}:   -80,  // V hyphen
+	{86, 46}:   -125, // V period
+	{86, 58}:   -40,  // V colon
+	{86, 59}:   -40,  // V semicolon
+	{86, 65}:   -80,  // V A
+	{86, 71}:   -40,  // V G
+	{86, 79}:   -40,  // V O
+	{86, 97}:   -70,  // V a
+	{86, 101}:  -80,  // V e
+	{86, 111}:  -80,  // V o
+	{86, 117}:  -70,  // V u
+	{86, 173}:  -80,  // V hyphen
+	{86, 192}:  -80,  // V Agrave
+	{86, 193}:  -80,  // V Aacute
+	{86, 194}:  -80,  // V Acircumflex
+	{86, 195}:  -80,  // V Atilde
+	{86, 196}:  -80,  // V Adieresis
+	{86, 197}:  -80,  // V Aring
+	{86, 210}:  -40,  // V Ograve
+	{86, 211}:  -40,  // V Oacute
+	{86, 212}:  -40,  // V Ocircumflex
+	{86, 213}:  -40,  // V Otilde
+	{86, 214}:  -40,  // V Odieresis
+	{86, 216}:  -40,  // V Oslash
+	{86, 224}:  -70,  // V agrave
+	{86, 225}:  -70,  // V aacute
+	{86, 226}:  -70,  // V acircumflex
+	{86, 227}:  -70,  // V atilde
+	{86, 228}:  -70,  // V adieresis
+	{86, 229}:  -70,  // V aring
+	{86, 232}:  -80,  // V egrave
+	{86, 233}:  -80,  // V eacute
+	{86, 234}:  -80,  // V ecircumflex
+	{86, 235}:  -80,  // V edieresis
+	{86, 242}:  -80,  // V ograve
+	{86, 243}:  -80,  // V oacute
+	{86, 244}:  -80,  // V ocircumflex
+	{86, 245}:  -80,  // V otilde
+	{86, 246}:  -80,  // V odieresis
+	{86, 248}:  -80,  // V oslash
+	{86, 249}:  -70,  // V ugrave
+	{86, 250}:  -70,  // V uacute
+	{86, 251}:  -70,  // V ucircumflex
+	{86, 252}:  -70,  // V udieresis
+	{87, 44}:   -80,  // W comma
+	{87, 45}:   -40,  // W hyphen
+	{87, 46}:   -80,  // W period
+	{87, 65}:   -50,  // W A
+	{87, 79}:   -20,  // W O
+	{87, 97}:   -40,  // W a
+	{87, 101}:  -30,  // W e
+	{87, 111}:  -30,  // W o
+	{87, 117}:  -30,  // W u
+	{87, 121}:  -20,  // W y
+	{87, 173}:  -40,  // W hyphen
+	{87, 192}:  -50,  // W Agrave
+	{87, 193}:  -50,  // W Aacute
+	{87, 194}:  -50,  // W Acircumflex
+	{87, 195}:  -50,  // W Atilde
+	{87, 196}:  -50,  // W Adieresis
+	{87, 197}:  -50,  // W Aring
+	{87, 210}:  -20,  // W Ograve
+	{87, 211}:  -20,  // W Oacute
+	{87, 212}:  -20,  // W Ocircumflex
+	{87, 213}:  -20,  // W Otilde
+	{87, 214}:  -20,  // W Odieresis
+	{87, 216}:  -20,  // W Oslash
+	{87, 224}:  -40,  // W agrave
+	{87, 225}:  -40,  // W aacute
+	{87, 226}:  -40,  // W acircumflex
+	{87, 227}:  -40,  // W atilde
+	{87, 228}:  -40,  // W adieresis
+	{87, 229}:  -40,  // W aring
+	{87, 232}:  -30,  // W egrave
+	{87, 233}:  -30,  // W eacute
+	{87, 234}:  -30,  // W ecircumflex
+	{87, 235}:  -30,  // W edieresis
+	{87, 242}:  -30,  // W ograve
+	{87, 243}:  -30,  // W oacute
+	{87, 244}:  -30,  // W ocircumflex
+	{87, 245}:  -30,  // W otilde
+	{87, 246}:  -30,  // W odieresis
+	{87, 248}:  -30,  // W oslash
+	{87, 249}:  -30,  // W ugrave
+	{87, 250}:  -30,  // W uacute
+	{87, 251}:  -30,  // W ucircumflex
+	{87, 252}:  -30,  // W udieresis
+	{87, 253}:  -20,  // W yacute
+	{87, 255}:  -20,  // W ydieresis
+	{89, 44}:   -140, // Y comma
+	{89, 45}:   -140, // Y hyphen
+	{89, 46}:   -140, // Y period
+	{89, 58}:   -60,  // Y colon
+	{89, 59}:   -60,  // Y semicolon
+	{89, 65}:   -110, // Y A
+	{89, 79}:   -85,  // Y O
+	{89, 97}:   -140, // Y a
+	{89, 101}:  -140, // Y e
+	{89, 105}:  -20,  // Y i
+	{89, 111}:  -140, // Y o
+	{89, 117}:  -110, // Y u
+	{89, 173}:  -140, // Y hyphen
+	{89, 192}:  -110, // Y Agrave
+	{89, 193}:  -110, // Y Aacute
+	{89, 194}:  -110, // Y Acircumflex
+	{89, 195}:  -110, // Y Atilde
+	{89, 196}:  -110, // Y Adieresis
+	{89, 197}:  -110, // Y Aring
+	{89, 210}:  -85,  // Y Ograve
+	{89, 211}:  -85,  // Y Oacute
+	{89, 212}:  -85,  // Y Ocircumflex
+	{89, 213}:  -85,  // Y Otilde
+	{89, 214}:  -85,  // Y Odieresis
+	{89, 216}:  -85,  // Y Oslash
+	{89, 224}:  -140, // Y agrave
+	{89, 225}:  -140, // Y aacute
+	{89, 226}:  -140, // Y acircumflex
+	{89, 227}:  -140, // Y atilde
+	{89, 228}:  -140, // Y adieresis
+	{89, 229}:  -140, // Y aring
+	{89, 232}:  -140, // Y egrave
+	{89, 233}:  -140, // Y eacute
+	{89, 234}:  -140, // Y ecircumflex
+	{89, 235}:  -140, // Y edieresis
+	{89, 237}:  -20,  // Y iacute
+	{89, 242}:  -140, // Y ograve
+	{89, 243}:  -140, // Y oacute
+	{89, 244}:  -140, // Y ocircumflex
+	{89, 245}:  -140, // Y otilde
+	{89, 246}:  -140, // Y odieresis
+	{89, 248}:  -140, // Y oslash
+	{89, 249}:  -110, // Y ugrave
+	{89, 250}:  -110, // Y uacute
+	{89, 251}:  -110, // Y ucircumflex
+	{89, 252}:  -110, // Y udieresis
+	{97, 118}:  -20,  // a v
+	{97, 119}:  -20,  // a w
+	{97, 121}:  -30,  // a y
+	{97, 253}:  -30,  // a yacute
+	{97, 255}:  -30,  // a ydieresis
+	{98, 44}:   -40,  // b comma
+	{98, 46}:   -40,  // b period
+	{98, 98}:   -10,  // b b
+	{98, 108}:  -20,  // b l
+	{98, 117}:  -20,  // b u
+	{98, 118}:  -20,  // b v
+	{98, 121}:  -20,  // b y
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{98, 253}:  -20,  // b yacute
+	{98, 255}:  -20,  // b ydieresis
+	{99, 44}:   -15,  // c comma
+	{99, 107}:  -20,  // c k
+	{101, 44}:  -15,  // e comma
+	{101, 46}:  -15,  // e period
+	{101, 118}: -30,  // e v
+	{101, 119}: -20,  // e w
+	{101, 120}: -30,  // e x
+	{101, 121}: -20,  // e y
+	{101, 253}: -20,  // e yacute
+	{101, 255}: -20,  // e ydieresis
+	{102, 44}:  -30,  // f comma
+	{102, 46}:  -30,  // f period
+	{102, 97}:  -30,  // f a
+	{102, 101}: -30,  // f e
+	{102, 111}: -30,  // f o
+	{102, 146}: 50,   // f quoteright
+	{102, 148}: 60,   // f quotedblright
+	{102, 224}: -30,  // f agrave
+	{102, 225}: -30,  // f aacute
+	{102, 226}: -30,  // f acircumflex
+	{102, 227}: -30,  // f atilde
+	{102, 228}: -30,  // f adieresis
+	{102, 229}: -30,  // f aring
+	{102, 232}: -30,  // f egrave
+	{102, 233}: -30,  // f eacute
+	{102, 234}: -30,  // f ecircumflex
+	{102, 235}: -30,  // f edieresis
+	{102, 242}: -30,  // f ograve
+	{102, 243}: -30,  // f oacute
+	{102, 244}: -30,  // f ocircumflex
+	{102, 245}: -30,  // f otilde
+	{102, 246}: -30,  // f odieresis
+	{102, 248}: -30,  // f oslash
+	{103, 114}: -10,  // g r
+	{104, 121}: -30,  // h y
+	{104, 253}: -30,  // h yacute
+	{104, 255}: -30,  // h ydieresis
+	{107, 101}: -20,  // k e
+	{107, 111}: -20,  // k o
+	{107, 232}: -20,  // k egrave
+	{107, 233}: -20,  // k eacute
+	{107, 234}: -20,  // k ecircumflex
+	{107, 235}: -20,  // k edieresis
+	{107, 242}: -20,  // k ograve
+	{107, 243}: -20,  // k oacute
+	{107, 244}: -20,  // k ocircumflex
+	{107, 245}: -20,  // k otilde
+	{107, 246}: -20,  // k odieresis
+	{107, 248}: -20,  // k oslash
+	{109, 117}: -10,  // m u
+	{109, 121}: -15,  // m y
+	{109, 249}: -10,  // m ugrave
+	{109, 250}: -10,  // m uacute
+	{109, 251}: -10,  // m ucircumflex
+	{109, 252}: -10,  // m udieresis
+	{109, 253}: -15,  // m yacute
+	{109, 255}: -15,  // m ydieresis
+	{110, 117}: -10,  // n u
+	{110, 118}: -20,  // n v
+	{110, 121}: -15,  // n y
+	{110, 249}: -10,  // n ugrave
+	{110, 250}: -10,  // n uacute
+	{110, 251}: -10,  // n ucircumflex
+	{110, 252}: -10,  // n udieresis
+	{110, 253}: -15,  // n yacute
+	{110, 255}: -15,  // n ydieresis
+	{111, 44}:  -40,  // o comma
+	{111, 46}:  -40,  // o period
+	{111, 118}: -15,  // o v
+	{111, 119}: -15,  // o w
+	{111, 120}: -30,  // o x
+	{111, 121}: -30,  // o y
+	{111, 253}: -30,  // o yacute
+	{111, 255}: -30,  // o ydieresis
+	{112, 44}:  -35,  // p comma
+	{112, 46}:  -35,  // p period
+	{112, 121}: -30,  // p y
+	{112, 253}: -30,  // p yacute
+	{112, 255}: -30,  // p ydieresis
+	{114, 44}:  -50,  // r comma
+	{114, 46}:  -50,  // r period
+	{114, 58}:  30,   // r colon
+	{114, 59}:  30,   // r semicolon
+	{114, 97}:  -10,  // r a
+	{114, 105}: 15,   // r i
+	{114, 107}: 15,   // r k
+	{114, 108}: 15,   // r l
+	{114, 109}: 25,   // r m
+	{114, 110}: 25,   // r n
+	{114, 112}: 30,   // r p
+	{114, 116}: 40,   // r t
+	{114, 117}: 15,   // r u
+	{114, 118}: 30,   // r v
+	{114, 121}: 30,   // r y
+	{114, 224}: -10,  // r agrave
+	{114, 225}: -10,  // r aacute
+	{114, 226}: -10,  // r acircumflex
+	{114, 227}: -10,  // r atilde
+	{114, 228}: -10,  // r adieresis
+	{114, 229}: -10,  // r aring
+	{114, 236}: 15,   // r igrave
+	{114, 237}: 15,   // r iacute
+	{114, 238}: 15,   // r icircumflex
+	{114, 239}: 15,   // r idieresis
+	{114, 241}: 25,   // r ntilde
+	{114, 249}: 15,   // r ugrave
+	{114, 250}: 15,   // r uacute
+	{114, 251}: 15,   // r ucircumflex
+	{114, 252}: 15,   // r udieresis
+	{114, 253}: 30,   // r yacute
+	{114, 255}: 30,   // r ydieresis
+	{115, 44}:  -15,  // s comma
+	{115, 46}:  -15,  // s period
+	{115, 119}: -30,  // s w
+	{118, 44}:  -80,  // v comma
+	{118, 46}:  -80,  // v period
+	{118, 97}:  -25,  // v a
+	{118, 101}: -25,  // v e
+	{118, 111}: -25,  // v o
+	{118, 224}: -25,  // v agrave
+	{118, 225}: -25,  // v aacute
+	{118, 226}: -25,  // v acircumflex
+	{118, 227}: -25,  // v atilde
+	{118, 228}: -25,  // v adieresis
+	{118, 229}: -25,  // v aring
+	{118, 232}: -25,  // v egrave
+	{118, 233}: -25,  // v eacute
+	{118, 234}: -25,  // v ecircumflex
+	{118, 235}: -25,  // v edieresis
+	{118, 242}: -25,  // v ograve
+	{118, 243}: -25,  // v oacute
+	{118, 244}: -25,  // v ocircumflex
+	{118, 245}: -25,  // v otilde
+	{118, 246}: -25,  // v odieresis
+	{118, 248}: -25,  // v oslash
+	{119, 44}:  -60,  // w comma
+	{119, 46}:  -60,  // w period
+	{119, 97}:  -15,  // w a
+	{119, 101}: -10,  // w e
+	{119, 111}: -10,  // w o
+	{119, 224}: -15,  // w agrave
+	{119, 225}: -15,  // w aacute
+	{119, 226}: -15,  // w acircumflex
+	{119, 227}: -15,  // w atilde
+	{119, 228}: -15,  // w adieresis
+	{119, 229}: -15,  // w aring
+	{119, 232}: -10,  // w egrave
+	{119, 233}: -10,  // w eacute
+	{119, 234}: -10,  // w ecircumflex
+	{119, 235}: -10,  // w edieresis
+	{119, 242}: -10,  // w ograve
+	{119, 243}: -10,  // w oacute
+	{119, 244}: -10,  // w ocircumflex
+	{119, 245}: -10,  // w otilde
+	{119, 246}: -10,  // w odieresis
+	{119, 248}: -10,  // w oslash
+	{120, 101}: -30,  // x e
+	{120, 232}: -30,  // x egrave
+	{120, 233}: -30,  // x eacute
+	{120, 234}: -30,  // x ecircumflex
+	{120, 235}: -30,  // x edieresis
+	{121, 44}:  -100, // y comma
+	{121, 46}:  -100, // y period
+	{121, 97}:  -20,  // y a
+	{121, 101}: -20,  // y e
+	{121, 111}: -20,  // y o
+	{121, 224}: -20,  // y agrave
+	{121, 225}: -20,  // y aacute
+	{121, 226}: -20,  // y acircumflex
+	{121, 227}: -20,  // y atilde
+	{121, 228}: -20,  // y adieresis
+	{121, 229}: -20,  // y aring
+	{121, 232}: -20,  // y egrave
+	{121, 233}: -20,  // y eacute
+	{121, 234}: -20,  // y ecircumflex
+	{121, 235}: -20,  // y edieresis
+	{121, 242}: -20,  // y ograve
+	{121, 243}: -20,  // y oacute
+	{121, 244}: -20,  // y ocircumflex
+	{121, 245}: -20,  // y otilde
+	{121, 246}: -20,  // y odieresis
+	{121, 248}: -20,  // y oslash
+	{122, 101}: -15,  // z e
+	{122, 111}: -15,  // z o
+	{122, 232}: -15,  // z egrave
+	{122, 233}: -15,  // z eacute
+	{122, 234}: -15,  // z ecircumflex
+	{122, 235}: -15,  // z edieresis
+	{122, 242}: -15,  // z ograve
+	{122, 243}: -15,  // z oacute
+	{122, 244}: -15,  // z ocircumflex
+	{122, 245}: -15,  // z otilde
+	{122, 246}: -15,  // z odieresis
+	{122, 248}: -15,  // z oslash
+	{138, 44}:  -20,  // Scaron comma
+	{138, 46}:  -20,  // Scaron period
+	{145, 145}: -57,  // quoteleft quoteleft
+	{146, 32}:  -70,  // quoteright space
+	{146, 100}: -50,  // quoteright d
+	{146, 114}: -50,  // quoteright r
+	{146, 115}: -50,  // quoteright s
+	{146, 146}: -57,  // quoteright quoteright
+	{146, 154}: -50,  // quoteright scaron
+	{146, 160}: -70,  // quoteright space
+	{148, 32}:  -40,  // quotedblright space
+	{148, 160}: -40,  // quotedblright space
+	{154, 44}:  -15,  // scaron comma
+	{154, 46}:  -15,  // scaron period
+	{154, 119}: -30,  // scaron w
+	{158, 101}: -15,  // zcaron e
+	{158, 111}: -15,  // zcaron o
+	{158, 232}: -15,  // zcaron egrave
+	{158, 233}: -15,  // zcaron eacute
+	{158, 234}: -15,  // zcaron ecircumflex
+	{158, 235}: -15,  // zcaron edieresis
+	{158, 242}: -15,  // zcaron ograve
+	{158, 243}: -15,  // zcaron oacute
+	{158, 244}: -15,  // zcaron ocircumflex
+	{158, 245}: -15,  // zcaron otilde
+	{158, 246}: -15,  // zcaron odieresis
+	{158, 248}: -15,  // zcaron oslash
+	{159, 44}:  -140, // Ydieresis comma
+	{159, 45}:  -140, // Ydieresis hyphen
+	{159, 46}:  -140, // Ydieresis period
+	{159, 58}:  -60,  // Ydieresis colon
+	{159, 59}:  -60,  // Ydieresis semicolon
+	{159, 65}:  -110, // Ydieresis A
+	{159, 79}:  -85,  // Ydieresis O
+	{159, 97}:  -140, // Ydieresis a
+	{159, 101}: -140, // Ydieresis e
+	{159, 105}: -20,  // Ydieresis i
+	{159, 111}: -140, // Ydieresis o
+	{159, 117}: -110, // Ydieresis u
+	{159, 173}: -140, // Ydieresis hyphen
+	{159, 192}: -110, // Ydieresis Agrave
+	{159, 193}: -110, // Ydieresis Aacute
+	{159, 194}: -110, // Ydieresis Acircumflex
+	{159, 195}: -110, // Ydieresis Atilde
+	{159, 196}: -110, // Ydieresis Adieresis
+	{159, 197}: -110, // Ydieresis Aring
+	{159, 210}: -85,  // Ydieresis Ograve
+	{159, 211}: -85,  // Ydieresis Oacute
+	{159, 212}: -85,  // Ydieresis Ocircumflex
+	{159, 213}: -85,  // Ydieresis Otilde
+	{159, 214}: -85,  // Ydieresis Odieresis
+	{159, 216}: -85,  // Ydieresis Oslash
+	{159, 224}: -140, // Ydieresis agrave
+	{159, 225}: -140, // Ydieresis aacute
+	{159, 226}: -140, // Ydieresis acircumflex
+	{159, 227}: -70,  // Ydieresis atilde
+	{159, 228}: -140, // Ydieresis adieresis
+	{159, 229}: -140, // Ydieresis aring
+	{159, 232}: -140, // Ydieresis egrave
+	{159, 233}: -140, // Ydieresis eacute
+	{159, 234}: -140, // Ydieresis ecircumflex
+	{159, 235}: -140, // Ydieresis edieresis
+	{159, 237}: -20,  // Ydieresis iacute
+	{159, 242}: -140, // Ydieresis ograve
+	{159, 243}: -140, // Ydieresis oacute
+	{159, 244}: -140, // Ydieresis ocircumflex
+	{159, 245}: -140, // Ydieresis otilde
+	{159, 246}: -140, // Ydieresis odieresis
+	{159, 248}: -140, // Ydieresis oslash
+	{159, 249}: -110, // Ydieresis ugrave
+	{159, 250}: -110, // Ydieresis uacute
+	{159, 251}: -110, // Ydieresis ucircumflex
+	{159, 252}: -110, // Ydieresis udieresis
+	{160, 84}:  -50,  // space T
+	{160, 86}:  -50,  // space V
+	{160, 87}:  -40,  // space W
+	{160, 89}:  -90,  // space Y
+	{160, 145}: -60,  // space quoteleft
+	{160, 147}: -30,  // space quotedblleft
+	{160, 159}: -90,  // space Ydieresis
+	{160, 221}: -90,  // space Yacute
+	{192, 67}:  -30,  // Agrave C
+	{192, 71}:  -30,  // Agrave G
+	{192, 79}:  -30,  // Agrave O
+	{192, 81}:  -30,  // Agrave Q
+	{192, 84}:  -120, // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -70,  // Agrave V
+	{192, 87}:  -50,  // Agrave W
+	{192, 89}:  -100, // Agrave Y
+	{192, 117}: -30,  // Agrave u
+	{192, 118}: -40,  // Agrave v
+	{192, 119}: -40,  // Agrave w
+	{192, 121}: -40,  // Agrave y
+	{192, 159}: -100, // Agrave Ydieresis
+	{192, 199}: -30,  // Agrave Ccedilla
+	{192, 210}: -30,  // Agrave Ograve
+	{192, 211}: -30,  // Agrave Oacute
+	{192, 212}: -30,  // Agrave Ocircumflex
+	{192, 213}: -30,  // Agrave Otilde
+	{192, 214}: -30,  // Agrave Odieresis
+	{192, 216}: -30,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -100, // Agrave Yacute
+	{192, 249}: -30,  // Agrave ugrave
+	{192, 250}: -30,  // Agrave uacute
+	{192, 251}: -30,  // Agrave ucircumflex
+	{192, 252}: -30,  // Agrave udieresis
+	{192, 253}: -40,  // Agrave yacute
+	{192, 255}: -40,  // Agrave ydieresis
+	{193, 67}:  -30,  // Aacute C
+	{193, 71}:  -30,  // Aacute G
+	{193, 79}:  -30,  // Aacute O
+	{193, 81}:  -30,  // Aacute Q
+	{193, 84}:  -120, // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -70,  // Aacute V
+	{193, 87}:  -50,  // Aacute W
+	{193, 89}:  -100, // Aacute Y
+	{193, 117}: -30,  // Aacute u
+	{193, 118}: -40,  // Aacute v
+	{193, 119}: -40,  // Aacute w
+	{193, 121}: -40,  // Aacute y
+	{193, 159}: -100, // Aacute Ydieresis
+	{193, 199}: -30,  // Aacute Ccedilla
+	{193, 210}: -30,  // Aacute Ograve
+	{193, 211}: -30,  // Aacute Oacute
+	{193, 212}: -30,  // Aacute Ocircumflex
+	{193, 213}: -30,  // Aacute Otilde
+	{193, 214}: -30,  // Aacute Odieresis
+	{193, 216}: -30,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -100, // Aacute Yacute
+	{193, 249}: -30,  // Aacute ugrave
+	{193, 250}: -30,  // Aacute uacute
+	{193, 251}: -30,  // Aacute ucircumflex
+	{193, 252}: -30,  // Aacute udieresis
+	{193, 253}: -40,  // Aacute yacute
+	{193, 255}: -40,  // Aacute ydieresis
+	{194, 67}:  -30,  // Acircumflex C
+	{194, 71}:  -30,  // Acircumflex G
+	{194, 79}:  -30,  // Acircumflex O
+	{194, 81}:  -30,  // Acircumflex Q
+	{194, 84}:  -120, // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -70,  // Acircumflex V
+	{194, 87}:  -50,  // Acircumflex W
+	{194, 89}:  -100, // Acircumflex Y
+	{194, 117}: -30,  // Acircumflex u
+	{194, 118}: -40,  // Acircumflex v
+	{194, 119}: -40,  // Acircumflex w
+	{194, 121}: -40,  // Acircumflex y
+	{194, 159}: -100, // Acircumflex Ydieresis
+	{194, 199}: -30,  // Acircumflex Ccedilla
+	{194, 210}: -30,  // Acircumflex Ograve
+	{194, 211}: -30,  // Acircumflex Oacute
+	{194, 212}: -30,  // Acircumflex Ocircumflex
+	{194, 213}: -30,  // Acircumflex Otilde
+	{194, 214}: -30,  // Acircumflex Odieresis
+	{194, 216}: -30,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -100, // Acircumflex Yacute
+	{194, 249}: -30,  // Acircumflex ugrave
+	{194, 250}: -30,  // Acircumflex uacute
+	{194, 251}: -30,  // Acircumflex ucircumflex
+	{194, 252}: -30,  // Acircumflex udieresis
+	{194, 253}: -40,  // Acircumflex yacute
+	{194, 255}: -40,  // Acircumflex ydieresis
+	{195, 67}:  -30,  // Atilde C
+	{195, 71}:  -30,  // Atilde G
+	{195, 79}:  -30,  // Atilde O
+	{195, 81}:  -30,  // Atilde Q
+	{195, 84}:  -120, // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -70,  // Atilde V
+	{195, 87}:  -50,  // Atilde W
+	{195, 89}:  -100, // Atilde Y
+	{195, 117}: -30,  // Atilde u
+	{195, 118}: -40,  // Atilde v
+	{195, 119}: -40,  // Atilde w
+	{195, 121}: -40,  // Atilde y
+	{195, 159}: -100, // Atilde Ydieresis
+	{195, 199}: -30,  // Atilde Ccedilla
+	{195, 210}: -30,  // Atilde Ograve
+	{195, 211}: -30,  // Atilde Oacute
+	{195, 212}: -30,  // Atilde Ocircumflex
+	{195, 213}: -30,  // Atilde Otilde
+	{195, 214}: -30,  // Atilde Odieresis
+	{195, 216}: -30,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -100, // Atilde Yacute
+	{195, 249}: -30,  // Atilde ugrave
+	{195, 250}: -30,  // Atilde uacute
+	{195, 251}: -30,  // Atilde ucircumflex
+	{195, 252}: -30,  // Atilde udieresis
+	{195, 253}: -40,  // Atilde yacute
+	{195, 255}: -40,  // Atilde ydieresis
+	{196, 67}:  -30,  // Adieresis C
+	{196, 71}:  -30,  // Adieresis G
+	{196, 79}:  -30,  // Adieresis O
+	{196, 81}:  -30,  // Adieresis Q
+	{196, 84}:  -120, // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -70,  // Adieresis V
+	{196, 87}:  -50,  // Adieresis W
+	{196, 89}:  -100, // Adieresis Y
+	{196, 117}: -30,  // Adieresis u
+	{196, 118}: -40,  // Adieresis v
+	{196, 119}: -40,  // Adieresis w
+	{196, 121}: -40,  // Adieresis y
+	{196, 159}: -100, // Adieresis Ydieresis
+	{196, 199}: -30,  // Adieresis Ccedilla
+	{196, 210}: -30,  // Adieresis Ograve
+	{196, 211}: -30,  // Adieresis Oacute
+	{196, 212}: -30,  // Adieresis Ocircumflex
+	{196, 213}: -30,  // Adieresis Otilde
+	{196, 214}: -30,  // Adieresis Odieresis
+	{196, 216}: -30,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -100, // Adieresis Yacute
+	{196, 249}: -30,  // Adieresis ugrave
+	{196, 250}: -30,  // Adieresis uacute
+	{196, 251}: -30,  // Adieresis ucircumflex
+	{196, 252}: -30,  // Adieresis udieresis
+	{196, 253}: -40,  // Adieresis yacute
+	{196, 255}: -40,  // Adieresis ydieresis
+	{197, 67}:  -30,  // Aring C
+	{197, 71}:  -30,  // Aring G
+	{197, 79}:  -30,  // Aring O
+	{197, 81}:  -30,  // Aring Q
+	{197, 84}:  -120, // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -70,  // Aring V
+	{197, 87}:  -50,  // Aring W
+	{197, 89}:  -100, // Aring Y
+	{197, 117}: -30,  // Aring u
+	{197, 118}: -40,  // Aring v
+	{197, 119}: -40,  // Aring w
+	{197, 121}: -40,  // Aring y
+	{197, 159}: -100, // Aring Ydieresis
+	{197, 199}: -30,  // Aring Ccedilla
+	{197, 210}: -30,  // Aring Ograve
+	{197, 211}: -30,  // Aring Oacute
+	{197, 212}: -30,  // Aring Ocircumflex
+	{197, 213}: -30,  // Aring Otilde
+	{197, 214}: -30,  // Aring Odieresis
+	{197, 216}: -30,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -100, // Aring Yacute
+	{197, 249}: -30,  // Aring ugrave
+	{197, 250}: -30,  // Aring uacute
+	{197, 251}: -30,  // Aring ucircumflex
+	{197, 252}: -30,  // Aring udieresis
+	{197, 253}: -40,  // Aring yacute
+	{197, 255}: -40,  // Aring ydieresis
+	{199, 44}:  -30,  // Ccedilla comma
+	{199, 46}:  -30,  // Ccedilla period
+	{210, 44}:  -40,  // Ograve comma
+	{210, 46}:  -40,  // Ograve period
+	{210, 65}:  -20,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -30,  // Ograve W
+	{210, 88}:  -60,  // Ograve X
+	{210, 89}:  -70,  // Ograve Y
+	{210, 159}: -70,  // Ograve Ydieresis
+	{210, 192}: -20,  // Ograve Agrave
+	{210, 193}: -20,  // Ograve Aacute
+	{210, 194}: -20,  // Ograve Acircumflex
+	{210, 195}: -20,  // Ograve Atilde
+	{210, 196}: -20,  // Ograve Adieresis
+	{210, 197}: -20,  // Ograve Aring
+	{210, 221}: -70,  // Ograve Yacute
+	{211, 44}:  -40,  // Oacute comma
+	{211, 46}:  -40,  // Oacute period
+	{211, 65}:  -20,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -30,  // Oacute W
+	{211, 88}:  -60,  // Oacute X
+	{211, 89}:  -70,  // Oacute Y
+	{211, 159}: -70,  // Oacute Ydieresis
+	{211, 192}: -20,  // Oacute Agrave
+	{211, 193}: -20,  // Oacute Aacute
+	{211, 194}: -20,  // Oacute Acircumflex
+	{211, 195}: -20,  // Oacute Atilde
+	{211, 196}: -20,  // Oacute Adieresis
+	{211, 197}: -20,  // Oacute Aring
+	{211, 221}: -70,  // Oacute Yacute
+	{212, 44}:  -40,  // Ocircumflex comma
+	{212, 46}:  -40,  // Ocircumflex period
+	{212, 65}:  -20,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -30,  // Ocircumflex W
+	{212, 88}:  -60,  // Ocircumflex X
+	{212, 89}:  -70,  // Ocircumflex Y
+	{212, 159}: -70,  // Ocircumflex Ydieresis
+	{212, 192}: -20,  // Ocircumflex Agrave
+	{212, 193}: -20,  // Ocircumflex Aacute
+	{212, 194}: -20,  // Ocircumflex Acircumflex
+	{212, 195}: -20,  // Ocircumflex Atilde
+	{212, 196}: -20,  // Ocircumflex Adieresis
+	{212, 197}: -20,  // Ocircumflex Aring
+	{212, 221}: -70,  // Ocircumflex Yacute
+	{213, 44}:  -40,  // Otilde comma
+	{213, 46}:  -40,  // Otilde period
+	{213, 65}:  -20,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -30,  // Otilde W
+	{213, 88}:  -60,  // Otilde X
+	{213, 89}:  -70,  // Otilde Y
+	{213, 159}: -70,  // Otilde Ydieresis
+	{213, 192}: -20,  // Otilde Agrave
+	{213, 193}: -20,  // Otilde Aacute
+	{213, 194}: -20,  // Otilde Acircumflex
+	{213, 195}: -20,  // Otilde Atilde
+	{213, 196}: -20,  // Otilde Adieresis
+	{213, 197}: -20,  // Otilde Aring
+	{213, 221}: -70,  // Otilde Yacute
+	{214, 44}:  -40,  // Odieresis comma
+	{214, 46}:  -40,  // Odieresis period
+	{214, 65}:  -20,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -30,  // Odieresis W
+	{214, 88}:  -60,  // Odieresis X
+	{214, 89}:  -70,  // Odieresis Y
+	{214, 159}: -70,  // Odieresis Ydieresis
+	{214, 192}: -20,  // Odieresis Agrave
+	{214, 193}: -20,  // Odieresis Aacute
+	{214, 194}: -20,  // Odieresis Acircumflex
+	{214, 195}: -20,  // Odieresis Atilde
+	{214, 196}: -20,  // Odieresis Adieresis
+	{214, 197}: -20,  // Odieresis Aring
+	{214, 221}: -70,  // Odieresis Yacute
+	{216, 44}:  -40,  // Oslash comma
+	{216, 46}:  -40,  // Oslash period
+	{216, 65}:  -20,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -30,  // Oslash W
+	{216, 88}:  -60,  // Oslash X
+	{216, 89}:  -70,  // Oslash Y
+	{216, 159}: -70,  // Oslash Ydieresis
+	{216, 192}: -20,  // Oslash Agrave
+	{216, 193}: -20,  // Oslash Aacute
+	{216, 194}: -20,  // Oslash Acircumflex
+	{216, 195}: -20,  // Oslash Atilde
+	{216, 196}: -20,  // Oslash Adieresis
+	{216, 197}: -20,  // Oslash Aring
+	{216, 221}: -70,  // Oslash Yacute
+	{217, 44}:  -40,  // Ugrave comma
+	{217, 46}:  -40,  // Ugrave period
+	{217, 65}:  -40,  // Ugrave A
+	{217, 192}: -40,  // Ugrave Agrave
+	{217, 193}: -40,  // Ugrave Aacute
+	{217, 194}: -40,  // Ugrave Acircumflex
+	{217, 195}: -40,  // Ugrave Atilde
+	{217, 196}: -40,  // Ugrave Adieresis
+	{217, 197}: -40,  // Ugrave Aring
+	{218, 44}:  -40,  // Uacute comma
+	{218, 46}:  -40,  // Uacute period
+	{218, 65}:  -40,  // Uacute A
+	{218, 192}: -40,  // Uacute Agrave
+	{218, 193}: -40,  // Uacute Aacute
+	{218, 194}: -40,  // Uacute Acircumflex
+	{218, 195}: -40,  // Uacute Atilde
+	{218, 196}: -40,  // Uacute Adieresis
+	{218, 197}: -40,  // Uacute Aring
+	{219, 44}:  -40,  // Ucircumflex comma
+	{219, 46}:  -40,  // Ucircumflex period
+	{219, 65}:  -40,  // Ucircumflex A
+	{219, 192}: -40,  // Ucircumflex Agrave
+	{219, 193}: -40,  // Ucircumflex Aacute
+	{219, 194}: -40,  // Ucircumflex Acircumflex
+	{219, 195}: -40,  // Ucircumflex Atilde
+	{219, 196}: -40,  // Ucircumflex Adieresis
+	{219, 197}: -40,  // Ucircumflex Aring
+	{220, 44}:  -40,  // Udieresis comma
+	{220, 46}:  -40,  // Udieresis period
+	{220, 65}:  -40,  // Udieresis A
+	{220, 192}: -40,  // Udieresis Agrave
+	{220, 193}: -40,  // Udieresis Aacute
+	{220, 194}: -40,  // Udieresis Acircumflex
+	{220, 195}: -40,  // Udieresis Atilde
+	{220, 196}: -40,  // Udieresis Adieresis
+	{220, 197}: -40,  // Udieresis Aring
+	{221, 44}:  -140, // Yacute comma
+	{221, 45}:  -140, // Yacute hyphen
+	{221, 46}:  -140, // Yacute period
+	{221, 58}:  -60,  // Yacute colon
+	{221, 59}:  -60,  // Yacute semicolon
+	{221, 65}:  -110, // Yacute A
+	{221, 79}:  -85,  // Yacute O
+	{221, 97}:  -140, // Yacute a
+	{221, 101}: -140, // Yacute e
+	{221, 105}: -20,  // Yacute i
+	{221, 111}: -140, // Yacute o
+	{221, 117}: -110, // Yacute u
+	{221, 173}: -140, // Yacute hyphen
+	{221, 192}: -110, // Yacute Agrave
+	{221, 193}: -110, // Yacute Aacute
+	{221, 194}: -110, // Yacute Acircumflex
+	{221, 195}: -110, // Yacute Atilde
+	{221, 196}: -110, // Yacute Adieresis
+	{221, 197}: -110, // Yacute Aring
+	{221, 210}: -85,  // Yacute Ograve
+	{221, 211}: -85,  // Yacute Oacute
+	{221, 212}: -85,  // Yacute Ocircumflex
+	{221, 213}: -85,  // Yacute Otilde
+	{221, 214}: -85,  // Yacute Odieresis
+	{221, 216}: -85,  // Yacute Oslash
+	{221, 224}: -140, // Yacute agrave
+	{221, 225}: -140, // Yacute aacute
+	{221, 226}: -140, // Yacute acircumflex
+	{221, 227}: -70,  // Yacute atilde
+	{221, 228}: -140, // Yacute adieresis
+	{221, 229}: -140, // Yacute aring
+	{221, 232}: -140, // Yacute egrave
+	{221, 233}: -140, // Yacute eacute
+	{221, 234}: -140, // Yacute ecircumflex
+	{221, 235}: -140, // Yacute edieresis
+	{221, 237}: -20,  // Yacute iacute
+	{221, 242}: -140, // Yacute ograve
+	{221, 243}: -140, // Yacute oacute
+	{221, 244}: -140, // Yacute ocircumflex
+	{221, 245}: -140, // Yacute otilde
+	{221, 246}: -140, // Yacute odieresis
+	{221, 248}: -140, // Yacute oslash
+	{221, 249}: -110, // Yacute ugrave
+	{221, 250}: -110, // Yacute uacute
+	{221, 251}: -110, // Yacute ucircumflex
+	{221, 252}: -110, // Yacute udieresis
+	{224, 118}: -20,  // agrave v
+	{224, 119}: -20,  // agrave w
+	{224, 121}: -30,  // agrave y
+	{224, 253}: -30,  // agrave yacute
+	{224, 255}: -30,  // agrave ydieresis
+	{225, 118}: -20,  // aacute v
+	{225, 119}: -20,  // aacute w
+	{225, 121}: -30,  // aacute y
+	{225, 253}: -30,  // aacute yacute
+	{225, 255}: -30,  // aacute ydieresis
+	{226, 118}: -20,  // acircumflex v
+	{226, 119}: -20,  // acircumflex w
+	{226, 121}: -30,  // acircumflex y
+	{226, 253}: -30,  // acircumflex yacute
+	{226, 255}: -30,  // acircumflex ydieresis
+	{227, 118}: -20,  // atilde v
+	{227, 119}: -20,  // atilde w
+	{227, 121}: -30,  // atilde y
+	{227, 253}: -30,  // atilde yacute
+	{227, 255}: -30,  // atilde ydieresis
+	{228, 118}: -20,  // adieresis v
+	{228, 119}: -20,  // adieresis w
+	{228, 121}: -30,  // adieresis y
+	{228, 253}: -30,  // adieresis yacute
+	{228, 255}: -30,  // adieresis ydieresis
+	{229, 118}: -20,  // aring v
+	{229, 119}: -20,  // aring w
+	{229, 121}: -30,  // aring y
+	{229, 253}: -30,  // aring yacute
+	{229, 255}: -30,  // aring ydieresis
+	{231, 44}:  -15,  // ccedilla comma
+	{231, 107}: -20,  // ccedilla k
+	{232, 44}:  -15,  // egrave comma
+	{232, 46}:  -15,  // egrave period
+	{232, 118}: -30,  // egrave v
+	{232, 119}: -20,  // egrave w
+	{232, 120}: -30,  // egrave x
+	{232, 121}: -20,  // egrave y
+	{232, 253}: -20,  // egrave yacute
+	{232, 255}: -20,  // egrave ydieresis
+	{233, 44}:  -15,  // eacute comma
+	{233, 46}:  -15,  // eacute period
+	{233, 118}: -30,  // eacute v
+	{233, 119}: -20,  // eacute w
+	{233, 120}: -30,  // eacute x
+	{233, 121}: -20,  // eacute y
+	{233, 253}: -20,  // eacute yacute
+	{233, 255}: -20,  // eacute ydieresis
+	{234, 44}:  -15,  // ecircumflex comma
+	{234, 46}:  -15,  // ecircumflex period
+	{234, 118}: -30,  // ecircumflex v
+	{234, 119}: -20,  // ecircumflex w
+	{234, 120}: -30,  // ecircumflex x
+	{234, 121}: -20,  // ecircumflex y
+	{234, 253}: -20,  // ecircumflex yacute
+	{234, 255}: -20,  // ecircumflex ydieresis
+	{235, 44}:  -15,  // edieresis comma
+	{235, 46}:  -15,  // edieresis period
+	{235, 118}: -30,  // edieresis v
+	{235, 119}: -20,  // edieresis w
+	{235, 120}: -30,  // edieresis x
+	{235, 121}: -20,  // edieresis y
+	{235, 253}: -20,  // edieresis yacute
+	{235, 255}: -20,  // edieresis ydieresis
+	{241, 117}: -10,  // ntilde u
+	{241, 118}: -20,  // ntilde v
+	{241, 121}: -15,  // ntilde y
+	{241, 249}: -10,  // ntilde ugrave
+	{241, 250}: -10,  // ntilde uacute
+	{241, 251}: -10,  // ntilde ucircumflex
+	{241, 252}: -10,  // ntilde udieresis
+	{241, 253}: -15,  // ntilde yacute
+	{241, 255}: -15,  // ntilde ydieresis
+	{242, 44}:  -40,  // ograve comma
+	{242, 46}:  -40,  // ograve period
+	{242, 118}: -15,  // ograve v
+	{242, 119}: -15,  // ograve w
+	{242, 120}: -30,  // ograve x
+	{242, 121}: -30,  // ograve y
+	{242, 253}: -30,  // ograve yacute
+	{242, 255}: -30,  // ograve ydieresis
+	{243, 44}:  -40,  // oacute comma
+	{243, 46}:  -40,  // oacute period
+	{243, 118}: -15,  // oacute v
+	{243, 119}: -15,  // oacute w
+	{243, 120}: -30,  // oacute x
+	{243, 121}: -30,  // oacute y
+	{243, 253}: -30,  // oacute yacute
+	{243, 255}: -30,  // oacute ydieresis
+	{244, 44}:  -40,  // ocircumflex comma
+	{244, 46}:  -40,  // ocircumflex period
+	{244, 118}: -15,  // ocircumflex v
+	{244, 119}: -15,  // ocircumflex w
+	{244, 120}: -30,  // ocircumflex x
+	{244, 121}: -30,  // ocircumflex y
+	{244, 253}: -30,  // ocircumflex yacute
+	{244, 255}: -30,  // ocircumflex ydieresis
+	{245, 44}:  -40,  // otilde comma
+	{245, 46}:  -40,  // otilde period
+	{245, 118}: -15,  // otilde v
+	{245, 119}: -15,  // otilde w
+	{245, 120}: -30,  // otilde x
+	{245, 121}: -30,  // otilde y
+	{245, 253}: -30,  // otilde yacute
+	{245, 255}: -30,  // otilde ydieresis
+	{246, 44}:  -40,  // odieresis comma
+	{246, 46}:  -40,  // odieresis period
+	{246, 118}: -15,  // odieresis v
+	{246, 119}: -15,  // odieresis w
+	{246, 120}: -30,  // odieresis x
+	{246, 121}: -30,  // odieresis y
+	{246, 253}: -30,  // odieresis yacute
+	{246, 255}: -30,  // odieresis ydieresis
+	{248, 44}:  -95,  // oslash comma
+	{248, 46}:  -95,  // oslash period
+	{248, 97}:  -55,  // oslash a
+	{248, 98}:  -55,  // oslash b
+	{248, 99}:  -55,  // oslash c
+	{248, 100}: -55,  // oslash d
+	{248, 101}: -55,  // oslash e
+	{248, 102}: -55,  // oslash f
+	{248, 103}: -55,  // oslash g
+	{248, 104}: -55,  // oslash h
+	{248, 105}: -55,  // oslash i
+	{248, 106}: -55,  // oslash j
+	{248, 107}: -55,  // oslash k
+	{248, 108}: -55,  // oslash l
+	{248, 109}: -55,  // oslash m
+	{248, 110}: -55,  // oslash n
+	{248, 111}: -55,  // oslash o
+	{248, 112}: -55,  // oslash p
+	{248, 113}: -55,  // oslash q
+	{248, 114}: -55,  // oslash r
+	{248, 115}: -55,  // oslash s
+	{248, 116}: -55,  // oslash t
+	{248, 117}: -55,  // oslash u
+	{248, 118}: -70,  // oslash v
+	{248, 119}: -70,  // oslash w
+	{248, 120}: -85,  // oslash x
+	{248, 121}: -70,  // oslash y
+	{248, 122}: -55,  // oslash z
+	{248, 154}: -55,  // oslash scaron
+	{248, 158}: -55,  // oslash zcaron
+	{248, 224}: -55,  // oslash agrave
+	{248, 225}: -55,  // oslash aacute
+	{248, 226}: -55,  // oslash acircumflex
+	{248, 227}: -55,  // oslash atilde
+	{248, 228}: -55,  // oslash adieresis
+	{248, 229}: -55,  // oslash aring
+	{248, 231}: -55,  // oslash ccedilla
+	{248, 232}: -55,  // oslash egrave
+	{248, 233}: -55,  // oslash eacute
+	{248, 234}: -55,  // oslash ecircumflex
+	{248, 235}: -55,  // oslash edieresis
+	{248, 236}: -55,  // oslash igrave
+	{248, 237}: -55,  // oslash iacute
+	{248, 238}: -55,  // oslash icircumflex
+	{248, 239}: -55,  // oslash idieresis
+	{248, 241}: -55,  // oslash ntilde
+	{248, 242}: -55,  // oslash ograve
+	{248, 243}: -55,  // oslash oacute
+	{248, 244}: -55,  // oslash ocircumflex
+	{248, 245}: -55,  // oslash otilde
+	{248, 246}: -55,  // oslash odieresis
+	{248, 248}: -55,  // oslash oslash
+	{248, 249}: -55,  // oslash ugrave
+	{248, 250}: -55,  // oslash uacute
+	{248, 251}: -55,  // oslash ucircumflex
+	{248, 252}: -55,  // oslash udieresis
+	{248, 253}: -70,  // oslash yacute
+	{248, 255}: -70,  // oslash ydieresis
+	{253, 44}:  -100, // yacute comma
+	{253, 46}:  -100, // yacute period
+	{253, 97}:  -20,  // yacute a
+	{253, 101}: -20,  // yacute e
+	{253, 111}: -20,  // yacute o
+	{253, 224}: -20,  // yacute agrave
+	{253, 225}: -20,  // yacute aacute
+	{253, 226}: -20,  // yacute acircumflex
+	{253, 227}: -20,  // yacute atilde
+	{253, 228}: -20,  // yacute adieresis
+	{253, 229}: -20,  // yacute aring
+	{253, 232}: -20,  // yacute egrave
+	{253, 233}: -20,  // yacute eacute
+	{253, 234}: -20,  // yacute ecircumflex
+	{253, 235}: -20,  // yacute edieresis
+	{253, 242}: -20,  // yacute ograve
+	{253, 243}: -20,  // yacute oacute
+	{253, 244}: -20,  // yacute ocircumflex
+	{253, 245}: -20,  // yacute otilde
+	{253, 246}: -20,  // yacute odieresis
+	{253, 248}: -20,  // yacute oslash
+	{255, 44}:  -100, // ydieresis comma
+	{255, 46}:  -100, // ydieresis period
+	{255, 97}:  -20,  // ydieresis a
+	{255, 101}: -20,  // ydieresis e
+	{255, 111}: -20,  // ydieresis o
+	{255, 224}: -20,  // ydieresis agrave
+	{255, 225}: -20,  // ydieresis aacute
+	{255, 226}: -20,  // ydieresis acircumflex
+	{255, 227}: -20,  // ydieresis atilde
+	{255, 228}: -20,  // ydieresis adieresis
+	{255, 229}: -20,  // ydieresis aring
+	{255, 232}: -20,  // ydieresis egrave
+	{255, 233}: -20,  // ydieresis eacute
+	{255, 234}: -20,  // ydieresis ecircumflex
+	{255, 235}: -20,  // ydieresis edieresis
+	{255, 242}: -20,  // ydieresis ograve
+	{255, 243}: -20,  // ydieresis oacute
+	{255, 244}: -20,  // ydieresis ocircumflex
+	{255, 245}: -20,  // ydieresis otilde
+	{255, 246}: -20,  // ydieresis odieresis
+	{255, 248}: -20,  // ydieresis oslash
 }
 
 // Helvetica-Bold
+var helveticaBoldMetrics = FontMetrics{
+	Ascender:    718,
+	Descender:   -207,
+	CapHeight:   718,
+	XHeight:     532,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-170, -228}, Point{1003, 962}},
+}
+
 var helveticaBoldWidths = []uint16{
-	32:  278, // space
-	33:  333, // exclam
-	34:  474, // quotedbl
-	35:  556, // numbersign
-	36:  556, // dollar
-	37:  889, // percent
-	38:  722, // ampersand
-	39:  278, // quoteright
-	40:  333, // parenleft
-	41:  333, // parenright
-	42:  389, // asterisk
-	43:  584, // plus
-	44:  278, // comma
-	45:  333, // hyphen
-	46:  278, // period
-	47:  278, // slash
-	48:  556, // zero
-	49:  556, // one
-	50:  556, // two
-	51:  556, // three
-	52:  556, // four
-	53:  556, // five
-	54:  556, // six
-	55:  556, // seven
-	56:  556, // eight
-	57:  556, // nine
-	58:  333, // colon
-	59:  333, // semicolon
-	60:  584, // less
-	61:  584, // equal
-	62:  584, // greater
-	63:  611, // question
-	64:  975, // at
-	65:  722, // A
-	66:  722, // B
-	67:  722, // C
-	68:  722, // D
-	69:  667, // E
-	70:  611, // F
-	71:  778, // G
-	72:  722, // H
-	73:  278, // I
-	74:  556, // J
-	75:  722, // K
-	76:  611, // L
-	77:  833, // M
-	78:  722, // N
-	79:  778, // O
-	80:  667, // P
-	81:  778, // Q
-	82:  722, // R
-	83:  667, // S
-	84:  611, // T
-	85:  722, // U
-	86:  667, // V
-	87:  944, // W
-	88:  667, // X
-	89:  667, // Y
-	90:  611, // Z
-	91:  333, // bracketleft
-	92:  278, // backslash
-	93:  333, // bracketright
-	94:  584, // asciicircum
-	95:  556, // underscore
-	96:  278, // quoteleft
-	97:  556, // a
-	98:  611, // b
-	99:  556, // c
-	100: 611, // d
-	101: 556, // e
-	102: 333, // f
-	103: 611, // g
-	104: 611, // h
-	105: 278, // i
-	106: 278, // j
-	107: 556, // k
-	108: 278, // l
-	109: 889, // m
-	110: 611, // n
-	111: 611, // o
-	112: 611, // p
-	113: 611, // q
-	114: 389, // r
-	115: 556, // s
-	116: 333, // t
-	117: 611, // u
-	118: 556, // v
-	119: 778, // w
-	120: 556, // x
-	121: 556, // y
-	122: 500, // z
-	123: 389, // braceleft
-	124: 280, // bar
-	125: 389, // braceright
-	126: 584, // asciitilde
+	32:  278,  // space
+	33:  333,  // exclam
+	34:  474,  // quotedbl
+	35:  556,  // numbersign
+	36:  556,  // dollar
+	37:  889,  // percent
+	38:  722,  // ampersand
+	39:  238,  // quotesingle
+	40:  333,  // parenleft
+	41:  333,  // parenright
+	42:  389,  // asterisk
+	43:  584,  // plus
+	44:  278,  // comma
+	45:  333,  // hyphen
+	46:  278,  // period
+	47:  278,  // slash
+	48:  556,  // zero
+	49:  556,  // one
+	50:  556,  // two
+	51:  556,  // three
+	52:  556,  // four
+	53:  556,  // five
+	54:  556,  // six
+	55:  556,  // seven
+	56:  556,  // eight
+	57:  556,  // nine
+	58:  333,  // colon
+	59:  333,  // semicolon
+	60:  584,  // less
+	61:  584,  // equal
+	62:  584,  // greater
+	63:  611,  // question
+	64:  975,  // at
+	65:  722,  // A
+	66:  722,  // B
+	67:  722,  // C
+	68:  722,  // D
+	69:  667,  // E
+	70:  611,  // F
+	71:  778,  // G
+	72:  722,  // H
+	73:  278,  // I
+	74:  556,  // J
+	75:  722,  // K
+	76:  611,  // L
+	77:  833,  // M
+	78:  722,  // N
+	79:  778,  // O
+	80:  667,  // P
+	81:  778,  // Q
+	82:  722,  // R
+	83:  667,  // S
+	84:  611,  // T
+	85:  722,  // U
+	86:  667,  // V
+	87:  944,  // W
+	88:  667,  // X
+	89:  667,  // Y
+	90:  611,  // Z
+	91:  333,  // bracketleft
+	92:  278,  // backslash
+	93:  333,  // bracketright
+	94:  584,  // asciicircum
+	95:  556,  // underscore
+	96:  333,  // grave
+	97:  556,  // a
+	98:  611,  // b
+	99:  556,  // c
+	100: 611,  // d
+	101: 556,  // e
+	102: 333,  // f
+	103: 611,  // g
+	104: 611,  // h
+	105: 278,  // i
+	106: 278,  // j
+	107: 556,  // k
+	108: 278,  // l
+	109: 889,  // m
+	110: 611,  // n
+	111: 611,  // o
+	112: 611,  // p
+	113: 611,  // q
+	114: 389,  // r
+	115: 556,  // s
+	116: 333,  // t
+	117: 611,  // u
+	118: 556,  // v
+	119: 778,  // w
+	120: 556,  // x
+	121: 556,  // y
+	122: 500,  // z
+	123: 389,  // braceleft
+	124: 280,  // bar
+	125: 389,  // braceright
+	126: 584,  // asciitilde
+	128: 556,  // Euro
+	130: 278,  // quotesinglbase
+	131: 556,  // florin
+	132: 500,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 556,  // dagger
+	135: 556,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 667,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 1000, // OE
+	142: 611,  // Zcaron
+	145: 278,  // quoteleft
+	146: 278,  // quoteright
+	147: 500,  // quotedblleft
+	148: 500,  // quotedblright
+	149: 350,  // bullet
+	150: 556,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 1000, // trademark
+	154: 556,  // scaron
+	155: 333,  // guilsinglright
+	156: 944,  // oe
+	158: 500,  // zcaron
+	159: 667,  // Ydieresis
+	160: 278,  // space
+	161: 333,  // exclamdown
+	162: 556,  // cent
+	163: 556,  // sterling
+	164: 556,  // currency
+	165: 556,  // yen
+	166: 280,  // brokenbar
+	167: 556,  // section
+	168: 333,  // dieresis
+	169: 737,  // copyright
+	170: 370,  // ordfeminine
+	171: 556,  // guillemotleft
+	172: 584,  // logicalnot
+	173: 333,  // hyphen
+	174: 737,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 584,  // plusminus
+	178: 333,  // twosuperior
+	179: 333,  // threesuperior
+	180: 333,  // acute
+	181: 611,  // mu
+	182: 556,  // paragraph
+	183: 278,  // periodcentered
+	184: 333,  // cedilla
+	185: 333,  // onesuperior
+	186: 365,  // ordmasculine
+	187: 556,  // guillemotright
+	188: 834,  // onequarter
+	189: 834,  // onehalf
+	190: 834,  // threequarters
+	191: 611,  // questiondown
+	192: 722,  // Agrave
+	193: 722,  // Aacute
+	194: 722,  // Acircumflex
+	195: 722,  // Atilde
+	196: 722,  // Adieresis
+	197: 722,  // Aring
+	198: 1000, // AE
+	199: 722,  // Ccedilla
+	200: 667,  // Egrave
+	201: 667,  // Eacute
+	202: 667,  // Ecircumflex
+	203: 667,  // Edieresis
+	204: 278,  // Igrave
+	205: 278,  // Iacute
+	206: 278,  // Icircumflex
+	207: 278,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 778,  // Ograve
+	211: 778,  // Oacute
+	212: 778,  // Ocircumflex
+	213: 778,  // Otilde
+	214: 778,  // Odieresis
+	215: 584,  // multiply
+	216: 778,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 667,  // Yacute
+	222: 667,  // Thorn
+	223: 611,  // germandbls
+	224: 556,  // agrave
+	225: 556,  // aacute
+	226: 556,  // acircumflex
+	227: 556,  // atilde
+	228: 556,  // adieresis
+	229: 556,  // aring
+	230: 889,  // ae
+	231: 556,  // ccedilla
+	232: 556,  // egrave
+	233: 556,  // eacute
+	234: 556,  // ecircumflex
+	235: 556,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 611,  // eth
+	241: 611,  // ntilde
+	242: 611,  // ograve
+	243: 611,  // oacute
+	244: 611,  // ocircumflex
+	245: 611,  // otilde
+	246: 611,  // odieresis
+	247: 584,  // divide
+	248: 611,  // oslash
+	249: 611,  // ugrave
+	250: 611,  // uacute
+	251: 611,  // ucircumflex
+	252: 611,  // udieresis
+	253: 556,  // yacute
+	254: 611,  // thorn
+	255: 556,  // ydieresis
+}
+
+var helveticaBoldKerning = map[kernPair]int16{
+	{32, 84}:   -100, // space T
+	{32, 86}:   -80,  // space V
+	{32, 87}:   -80,  // space W
+	{32, 89}:   -120, // space Y
+	{32, 145}:  -60,  // space quoteleft
+	{32, 147}:  -80,  // space quotedblleft
+	{32, 159}:  -120, // space Ydieresis
+	{32, 221}:  -120, // space Yacute
+	{44, 32}:   -40,  // comma space
+	{44, 146}:  -120, // comma quoteright
+	{44, 148}:  -120, // comma quotedblright
+	{44, 160}:  -40,  // comma space
+	{46, 32}:   -40,  // period space
+	{46, 146}:  -120, // period quoteright
+	{46, 148}:  -120, // period quotedblright
+	{46, 160}:  -40,  // period space
+	{58, 32}:   -40,  // colon space
+	{58, 160}:  -40,  // colon space
+	{59, 32}:   -40,  // semicolon space
+	{59, 160}:  -40,  // semicolon space
+	{65, 67}:   -40,  // A C
+	{65, 71}:   -50,  // A G
+	{65, 79}:   -40,  // A O
+	{65, 81}:   -40,  // A Q
+	{65, 84}:   -90,  // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -80,  // A V
+	{65, 87}:   -60,  // A W
+	{65, 89}:   -110, // A Y
+	{65, 117}:  -30,  // A u
+	{65, 118}:  -40,  // A v
+	{65, 119}:  -30,  // A w
+	{65, 121}:  -30,  // A y
+	{65, 159}:  -110, // A Ydieresis
+	{65, 199}:  -40,  // A Ccedilla
+	{65, 210}:  -40,  // A Ograve
+	{65, 211}:  -40,  // A Oacute
+	{65, 212}:  -40,  // A Ocircumflex
+	{65, 213}:  -40,  // A Otilde
+	{65, 214}:  -40,  // A Odieresis
+	{65, 216}:  -40,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -110, // A Yacute
+	{65, 249}:  -30,  // A ugrave
+	{65, 250}:  -30,  // A uacute
+	{65, 251}:  -30,  // A ucircumflex
+	{65, 252}:  -30,  // A udieresis
+	{65, 253}:  -30,  // A yacute
+	{65, 255}:  -30,  // A ydieresis
+	{66, 65}:   -30,  // B A
+	{66, 85}:   -10,  // B U
+	{66, 192}:  -30,  // B Agrave
+	{66, 193}:  -30,  // B Aacute
+	{66, 194}:  -30,  // B Acircumflex
+	{66, 195}:  -30,  // B Atilde
+	{66, 196}:  -30,  // B Adieresis
+	{66, 197}:  -30,  // B Aring
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{68, 44}:   -30,  // D comma
+	{68, 46}:   -30,  // D period
+	{68, 65}:   -40,  // D A
+	{68, 86}:   -40,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -70,  // D Y
+	{68, 159}:  -70,  // D Ydieresis
+	{68, 192}:  -40,  // D Agrave
+	{68, 193}:  -40,  // D Aacute
+	{68, 194}:  -40,  // D Acircumflex
+	{68, 195}:  -40,  // D Atilde
+	{68, 196}:  -40,  // D Adieresis
+	{68, 197}:  -40,  // D Aring
+	{68, 221}:  -70,  // D Yacute
+	{70, 44}:   -100, // F comma
+	{70, 46}:   -100, // F period
+	{70, 65}:   -80,  // F A
+	{70, 97}:   -20,  // F a
+	{70, 192}:  -80,  // F Agrave
+	{70, 193}:  -80,  // F Aacute
+	{70, 194}:  -80,  // F Acircumflex
+	{70, 195}:  -80,  // F Atilde
+	{70, 196}:  -80,  // F Adieresis
+	{70, 197}:  -80,  // F Aring
+	{70, 224}:  -20,  // F agrave
+	{70, 225}:  -20,  // F aacute
+	{70, 226}:  -20,  // F acircumflex
+	{70, 227}:  -20,  // F atilde
+	{70, 228}:  -20,  // F adieresis
+	{70, 229}:  -20,  // F aring
+	{74, 44}:   -20,  // J comma
+	{74, 46}:   -20,  // J period
+	{74, 65}:   -20,  // J A
+	{74, 117}:  -20,  // J u
+	{74, 192}:  -20,  // J Agrave
+	{74, 193}:  -20,  // J Aacute
+	{74, 194}:  -20,  // J Acircumflex
+	{74, 195}:  -20,  // J Atilde
+	{74, 196}:  -20,  // J Adieresis
+	{74, 197}:  -20,  // J Aring
+	{74, 249}:  -20,  // J ugrave
+	{74, 250}:  -20,  // J uacute
+	{74, 251}:  -20,  // J ucircumflex
+	{74, 252}:  -20,  // J udieresis
+	{75, 79}:   -30,  // K O
+	{75, 101}:  -15,  // K e
+	{75, 111}:  -35,  // K o
+	{75, 117}:  -30,  // K u
+	{75, 121}:  -40,  // K y
+	{75, 210}:  -30,  // K Ograve
+	{75, 211}:  -30,  // K Oacute
+	{75, 212}:  -30,  // K Ocircumflex
+	{75, 213}:  -30,  // K Otilde
+	{75, 214}:  -30,  // K Odieresis
+	{75, 216}:  -30,  // K Oslash
+	{75, 232}:  -15,  // K egrave
+	{75, 233}:  -15,  // K eacute
+	{75, 234}:  -15,  // K ecircumflex
+	{75, 235}:  -15,  // K edieresis
+	{75, 242}:  -35,  // K ograve
+	{75, 243}:  -35,  // K oacute
+	{75, 244}:  -35,  // K ocircumflex
+	{75, 245}:  -35,  // K otilde
+	{75, 246}:  -35,  // K odieresis
+	{75, 248}:  -35,  // K oslash
+	{75, 249}:  -30,  // K ugrave
+	{75, 250}:  -30,  // K uacute
+	{75, 251}:  -30,  // K ucircumflex
+	{75, 252}:  -30,  // K udieresis
+	{75, 253}:  -40,  // K yacute
+	{75, 255}:  -40,  // K ydieresis
+	{76, 84}:   -90,  // L T
+	{76, 86}:   -110, // L V
+	{76, 87}:   -80,  // L W
+	{76, 89}:   -120, // L Y
+	{76, 121}:  -30,  // L y
+	{76, 146}:  -140, // L quoteright
+	{76, 148}:  -140, // L quotedblright
+	{76, 159}:  -120, // L Ydieresis
+	{76, 221}:  -120, // L Yacute
+	{76, 253}:  -30,  // L yacute
+	{76, 255}:  -30,  // L ydieresis
+	{79, 44}:   -40,  // O comma
+	{79, 46}:   -40,  // O period
+	{79, 65}:   -50,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -50,  // O W
+	{79, 88}:   -50,  // O X
+	{79, 89}:   -70,  // O Y
+	{79, 159}:  -70,  // O Ydieresis
+	{79, 192}:  -50,  // O Agrave
+	{79, 193}:  -50,  // O Aacute
+	{79, 194}:  -50,  // O Acircumflex
+	{79, 195}:  -50,  // O Atilde
+	{79, 196}:  -50,  // O Adieresis
+	{79, 197}:  -50,  // O Aring
+	{79, 221}:  -70,  // O Yacute
+	{80, 44}:   -120, // P comma
+	{80, 46}:   -120, // P period
+	{80, 65}:   -100, // P A
+	{80, 97}:   -30,  // P a
+	{80, 101}:  -30,  // P e
+	{80, 111}:  -40,  // P o
+	{80, 192}:  -100, // P Agrave
+	{80, 193}:  -100, // P Aacute
+	{80, 194}:  -100, // P Acircumflex
+	{80, 195}:  -100, // P Atilde
+	{80, 196}:  -100, // P Adieresis
+	{80, 197}:  -100, // P Aring
+	{80, 224}:  -30,  // P agrave
+	{80, 225}:  -30,  // P aacute
+	{80, 226}:  -30,  // P acircumflex
+	{80, 227}:  -30,  // P atilde
+	{80, 228}:  -30,  // P adieresis
+	{80, 229}:  -30,  // P aring
+	{80, 232}:  -30,  // P egrave
+	{80, 233}:  -30,  // P eacute
+	{80, 234}:  -30,  // P ecircumflex
+	{80, 235}:  -30,  // P edieresis
+	{80, 242}:  -40,  // P ograve
+	{80, 243}:  -40,  // P oacute
+	{80, 244}:  -40,  // P ocircumflex
+	{80, 245}:  -40,  // P otilde
+	{80, 246}:  -40,  // P odieresis
+	{80, 248}:  -40,  // P oslash
+	{81, 44}:   20,   // Q comma
+	{81, 46}:   20,   // Q period
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -20,  // R O
+	{82, 84}:   -20,  // R T
+	{82, 85}:   -20,  // R U
+	{82, 86}:   -50,  // R V
+	{82, 87}:   -40,  // R W
+	{82, 89}:   -50,  // R Y
+	{82, 159}:  -50,  // R Ydieresis
+	{82, 210}:  -20,  // R Ograve
+	{82, 211}:  -20,  // R Oacute
+	{82, 212}:  -20,  // R Ocircumflex
+	{82, 213}:  -20,  // R Otilde
+	{82, 214}:  -20,  // R Odieresis
+	{82, 216}:  -20,  // R Oslash
+	{82, 217}:  -20,  // R Ugrave
+	{82, 218}:  -20,  // R Uacute
+	{82, 219}:  -20,  // R Ucircumflex
+	{82, 220}:  -20,  // R Udieresis
+	{82, 221}:  -50,  // R Yacute
+	{84, 44}:   -80,  // T comma
+	{84, 45}:   -120, // T hyphen
+	{84, 46}:   -80,  // T period
+	{84, 58}:   -40,  // T colon
+	{84, 59}:   -40,  // T semicolon
+	{84, 65}:   -90,  // T A
+	{84, 79}:   -40,  // T O
+	{84, 97}:   -80,  // T a
+	{84, 101}:  -60,  // T e
+	{84, 111}:  -80,  // T o
+	{84, 114}:  -80,  // T r
+	{84, 117}:  -90,  // T u
+	{84, 119}:  -60,  // T w
+	{84, 121}:  -60,  // T y
+	{84, 173}:  -120, // T hyphen
+	{84, 192}:  -90,  // T Agrave
+	{84, 193}:  -90,  // T Aacute
+	{84, 194}:  -90,  // T Acircumflex
+	{84, 195}:  -90,  // T Atilde
+	{84, 196}:  -90,  // T Adieresis
+	{84, 197}:  -90,  // T Aring
+	{84, 210}:  -40,  // T Ograve
+	{84, 211}:  -40,  // T Oacute
+	{84, 212}:  -40,  // T Ocircumflex
+	{84, 213}:  -40,  // T Otilde
+	{84, 214}:  -40,  // T Odieresis
+	{84, 216}:  -40,  // T Oslash
+	{84, 224}:  -80,  // T agrave
+	{84, 225}:  -80,  // T aacute
+	{84, 226}:  -80,  // T acircumflex
+	{84, 227}:  -80,  // T atilde
+	{84, 228}:  -80,  // T adieresis
+	{84, 229}:  -80,  // T aring
+	{84, 232}:  -60,  // T egrave
+	{84, 233}:  -60,  // T eacute
+	{84, 234}:  -60,  // T ecircumflex
+	{84, 235}:  -60,  // T edieresis
+	{84, 242}:  -80,  // T ograve
+	{84, 243}:  -80,  // T oacute
+	{84, 244}:  -80,  // T ocircumflex
+	{84, 245}:  -80,  // T otilde
+	{84, 246}:  -80,  // T odieresis
+	{84, 248}:  -80,  // T oslash
+	{84, 249}:  -90,  // T ugrave
+	{84, 250}:  -90,  // T uacute
+	{84, 251}:  -90,  // T ucircumflex
+	{84, 252}:  -90,  // T udieresis
+	{84, 253}:  -60,  // T yacute
+	{84, 255}:  -60,  // T ydieresis
+	{85, 44}:   -30,  // U comma
+	{85, 46}:   -30,  // U period
+	{85, 65}:   -50,  // U A
+	{85, 192}:  -50,  // U Agrave
+	{85, 193}:  -50,  // U Aacute
+	{85, 194}:  -50,  // U Acircumflex
+	{85, 195}:  -50,  // U Atilde
+	{85, 196}:  -50,  // U Adieresis
+	{85, 197}:  -50,  // U Aring
+	{86, 44}:   -120, // V comma
+	{86, 45}:   -80,  // V hyphen
+	{86, 46}:   -120, // V period
+	{86, 58}:   -40,  // V colon
+	{86, 59}:   -40,  // V semicolon
+	{86, 65}:   -80,  // V A
+	{86, 71}:   -50,  // V G
+	{86, 79}:   -50,  // V O
+	{86, 97}:   -60,  // V a
+	{86, 101}:  -50,  // V e
+	{86, 111}:  -90,  // V o
+	{86, 117}:  -60,  // V u
+	{86, 173}:  -80,  // V hyphen
+	{86, 192}:  -80,  // V Agrave
+	{86, 193}:  -80,  // V Aacute
+	{86, 194}:  -80,  // V Acircumflex
+	{86, 195}:  -80,  // V Atilde
+	{86, 196}:  -80,  // V Adieresis
+	{86, 197}:  -80,  // V Aring
+	{86, 210}:  -50,  // V Ograve
+	{86, 211}:  -50,  // V Oacute
+	{86, 212}:  -50,  // V Ocircumflex
+	{86, 213}:  -50,  // V Otilde
+	{86, 214}:  -50,  // V Odieresis
+	{86, 216}:  -50,  // V Oslash
+	{86, 224}:  -60,  // V agrave
+	{86, 225}:  -60,  // V aacute
+	{86, 226}:  -60,  // V acircumflex
+	{86, 227}:  -60,  // V atilde
+	{86, 228}:  -60,  // V adieresis
+	{86, 229}:  -60,  // V aring
+	{86, 232}:  -50,  // V egrave
+	{86, 233}:  -50,  // V eacute
+	{86, 234}:  -50,  // V ecircumflex
+	{86, 235}:  -50,  // V edieresis
+	{86, 242}:  -90,  // V ograve
+	{86, 243}:  -90,  // V oacute
+	{86, 244}:  -90,  // V ocircumflex
+	{86, 245}:  -90,  // V otilde
+	{86, 246}:  -90,  // V odieresis
+	{86, 248}:  -90,  // V oslash
+	{86, 249}:  -60,  // V ugrave
+	{86, 250}:  -60,  // V uacute
+	{86, 251}:  -60,  // V ucircumflex
+	{86, 252}:  -60,  // V udieresis
+	{87, 44}:   -80,  // W comma
+	{87, 45}:   -40,  // W hyphen
+	{87, 46}:   -80,  // W period
+	{87, 58}:   -10,  // W colon
+	{87, 59}:   -10,  // W semicolon
+	{87, 65}:   -60,  // W A
+	{87, 79}:   -20,  // W O
+	{87, 97}:   -40,  // W a
+	{87, 101}:  -35,  // W e
+	{87, 111}:  -60,  // W o
+	{87, 117}:  -45,  // W u
+	{87, 121}:  -20,  // W y
+	{87, 173}:  -40,  // W hyphen
+	{87, 192}:  -60,  // W Agrave
+	{87, 193}:  -60,  // W Aacute
+	{87, 194}:  -60,  // W Acircumflex
+	{87, 195}:  -60,  // W Atilde
+	{87, 196}:  -60,  // W Adieresis
+	{87, 197}:  -60,  // W Aring
+	{87, 210}:  -20,  // W Ograve
+	{87, 211}:  -20,  // W Oacute
+	{87, 212}:  -20,  // W Ocircumflex
+	{87, 213}:  -20,  // W Otilde
+	{87, 214}:  -20,  // W Odieresis
+	{87, 216}:  -20,  // W Oslash
+	{87, 224}:  -40,  // W agrave
+	{87, 225}:  -40,  // W aacute
+	{87, 226}:  -40,  // W acircumflex
+	{87, 227}:  -40,  // W atilde
+	{87, 228}:  -40,  // W adieresis
+	{87, 229}:  -40,  // W aring
+	{87, 232}:  -35,  // W egrave
+	{87, 233}:  -35,  // W eacute
+	{87, 234}:  -35,  // W ecircumflex
+	{87, 235}:  -35,  // W edieresis
+	{87, 242}:  -60,  // W ograve
+	{87, 243}:  -60,  // W oacute
+	{87, 244}:  -60,  // W ocircumflex
+	{87, 245}:  -60,  // W otilde
+	{87, 246}:  -60,  // W odieresis
+	{87, 248}:  -60,  // W oslash
+	{87, 249}:  -45,  // W ugrave
+	{87, 250}:  -45,  // W uacute
+	{87, 251}:  -45,  // W ucircumflex
+	{87, 252}:  -45,  // W udieresis
+	{87, 253}:  -20,  // W yacute
+	{87, 255}:  -20,  // W ydieresis
+	{89, 44}:   -100, // Y comma
+	{89, 46}:   -100, // Y period
+	{89, 58}:   -50,  // Y colon
+	{89, 59}:   -50,  // Y semicolon
+	{89, 65}:   -110, // Y A
+	{89, 79}:   -70,  // Y O
+	{89, 97}:   -90,  // Y a
+	{89, 101}:  -80,  // Y e
+	{89, 111}:  -100, // Y o
+	{89, 117}:  -100, // Y u
+	{89, 192}:  -110, // Y Agrave
+	{89, 193}:  -110, // Y Aacute
+	{89, 194}:  -110, // Y Acircumflex
+	{89, 195}:  -110, // Y Atilde
+	{89, 196}:  -110, // Y Adieresis
+	{89, 197}:  -110, // Y Aring
+	{89, 210}:  -70,  // Y Ograve
+	{89, 211}:  -70,  // Y Oacute
+	{89, 212}:  -70,  // Y Ocircumflex
+	{89, 213}:  -70,  // Y Otilde
+	{89, 214}:  -70,  // Y Odieresis
+	{89, 216}:  -70,  // Y Oslash
+	{89, 224}:  -90,  // Y agrave
+	{89, 225}:  -90,  // Y aacute
+	{89, 226}:  -90,  // Y acircumflex
+	{89, 227}:  -90,  // Y atilde
+	{89, 228}:  -90,  // Y adieresis
+	{89, 229}:  -90,  // Y aring
+	{89, 232}:  -80,  // Y egrave
+	{89, 233}:  -80,  // Y eacute
+	{89, 234}:  -80,  // Y ecircumflex
+	{89, 235}:  -80,  // Y edieresis
+	{89, 242}:  -100, // Y ograve
+	{89, 243}:  -100, // Y oacute
+	{89, 244}:  -100, // Y ocircumflex
+	{89, 245}:  -100, // Y otilde
+	{89, 246}:  -100, // Y odieresis
+	{89, 248}:  -100, // Y oslash
+	{89, 249}:  -100, // Y ugrave
+	{89, 250}:  -100, // Y uacute
+	{89, 251}:  -100, // Y ucircumflex
+	{89, 252}:  -100, // Y udieresis
+	{97, 103}:  -10,  // a g
+	{97, 118}:  -15,  // a v
+	{97, 119}:  -15,  // a w
+	{97, 121}:  -20,  // a y
+	{97, 253}:  -20,  // a yacute
+	{97, 255}:  -20,  // a ydieresis
+	{98, 108}:  -10,  // b l
+	{98, 117}:  -20,  // b u
+	{98, 118}:  -20,  // b v
+	{98, 121}:  -20,  // b y
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{98, 253}:  -20,  // b yacute
+	{98, 255}:  -20,  // b ydieresis
+	{99, 104}:  -10,  // c h
+	{99, 107}:  -20,  // c k
+	{99, 108}:  -20,  // c l
+	{99, 121}:  -10,  // c y
+	{99, 253}:  -10,  // c yacute
+	{99, 255}:  -10,  // c ydieresis
+	{100, 100}: -10,  // d d
+	{100, 118}: -15,  // d v
+	{100, 119}: -15,  // d w
+	{100, 121}: -15,  // d y
+	{100, 253}: -15,  // d yacute
+	{100, 255}: -15,  // d ydieresis
+	{101, 44}:  10,   // e comma
+	{101, 46}:  20,   // e period
+	{101, 118}: -15,  // e v
+	{101, 119}: -15,  // e w
+	{101, 120}: -15,  // e x
+	{101, 121}: -15,  // e y
+	{101, 253}: -15,  // e yacute
+	{101, 255}: -15,  // e ydieresis
+	{102, 44}:  -10,  // f comma
+	{102, 46}:  -10,  // f period
+	{102, 101}: -10,  // f e
+	{102, 111}: -20,  // f o
+	{102, 146}: 30,   // f quoteright
+	{102, 148}: 30,   // f quotedblright
+	{102, 232}: -10,  // f egrave
+	{102, 233}: -10,  // f eacute
+	{102, 234}: -10,  // f ecircumflex
+	{102, 235}: -10,  // f edieresis
+	{102, 242}: -20,  // f ograve
+	{102, 243}: -20,  // f oacute
+	{102, 244}: -20,  // f ocircumflex
+	{102, 245}: -20,  // f otilde
+	{102, 246}: -20,  // f odieresis
+	{102, 248}: -20,  // f oslash
+	{103, 101}: 10,   // g e
+	{103, 103}: -10,  // g g
+	{103, 232}: 10,   // g egrave
+	{103, 233}: 10,   // g eacute
+	{103, 234}: 10,   // g ecircumflex
+	{103, 235}: 10,   // g edieresis
+	{104, 121}: -20,  // h y
+	{104, 253}: -20,  // h yacute
+	{104, 255}: -20,  // h ydieresis
+	{107, 111}: -15,  // k o
+	{107, 242}: -15,  // k ograve
+	{107, 243}: -15,  // k oacute
+	{107, 244}: -15,  // k ocircumflex
+	{107, 245}: -15,  // k otilde
+	{107, 246}: -15,  // k odieresis
+	{107, 248}: -15,  // k oslash
+	{108, 119}: -15,  // l w
+	{108, 121}: -15,  // l y
+	{108, 253}: -15,  // l yacute
+	{108, 255}: -15,  // l ydieresis
+	{109, 117}: -20,  // m u
+	{109, 121}: -30,  // m y
+	{109, 249}: -20,  // m ugrave
+	{109, 250}: -20,  // m uacute
+	{109, 251}: -20,  // m ucircumflex
+	{109, 252}: -20,  // m udieresis
+	{109, 253}: -30,  // m yacute
+	{109, 255}: -30,  // m ydieresis
+	{110, 117}: -10,  // n u
+	{110, 118}: -40,  // n v
+	{110, 121}: -20,  // n y
+	{110, 249}: -10,  // n ugrave
+	{110, 250}: -10,  // n uacute
+	{110, 251}: -10,  // n ucircumflex
+	{110, 252}: -10,  // n udieresis
+	{110, 253}: -20,  // n yacute
+	{110, 255}: -20,  // n ydieresis
+	{111, 118}: -20,  // o v
+	{111, 119}: -15,  // o w
+	{111, 120}: -30,  // o x
+	{111, 121}: -20,  // o y
+	{111, 253}: -20,  // o yacute
+	{111, 255}: -20,  // o ydieresis
+	{112, 121}: -15,  // p y
+	{112, 253}: -15,  // p yacute
+	{112, 255}: -15,  // p ydieresis
+	{114, 44}:  -60,  // r comma
+	{114, 45}:  -20,  // r hyphen
+	{114, 46}:  -60,  // r period
+	{114, 99}:  -20,  // r c
+	{114, 100}: -20,  // r d
+	{114, 103}: -15,  // r g
+	{114, 111}: -20,  // r o
+	{114, 113}: -20,  // r q
+	{114, 115}: -15,  // r s
+	{114, 116}: 20,   // r t
+	{114, 118}: 10,   // r v
+	{114, 121}: 10,   // r y
+	{114, 154}: -15,  // r scaron
+	{114, 173}: -20,  // r hyphen
+	{114, 231}: -20,  // r ccedilla
+	{114, 242}: -20,  // r ograve
+	{114, 243}: -20,  // r oacute
+	{114, 244}: -20,  // r ocircumflex
+	{114, 245}: -20,  // r otilde
+	{114, 246}: -20,  // r odieresis
+	{114, 248}: -20,  // r oslash
+	{114, 253}: 10,   // r yacute
+	{114, 255}: 10,   // r ydieresis
+	{115, 119}: -15,  // s w
+	{118, 44}:  -80,  // v comma
+	{118, 46}:  -80,  // v period
+	{118, 97}:  -20,  // v a
+	{118, 111}: -30,  // v o
+	{118, 224}: -20,  // v agrave
+	{118, 225}: -20,  // v aacute
+	{118, 226}: -20,  // v acircumflex
+	{118, 227}: -20,  // v atilde
+	{118, 228}: -20,  // v adieresis
+	{118, 229}: -20,  // v aring
+	{118, 242}: -30,  // v ograve
+	{118, 243}: -30,  // v oacute
+	{118, 244}: -30,  // v ocircumflex
+	{118, 245}: -30,  // v otilde
+	{118, 246}: -30,  // v odieresis
+	{118, 248}: -30,  // v oslash
+	{119, 44}:  -40,  // w comma
+	{119, 46}:  -40,  // w period
+	{119, 111}: -20,  // w o
+	{119, 242}: -20,  // w ograve
+	{119, 243}: -20,  // w oacute
+	{119, 244}: -20,  // w ocircumflex
+	{119, 245}: -20,  // w otilde
+	{119, 246}: -20,  // w odieresis
+	{119, 248}: -20,  // w oslash
+	{120, 101}: -10,  // x e
+	{120, 232}: -10,  // x egrave
+	{120, 233}: -10,  // x eacute
+	{120, 234}: -10,  // x ecircumflex
+	{120, 235}: -10,  // x edieresis
+	{121, 44}:  -80,  // y comma
+	{121, 46}:  -80,  // y period
+	{121, 97}:  -30,  // y a
+	{121, 101}: -10,  // y e
+	{121, 111}: -25,  // y o
+	{121, 224}: -30,  // y agrave
+	{121, 225}: -30,  // y aacute
+	{121, 226}: -30,  // y acircumflex
+	{121, 227}: -30,  // y atilde
+	{121, 228}: -30,  // y adieresis
+	{121, 229}: -30,  // y aring
+	{121, 232}: -10,  // y egrave
+	{121, 233}: -10,  // y eacute
+	{121, 234}: -10,  // y ecircumflex
+	{121, 235}: -10,  // y edieresis
+	{121, 242}: -25,  // y ograve
+	{121, 243}: -25,  // y oacute
+	{121, 244}: -25,  // y ocircumflex
+	{121, 245}: -25,  // y otilde
+	{121, 246}: -25,  // y odieresis
+	{121, 248}: -25,  // y oslash
+	{122, 101}: 10,   // z e
+	{122, 232}: 10,   // z egrave
+	{122, 233}: 10,   // z eacute
+	{122, 234}: 10,   // z ecircumflex
+	{122, 235}: 10,   // z edieresis
+	{145, 145}: -46,  // quoteleft quoteleft
+	{146, 32}:  -80,  // quoteright space
+	{146, 100}: -80,  // quoteright d
+	{146, 108}: -20,  // quoteright l
+	{146, 114}: -40,  // quoteright r
+	{146, 115}: -60,  // quoteright s
+	{146, 118}: -20,  // quoteright v
+	{146, 146}: -46,  // quoteright quoteright
+	{146, 154}: -60,  // quoteright scaron
+	{146, 160}: -80,  // quoteright space
+	{148, 32}:  -80,  // quotedblright space
+	{148, 160}: -80,  // quotedblright space
+	{154, 119}: -15,  // scaron w
+	{158, 101}: 10,   // zcaron e
+	{158, 232}: 10,   // zcaron egrave
+	{158, 233}: 10,   // zcaron eacute
+	{158, 234}: 10,   // zcaron ecircumflex
+	{158, 235}: 10,   // zcaron edieresis
+	{159, 44}:  -100, // Ydieresis comma
+	{159, 46}:  -100, // Ydieresis period
+	{159, 58}:  -50,  // Ydieresis colon
+	{159, 59}:  -50,  // Ydieresis semicolon
+	{159, 65}:  -110, // Ydieresis A
+	{159, 79}:  -70,  // Ydieresis O
+	{159, 97}:  -90,  // Ydieresis a
+	{159, 101}: -80,  // Ydieresis e
+	{159, 111}: -100, // Ydieresis o
+	{159, 117}: -100, // Ydieresis u
+	{159, 192}: -110, // Ydieresis Agrave
+	{159, 193}: -110, // Ydieresis Aacute
+	{159, 194}: -110, // Ydieresis Acircumflex
+	{159, 195}: -110, // Ydieresis Atilde
+	{159, 196}: -110, // Ydieresis Adieresis
+	{159, 197}: -110, // Ydieresis Aring
+	{159, 210}: -70,  // Ydieresis Ograve
+	{159, 211}: -70,  // Ydieresis Oacute
+	{159, 212}: -70,  // Ydieresis Ocircumflex
+	{159, 213}: -70,  // Ydieresis Otilde
+	{159, 214}: -70,  // Ydieresis Odieresis
+	{159, 216}: -70,  // Ydieresis Oslash
+	{159, 224}: -90,  // Ydieresis agrave
+	{159, 225}: -90,  // Ydieresis aacute
+	{159, 226}: -90,  // Ydieresis acircumflex
+	{159, 227}: -90,  // Ydieresis atilde
+	{159, 228}: -90,  // Ydieresis adieresis
+	{159, 229}: -90,  // Ydieresis aring
+	{159, 232}: -80,  // Ydieresis egrave
+	{159, 233}: -80,  // Ydieresis eacute
+	{159, 234}: -80,  // Ydieresis ecircumflex
+	{159, 235}: -80,  // Ydieresis edieresis
+	{159, 242}: -100, // Ydieresis ograve
+	{159, 243}: -100, // Ydieresis oacute
+	{159, 244}: -100, // Ydieresis ocircumflex
+	{159, 245}: -100, // Ydieresis otilde
+	{159, 246}: -100, // Ydieresis odieresis
+	{159, 248}: -100, // Ydieresis oslash
+	{159, 249}: -100, // Ydieresis ugrave
+	{159, 250}: -100, // Ydieresis uacute
+	{159, 251}: -100, // Ydieresis ucircumflex
+	{159, 252}: -100, // Ydieresis udieresis
+	{160, 84}:  -100, // space T
+	{160, 86}:  -80,  // space V
+	{160, 87}:  -80,  // space W
+	{160, 89}:  -120, // space Y
+	{160, 145}: -60,  // space quoteleft
+	{160, 147}: -80,  // space quotedblleft
+	{160, 159}: -120, // space Ydieresis
+	{160, 221}: -120, // space Yacute
+	{192, 67}:  -40,  // Agrave C
+	{192, 71}:  -50,  // Agrave G
+	{192, 79}:  -40,  // Agrave O
+	{192, 81}:  -40,  // Agrave Q
+	{192, 84}:  -90,  // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -80,  // Agrave V
+	{192, 87}:  -60,  // Agrave W
+	{192, 89}:  -110, // Agrave Y
+	{192, 117}: -30,  // Agrave u
+	{192, 118}: -40,  // Agrave v
+	{192, 119}: -30,  // Agrave w
+	{192, 121}: -30,  // Agrave y
+	{192, 159}: -110, // Agrave Ydieresis
+	{192, 199}: -40,  // Agrave Ccedilla
+	{192, 210}: -40,  // Agrave Ograve
+	{192, 211}: -40,  // Agrave Oacute
+	{192, 212}: -40,  // Agrave Ocircumflex
+	{192, 213}: -40,  // Agrave Otilde
+	{192, 214}: -40,  // Agrave Odieresis
+	{192, 216}: -40,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -110, // Agrave Yacute
+	{192, 249}: -30,  // Agrave ugrave
+	{192, 250}: -30,  // Agrave uacute
+	{192, 251}: -30,  // Agrave ucircumflex
+	{192, 252}: -30,  // Agrave udieresis
+	{192, 253}: -30,  // Agrave yacute
+	{192, 255}: -30,  // Agrave ydieresis
+	{193, 67}:  -40,  // Aacute C
+	{193, 71}:  -50,  // Aacute G
+	{193, 79}:  -40,  // Aacute O
+	{193, 81}:  -40,  // Aacute Q
+	{193, 84}:  -90,  // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -80,  // Aacute V
+	{193, 87}:  -60,  // Aacute W
+	{193, 89}:  -110, // Aacute Y
+	{193, 117}: -30,  // Aacute u
+	{193, 118}: -40,  // Aacute v
+	{193, 119}: -30,  // Aacute w
+	{193, 121}: -30,  // Aacute y
+	{193, 159}: -110, // Aacute Ydieresis
+	{193, 199}: -40,  // Aacute Ccedilla
+	{193, 210}: -40,  // Aacute Ograve
+	{193, 211}: -40,  // Aacute Oacute
+	{193, 212}: -40,  // Aacute Ocircumflex
+	{193, 213}: -40,  // Aacute Otilde
+	{193, 214}: -40,  // Aacute Odieresis
+	{193, 216}: -40,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -110, // Aacute Yacute
+	{193, 249}: -30,  // Aacute ugrave
+	{193, 250}: -30,  // Aacute uacute
+	{193, 251}: -30,  // Aacute ucircumflex
+	{193, 252}: -30,  // Aacute udieresis
+	{193, 253}: -30,  // Aacute yacute
+	{193, 255}: -30,  // Aacute ydieresis
+	{194, 67}:  -40,  // Acircumflex C
+	{194, 71}:  -50,  // Acircumflex G
+	{194, 79}:  -40,  // Acircumflex O
+	{194, 81}:  -40,  // Acircumflex Q
+	{194, 84}:  -90,  // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -80,  // Acircumflex V
+	{194, 87}:  -60,  // Acircumflex W
+	{194, 89}:  -110, // Acircumflex Y
+	{194, 117}: -30,  // Acircumflex u
+	{194, 118}: -40,  // Acircumflex v
+	{194, 119}: -30,  // Acircumflex w
+	{194, 121}: -30,  // Acircumflex y
+	{194, 159}: -110, // Acircumflex Ydieresis
+	{194, 199}: -40,  // Acircumflex Ccedilla
+	{194, 210}: -40,  // Acircumflex Ograve
+	{194, 211}: -40,  // Acircumflex Oacute
+	{194, 212}: -40,  // Acircumflex Ocircumflex
+	{194, 213}: -40,  // Acircumflex Otilde
+	{194, 214}: -40,  // Acircumflex Odieresis
+	{194, 216}: -40,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -110, // Acircumflex Yacute
+	{194, 249}: -30,  // Acircumflex ugrave
+	{194, 250}: -30,  // Acircumflex uacute
+	{194, 251}: -30,  // Acircumflex ucircumflex
+	{194, 252}: -30,  // Acircumflex udieresis
+	{194, 253}: -30,  // Acircumflex yacute
+	{194, 255}: -30,  // Acircumflex ydieresis
+	{195, 67}:  -40,  // Atilde C
+	{195, 71}:  -50,  // Atilde G
+	{195, 79}:  -40,  // Atilde O
+	{195, 81}:  -40,  // Atilde Q
+	{195, 84}:  -90,  // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -80,  // Atilde V
+	{195, 87}:  -60,  // Atilde W
+	{195, 89}:  -110, // Atilde Y
+	{195, 117}: -30,  // Atilde u
+	{195, 118}: -40,  // Atilde v
+	{195, 119}: -30,  // Atilde w
+	{195, 121}: -30,  // Atilde y
+	{195, 159}: -110, // Atilde Ydieresis
+	{195, 199}: -40,  // Atilde Ccedilla
+	{195, 210}: -40,  // Atilde Ograve
+	{195, 211}: -40,  // Atilde Oacute
+	{195, 212}: -40,  // Atilde Ocircumflex
+	{195, 213}: -40,  // Atilde Otilde
+	{195, 214}: -40,  // Atilde Odieresis
+	{195, 216}: -40,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -110, // Atilde Yacute
+	{195, 249}: -30,  // Atilde ugrave
+	{195, 250}: -30,  // Atilde uacute
+	{195, 251}: -30,  // Atilde ucircumflex
+	{195, 252}: -30,  // Atilde udieresis
+	{195, 253}: -30,  // Atilde yacute
+	{195, 255}: -30,  // Atilde ydieresis
+	{196, 67}:  -40,  // Adieresis C
+	{196, 71}:  -50,  // Adieresis G
+	{196, 79}:  -40,  // Adieresis O
+	{196, 81}:  -40,  // Adieresis Q
+	{196, 84}:  -90,  // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -80,  // Adieresis V
+	{196, 87}:  -60,  // Adieresis W
+	{196, 89}:  -110, // Adieresis Y
+	{196, 117}: -30,  // Adieresis u
+	{196, 118}: -40,  // Adieresis v
+	{196, 119}: -30,  // Adieresis w
+	{196, 121}: -30,  // Adieresis y
+	{196, 159}: -110, // Adieresis Ydieresis
+	{196, 199}: -40,  // Adieresis Ccedilla
+	{196, 210}: -40,  // Adieresis Ograve
+	{196, 211}: -40,  // Adieresis Oacute
+	{196, 212}: -40,  // Adieresis Ocircumflex
+	{196, 213}: -40,  // Adieresis Otilde
+	{196, 214}: -40,  // Adieresis Odieresis
+	{196, 216}: -40,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -110, // Adieresis Yacute
+	{196, 249}: -30,  // Adieresis ugrave
+	{196, 250}: -30,  // Adieresis uacute
+	{196, 251}: -30,  // Adieresis ucircumflex
+	{196, 252}: -30,  // Adieresis udieresis
+	{196, 253}: -30,  // Adieresis yacute
+	{196, 255}: -30,  // Adieresis ydieresis
+	{197, 67}:  -40,  // Aring C
+	{197, 71}:  -50,  // Aring G
+	{197, 79}:  -40,  // Aring O
+	{197, 81}:  -40,  // Aring Q
+	{197, 84}:  -90,  // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -80,  // Aring V
+	{197, 87}:  -60,  // Aring W
+	{197, 89}:  -110, // Aring Y
+	{197, 117}: -30,  // Aring u
+	{197, 118}: -40,  // Aring v
+	{197, 119}: -30,  // Aring w
+	{197, 121}: -30,  // Aring y
+	{197, 159}: -110, // Aring Ydieresis
+	{197, 199}: -40,  // Aring Ccedilla
+	{197, 210}: -40,  // Aring Ograve
+	{197, 211}: -40,  // Aring Oacute
+	{197, 212}: -40,  // Aring Ocircumflex
+	{197, 213}: -40,  // Aring Otilde
+	{197, 214}: -40,  // Aring Odieresis
+	{197, 216}: -40,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -110, // Aring Yacute
+	{197, 249}: -30,  // Aring ugrave
+	{197, 250}: -30,  // Aring uacute
+	{197, 251}: -30,  // Aring ucircumflex
+	{197, 252}: -30,  // Aring udieresis
+	{197, 253}: -30,  // Aring yacute
+	{197, 255}: -30,  // Aring ydieresis
+	{210, 44}:  -40,  // Ograve comma
+	{210, 46}:  -40,  // Ograve period
+	{210, 65}:  -50,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -50,  // Ograve W
+	{210, 88}:  -50,  // Ograve X
+	{210, 89}:  -70,  // Ograve Y
+	{210, 159}: -70,  // Ograve Ydieresis
+	{210, 192}: -50,  // Ograve Agrave
+	{210, 193}: -50,  // Ograve Aacute
+	{210, 194}: -50,  // Ograve Acircumflex
+	{210, 195}: -50,  // Ograve Atilde
+	{210, 196}: -50,  // Ograve Adieresis
+	{210, 197}: -50,  // Ograve Aring
+	{210, 221}: -70,  // Ograve Yacute
+	{211, 44}:  -40,  // Oacute comma
+	{211, 46}:  -40,  // Oacute period
+	{211, 65}:  -50,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -50,  // Oacute W
+	{211, 88}:  -50,  // Oacute X
+	{211, 89}:  -70,  // Oacute Y
+	{211, 159}: -70,  // Oacute Ydieresis
+	{211, 192}: -50,  // Oacute Agrave
+	{211, 193}: -50,  // Oacute Aacute
+	{211, 194}: -50,  // Oacute Acircumflex
+	{211, 195}: -50,  // Oacute Atilde
+	{211, 196}: -50,  // Oacute Adieresis
+	{211, 197}: -50,  // Oacute Aring
+	{211, 221}: -70,  // Oacute Yacute
+	{212, 44}:  -40,  // Ocircumflex comma
+	{212, 46}:  -40,  // Ocircumflex period
+	{212, 65}:  -50,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -50,  // Ocircumflex W
+	{212, 88}:  -50,  // Ocircumflex X
+	{212, 89}:  -70,  // Ocircumflex Y
+	{212, 159}: -70,  // Ocircumflex Ydieresis
+	{212, 192}: -50,  // Ocircumflex Agrave
+	{212, 193}: -50,  // Ocircumflex Aacute
+	{212, 194}: -50,  // Ocircumflex Acircumflex
+	{212, 195}: -50,  // Ocircumflex Atilde
+	{212, 196}: -50,  // Ocircumflex Adieresis
+	{212, 197}: -50,  // Ocircumflex Aring
+	{212, 221}: -70,  // Ocircumflex Yacute
+	{213, 44}:  -40,  // Otilde comma
+	{213, 46}:  -40,  // Otilde period
+	{213, 65}:  -50,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -50,  // Otilde W
+	{213, 88}:  -50,  // Otilde X
+	{213, 89}:  -70,  // Otilde Y
+	{213, 159}: -70,  // Otilde Ydieresis
+	{213, 192}: -50,  // Otilde Agrave
+	{213, 193}: -50,  // Otilde Aacute
+	{213, 194}: -50,  // Otilde Acircumflex
+	{213, 195}: -50,  // Otilde Atilde
+	{213, 196}: -50,  // Otilde Adieresis
+	{213, 197}: -50,  // Otilde Aring
+	{213, 221}: -70,  // Otilde Yacute
+	{214, 44}:  -40,  // Odieresis comma
+	{214, 46}:  -40,  // Odieresis period
+	{214, 65}:  -50,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -50,  // Odieresis W
+	{214, 88}:  -50,  // Odieresis X
+	{214, 89}:  -70,  // Odieresis Y
+	{214, 159}: -70,  // Odieresis Ydieresis
+	{214, 192}: -50,  // Odieresis Agrave
+	{214, 193}: -50,  // Odieresis Aacute
+	{214, 194}: -50,  // Odieresis Acircumflex
+	{214, 195}: -50,  // Odieresis Atilde
+	{214, 196}: -50,  // Odieresis Adieresis
+	{214, 197}: -50,  // Odieresis Aring
+	{214, 221}: -70,  // Odieresis Yacute
+	{216, 44}:  -40,  // Oslash comma
+	{216, 46}:  -40,  // Oslash period
+	{216, 65}:  -50,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -50,  // Oslash W
+	{216, 88}:  -50,  // Oslash X
+	{216, 89}:  -70,  // Oslash Y
+	{216, 159}: -70,  // Oslash Ydieresis
+	{216, 192}: -50,  // Oslash Agrave
+	{216, 193}: -50,  // Oslash Aacute
+	{216, 194}: -50,  // Oslash Acircumflex
+	{216, 195}: -50,  // Oslash Atilde
+	{216, 196}: -50,  // Oslash Adieresis
+	{216, 197}: -50,  // Oslash Aring
+	{216, 221}: -70,  // Oslash Yacute
+	{217, 44}:  -30,  // Ugrave comma
+	{217, 46}:  -30,  // Ugrave period
+	{217, 65}:  -50,  // Ugrave A
+	{217, 192}: -50,  // Ugrave Agrave
+	{217, 193}: -50,  // Ugrave Aacute
+	{217, 194}: -50,  // Ugrave Acircumflex
+	{217, 195}: -50,  // Ugrave Atilde
+	{217, 196}: -50,  // Ugrave Adieresis
+	{217, 197}: -50,  // Ugrave Aring
+	{218, 44}:  -30,  // Uacute comma
+	{218, 46}:  -30,  // Uacute period
+	{218, 65}:  -50,  // Uacute A
+	{218, 192}: -50,  // Uacute Agrave
+	{218, 193}: -50,  // Uacute Aacute
+	{218, 194}: -50,  // Uacute Acircumflex
+	{218, 195}: -50,  // Uacute Atilde
+	{218, 196}: -50,  // Uacute Adieresis
+	{218, 197}: -50,  // Uacute Aring
+	{219, 44}:  -30,  // Ucircumflex comma
+	{219, 46}:  -30,  // Ucircumflex period
+	{219, 65}:  -50,  // Ucircumflex A
+	{219, 192}: -50,  // Ucircumflex Agrave
+	{219, 193}: -50,  // Ucircumflex Aacute
+	{219, 194}: -50,  // Ucircumflex Acircumflex
+	{219, 195}: -50,  // Ucircumflex Atilde
+	{219, 196}: -50,  // Ucircumflex Adieresis
+	{219, 197}: -50,  // Ucircumflex Aring
+	{220, 44}:  -30,  // Udieresis comma
+	{220, 46}:  -30,  // Udieresis period
+	{220, 65}:  -50,  // Udieresis A
+	{220, 192}: -50,  // Udieresis Agrave
+	{220, 193}: -50,  // Udieresis Aacute
+	{220, 194}: -50,  // Udieresis Acircumflex
+	{220, 195}: -50,  // Udieresis Atilde
+	{220, 196}: -50,  // Udieresis Adieresis
+	{220, 197}: -50,  // Udieresis Aring
+	{221, 44}:  -100, // Yacute comma
+	{221, 46}:  -100, // Yacute period
+	{221, 58}:  -50,  // Yacute colon
+	{221, 59}:  -50,  // Yacute semicolon
+	{221, 65}:  -110, // Yacute A
+	{221, 79}:  -70,  // Yacute O
+	{221, 97}:  -90,  // Yacute a
+	{221, 101}: -80,  // Yacute e
+	{221, 111}: -100, // Yacute o
+	{221, 117}: -100, // Yacute u
+	{221, 192}: -110, // Yacute Agrave
+	{221, 193}: -110, // Yacute Aacute
+	{221, 194}: -110, // Yacute Acircumflex
+	{221, 195}: -110, // Yacute Atilde
+	{221, 196}: -110, // Yacute Adieresis
+	{221, 197}: -110, // Yacute Aring
+	{221, 210}: -70,  // Yacute Ograve
+	{221, 211}: -70,  // Yacute Oacute
+	{221, 212}: -70,  // Yacute Ocircumflex
+	{221, 213}: -70,  // Yacute Otilde
+	{221, 214}: -70,  // Yacute Odieresis
+	{221, 216}: -70,  // Yacute Oslash
+	{221, 224}: -90,  // Yacute agrave
+	{221, 225}: -90,  // Yacute aacute
+	{221, 226}: -90,  // Yacute acircumflex
+	{221, 227}: -90,  // Yacute atilde
+	{221, 228}: -90,  // Yacute adieresis
+	{221, 229}: -90,  // Yacute aring
+	{221, 232}: -80,  // Yacute egrave
+	{221, 233}: -80,  // Yacute eacute
+	{221, 234}: -80,  // Yacute ecircumflex
+	{221, 235}: -80,  // Yacute edieresis
+	{221, 242}: -100, // Yacute ograve
+	{221, 243}: -100, // Yacute oacute
+	{221, 244}: -100, // Yacute ocircumflex
+	{221, 245}: -100, // Yacute otilde
+	{221, 246}: -100, // Yacute odieresis
+	{221, 248}: -100, // Yacute oslash
+	{221, 249}: -100, // Yacute ugrave
+	{221, 250}: -100, // Yacute uacute
+	{221, 251}: -100, // Yacute ucircumflex
+	{221, 252}: -100, // Yacute udieresis
+	{224, 103}: -10,  // agrave g
+	{224, 118}: -15,  // agrave v
+	{224, 119}: -15,  // agrave w
+	{224, 121}: -20,  // agrave y
+	{224, 253}: -20,  // agrave yacute
+	{224, 255}: -20,  // agrave ydieresis
+	{225, 103}: -10,  // aacute g
+	{225, 118}: -15,  // aacute v
+	{225, 119}: -15,  // aacute w
+	{225, 121}: -20,  // aacute y
+	{225, 253}: -20,  // aacute yacute
+	{225, 255}: -20,  // aacute ydieresis
+	{226, 103}: -10,  // acircumflex g
+	{226, 118}: -15,  // acircumflex v
+	{226, 119}: -15,  // acircumflex w
+	{226, 121}: -20,  // acircumflex y
+	{226, 253}: -20,  // acircumflex yacute
+	{226, 255}: -20,  // acircumflex ydieresis
+	{227, 103}: -10,  // atilde g
+	{227, 118}: -15,  // atilde v
+	{227, 119}: -15,  // atilde w
+	{227, 121}: -20,  // atilde y
+	{227, 253}: -20,  // atilde yacute
+	{227, 255}: -20,  // atilde ydieresis
+	{228, 103}: -10,  // adieresis g
+	{228, 118}: -15,  // adieresis v
+	{228, 119}: -15,  // adieresis w
+	{228, 121}: -20,  // adieresis y
+	{228, 253}: -20,  // adieresis yacute
+	{228, 255}: -20,  // adieresis ydieresis
+	{229, 103}: -10,  // aring g
+	{229, 118}: -15,  // aring v
+	{229, 119}: -15,  // aring w
+	{229, 121}: -20,  // aring y
+	{229, 253}: -20,  // aring yacute
+	{229, 255}: -20,  // aring ydieresis
+	{231, 104}: -10,  // ccedilla h
+	{231, 107}: -20,  // ccedilla k
+	{231, 108}: -20,  // ccedilla l
+	{231, 121}: -10,  // ccedilla y
+	{231, 253}: -10,  // ccedilla yacute
+	{231, 255}: -10,  // ccedilla ydieresis
+	{232, 44}:  10,   // egrave comma
+	{232, 46}:  20,   // egrave period
+	{232, 118}: -15,  // egrave v
+	{232, 119}: -15,  // egrave w
+	{232, 120}: -15,  // egrave x
+	{232, 121}: -15,  // egrave y
+	{232, 253}: -15,  // egrave yacute
+	{232, 255}: -15,  // egrave ydieresis
+	{233, 44}:  10,   // eacute comma
+	{233, 46}:  20,   // eacute period
+	{233, 118}: -15,  // eacute v
+	{233, 119}: -15,  // eacute w
+	{233, 120}: -15,  // eacute x
+	{233, 121}: -15,  // eacute y
+	{233, 253}: -15,  // eacute yacute
+	{233, 255}: -15,  // eacute ydieresis
+	{234, 44}:  10,   // ecircumflex comma
+	{234, 46}:  20,   // ecircumflex period
+	{234, 118}: -15,  // ecircumflex v
+	{234, 119}: -15,  // ecircumflex w
+	{234, 120}: -15,  // ecircumflex x
+	{234, 121}: -15,  // ecircumflex y
+	{234, 253}: -15,  // ecircumflex yacute
+	{234, 255}: -15,  // ecircumflex ydieresis
+	{235, 44}:  10,   // edieresis comma
+	{235, 46}:  20,   // edieresis period
+	{235, 118}: -15,  // edieresis v
+	{235, 119}: -15,  // edieresis w
+	{235, 120}: -15,  // edieresis x
+	{235, 121}: -15,  // edieresis y
+	{235, 253}: -15,  // edieresis yacute
+	{235, 255}: -15,  // edieresis ydieresis
+	{241, 117}: -10,  // ntilde u
+	{241, 118}: -40,  // ntilde v
+	{241, 121}: -20,  // ntilde y
+	{241, 249}: -10,  // ntilde ugrave
+	{241, 250}: -10,  // ntilde uacute
+	{241, 251}: -10,  // ntilde ucircumflex
+	{241, 252}: -10,  // ntilde udieresis
+	{241, 253}: -20,  // ntilde yacute
+	{241, 255}: -20,  // ntilde ydieresis
+	{242, 118}: -20,  // ograve v
+	{242, 119}: -15,  // ograve w
+	{242, 120}: -30,  // ograve x
+	{242, 121}: -20,  // ograve y
+	{242, 253}: -20,  // ograve yacute
+	{242, 255}: -20,  // ograve ydieresis
+	{243, 118}: -20,  // oacute v
+	{243, 119}: -15,  // oacute w
+	{243, 120}: -30,  // oacute x
+	{243, 121}: -20,  // oacute y
+	{243, 253}: -20,  // oacute yacute
+	{243, 255}: -20,  // oacute ydieresis
+	{244, 118}: -20,  // ocircumflex v
+	{244, 119}: -15,  // ocircumflex w
+	{244, 120}: -30,  // ocircumflex x
+	{244, 121}: -20,  // ocircumflex y
+	{244, 253}: -20,  // ocircumflex yacute
+	{244, 255}: -20,  // ocircumflex ydieresis
+	{245, 118}: -20,  // otilde v
+	{245, 119}: -15,  // otilde w
+	{245, 120}: -30,  // otilde x
+	{245, 121}: -20,  // otilde y
+	{245, 253}: -20,  // otilde yacute
+	{245, 255}: -20,  // otilde ydieresis
+	{246, 118}: -20,  // odieresis v
+	{246, 119}: -15,  // odieresis w
+	{246, 120}: -30,  // odieresis x
+	{246, 121}: -20,  // odieresis y
+	{246, 253}: -20,  // odieresis yacute
+	{246, 255}: -20,  // odieresis ydieresis
+	{248, 118}: -20,  // oslash v
+	{248, 119}: -15,  // oslash w
+	{248, 120}: -30,  // oslash x
+	{248, 121}: -20,  // oslash y
+	{248, 253}: -20,  // oslash yacute
+	{248, 255}: -20,  // oslash ydieresis
+	{253, 44}:  -80,  // yacute comma
+	{253, 46}:  -80,  // yacute period
+	{253, 97}:  -30,  // yacute a
+	{253, 101}: -10,  // yacute e
+	{253, 111}: -25,  // yacute o
+	{253, 224}: -30,  // yacute agrave
+	{253, 225}: -30,  // yacute aacute
+	{253, 226}: -30,  // yacute acircumflex
+	{253, 227}: -30,  // yacute atilde
+	{253, 228}: -30,  // yacute adieresis
+	{253, 229}: -30,  // yacute aring
+	{253, 232}: -10,  // yacute egrave
+	{253, 233}: -10,  // yacute eacute
+	{253, 234}: -10,  // yacute ecircumflex
+	{253, 235}: -10,  // yacute edieresis
+	{253, 242}: -25,  // yacute ograve
+	{253, 243}: -25,  // yacute oacute
+	{253, 244}: -25,  // yacute ocircumflex
+	{253, 245}: -25,  // yacute otilde
+	{253, 246}: -25,  // yacute odieresis
+	{253, 248}: -25,  // yacute oslash
+	{255, 44}:  -80,  // ydieresis comma
+	{255, 46}:  -80,  // ydieresis period
+	{255, 97}:  -30,  // ydieresis a
+	{255, 101}: -10,  // ydieresis e
+	{255, 111}: -25,  // ydieresis o
+	{255, 224}: -30,  // ydieresis agrave
+	{255, 225}: -30,  // ydieresis aacute
+	{255, 226}: -30,  // ydieresis acircumflex
+	{255, 227}: -30,  // ydieresis atilde
+	{255, 228}: -30,  // ydieresis adieresis
+	{255, 229}: -30,  // ydieresis aring
+	{255, 232}: -10,  // ydieresis egrave
+	{255, 233}: -10,  // ydieresis eacute
+	{255, 234}: -10,  // ydieresis ecircumflex
+	{255, 235}: -10,  // ydieresis edieresis
+	{255, 242}: -25,  // ydieresis ograve
+	{255, 243}: -25,  // ydieresis oacute
+	{255, 244}: -25,  // ydieresis ocircumflex
+	{255, 245}: -25,  // ydieresis otilde
+	{255, 246}: -25,  // ydieresis odieresis
+	{255, 248}: -25,  // ydieresis oslash
 }
 
 // Helvetica-BoldOblique
+var helveticaBoldObliqueMetrics = FontMetrics{
+	Ascender:    718,
+	Descender:   -207,
+	CapHeight:   718,
+	XHeight:     532,
+	ItalicAngle: -12,
+	FontBBox:    Rectangle{Point{-174, -228}, Point{1114, 962}},
+}
+
 var helveticaBoldObliqueWidths = []uint16{
-	32:  278, // space
-	33:  333, // exclam
-	34:  474, // quotedbl
-	35:  556, // numbersign
-	36:  556, // dollar
-	37:  889, // percent
-	38:  722, // ampersand
-	39:  278, // quoteright
-	40:  333, // parenleft
-	41:  333, // parenright
-	42:  389, // asterisk
-	43:  584, // plus
-	44:  278, // comma
-	45:  333, // hyphen
-	46:  278, // period
-	47:  278, // slash
-	48:  556, // zero
-	49:  556, // one
-	50:  556, // two
-	51:  556, // three
-	52:  556, // four
-	53:  556, // five
-	54:  556, // six
-	55:  556, // seven
-	56:  556, // eight
-	57:  556, // nine
-	58:  333, // colon
-	59:  333, // semicolon
-	60:  584, // less
-	61:  584, // equal
-	62:  584, // greater
-	63:  611, // question
-	64:  975, // at
-	65:  722, // A
-	66:  722, // B
-	67:  722, // C
-	68:  722, // D
-	69:  667, // E
-	70:  611, // F
-	71:  778, // G
-	72:  722, // H
-	73:  278, // I
-	74:  556, // J
-	75:  722, // K
-	76:  611, // L
-	77:  833, // M
-	78:  722, // N
-	79:  778, // O
-	80:  667, // P
-	81:  778, // Q
-	82:  722, // R
-	83:  667, // S
-	84:  611, // T
-	85:  722, // U
-	86:  667, // V
-	87:  944, // W
-	88:  667, // X
-	89:  667, // Y
-	90:  611, // Z
-	91:  333, // bracketleft
-	92:  278, // backslash
-	93:  333, // bracketright
-	94:  584, // asciicircum
-	95:  556, // underscore
-	96:  278, // quoteleft
-	97:  556, // a
-	98:  611, // b
-	99:  556, // c
-	100: 611, // d
-	101: 556, // e
-	102: 333, // f
-	103: 611, // g
-	104: 611, // h
-	105: 278, // i
-	106: 278, // j
-	107: 556, // k
-	108: 278, // l
-	109: 889, // m
-	110: 611, // n
-	111: 611, // o
-	112: 611, // p
-	113: 611, // q
-	114: 389, // r
-	115: 556, // s
-	116: 333, // t
-	117: 611, // u
-	118: 556, // v
-	119: 778, // w
-	120: 556, // x
-	121: 556, // y
-	122: 500, // z
-	123: 389, // braceleft
-	124: 280, // bar
-	125: 389, // braceright
-	126: 584, // asciitilde
+	32:  278,  // space
+	33:  333,  // exclam
+	34:  474,  // quotedbl
+	35:  556,  // numbersign
+	36:  556,  // dollar
+	37:  889,  // percent
+	38:  722,  // ampersand
+	39:  238,  // quotesingle
+	40:  333,  // parenleft
+	41:  333,  // parenright
+	42:  389,  // asterisk
+	43:  584,  // plus
+	44:  278,  // comma
+	45:  333,  // hyphen
+	46:  278,  // period
+	47:  278,  // slash
+	48:  556,  // zero
+	49:  556,  // one
+	50:  556,  // two
+	51:  556,  // three
+	52:  556,  // four
+	53:  556,  // five
+	54:  556,  // six
+	55:  556,  // seven
+	56:  556,  // eight
+	57:  556,  // nine
+	58:  333,  // colon
+	59:  333,  // semicolon
+	60:  584,  // less
+	61:  584,  // equal
+	62:  584,  // greater
+	63:  611,  // question
+	64:  975,  // at
+	65:  722,  // A
+	66:  722,  // B
+	67:  722,  // C
+	68:  722,  // D
+	69:  667,  // E
+	70:  611,  // F
+	71:  778,  // G
+	72:  722,  // H
+	73:  278,  // I
+	74:  556,  // J
+	75:  722,  // K
+	76:  611,  // L
+	77:  833,  // M
+	78:  722,  // N
+	79:  778,  // O
+	80:  667,  // P
+	81:  778,  // Q
+	82:  722,  // R
+	83:  667,  // S
+	84:  611,  // T
+	85:  722,  // U
+	86:  667,  // V
+	87:  944,  // W
+	88:  667,  // X
+	89:  667,  // Y
+	90:  611,  // Z
+	91:  333,  // bracketleft
+	92:  278,  // backslash
+	93:  333,  // bracketright
+	94:  584,  // asciicircum
+	95:  556,  // underscore
+	96:  333,  // grave
+	97:  556,  // a
+	98:  611,  // b
+	99:  556,  // c
+	100: 611,  // d
+	101: 556,  // e
+	102: 333,  // f
+	103: 611,  // g
+	104: 611,  // h
+	105: 278,  // i
+	106: 278,  // j
+	107: 556,  // k
+	108: 278,  // l
+	109: 889,  // m
+	110: 611,  // n
+	111: 611,  // o
+	112: 611,  // p
+	113: 611,  // q
+	114: 389,  // r
+	115: 556,  // s
+	116: 333,  // t
+	117: 611,  // u
+	118: 556,  // v
+	119: 778,  // w
+	120: 556,  // x
+	121: 556,  // y
+	122: 500,  // z
+	123: 389,  // braceleft
+	124: 280,  // bar
+	125: 389,  // braceright
+	126: 584,  // asciitilde
+	128: 556,  // Euro
+	130: 278,  // quotesinglbase
+	131: 556,  // florin
+	132: 500,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 556,  // dagger
+	135: 556,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 667,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 1000, // OE
+	142: 611,  // Zcaron
+	145: 278,  // quoteleft
+	146: 278,  // quoteright
+	147: 500,  // quotedblleft
+	148: 500,  // quotedblright
+	149: 350,  // bullet
+	150: 556,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 1000, // trademark
+	154: 556,  // scaron
+	155: 333,  // guilsinglright
+	156: 944,  // oe
+	158: 500,  // zcaron
+	159: 667,  // Ydieresis
+	160: 278,  // space
+	161: 333,  // exclamdown
+	162: 556,  // cent
+	163: 556,  // sterling
+	164: 556,  // currency
+	165: 556,  // yen
+	166: 280,  // brokenbar
+	167: 556,  // section
+	168: 333,  // dieresis
+	169: 737,  // copyright
+	170: 370,  // ordfeminine
+	171: 556,  // guillemotleft
+	172: 584,  // logicalnot
+	173: 333,  // hyphen
+	174: 737,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 584,  // plusminus
+	178: 333,  // twosuperior
+	179: 333,  // threesuperior
+	180: 333,  // acute
+	181: 611,  // mu
+	182: 556,  // paragraph
+	183: 278,  // periodcentered
+	184: 333,  // cedilla
+	185: 333,  // onesuperior
+	186: 365,  // ordmasculine
+	187: 556,  // guillemotright
+	188: 834,  // onequarter
+	189: 834,  // onehalf
+	190: 834,  // threequarters
+	191: 611,  // questiondown
+	192: 722,  // Agrave
+	193: 722,  // Aacute
+	194: 722,  // Acircumflex
+	195: 722,  // Atilde
+	196: 722,  // Adieresis
+	197: 722,  // Aring
+	198: 1000, // AE
+	199: 722,  // Ccedilla
+	200: 667,  // Egrave
+	201: 667,  // Eacute
+	202: 667,  // Ecircumflex
+	203: 667,  // Edieresis
+	204: 278,  // Igrave
+	205: 278,  // Iacute
+	206: 278,  // Icircumflex
+	207: 278,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 778,  // Ograve
+	211: 778,  // Oacute
+	212: 778,  // Ocircumflex
+	213: 778,  // Otilde
+	214: 778,  // Odieresis
+	215: 584,  // multiply
+	216: 778,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 667,  // Yacute
+	222: 667,  // Thorn
+	223: 611,  // germandbls
+	224: 556,  // agrave
+	225: 556,  // aacute
+	226: 556,  // acircumflex
+	227: 556,  // atilde
+	228: 556,  // adieresis
+	229: 556,  // aring
+	230: 889,  // ae
+	231: 556,  // ccedilla
+	232: 556,  // egrave
+	233: 556,  // eacute
+	234: 556,  // ecircumflex
+	235: 556,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 611,  // eth
+	241: 611,  // ntilde
+	242: 611,  // ograve
+	243: 611,  // oacute
+	244: 611,  // ocircumflex
+	245: 611,  // otilde
+	246: 611,  // odieresis
+	247: 584,  // divide
+	248: 611,  // oslash
+	249: 611,  // ugrave
+	250: 611,  // uacute
+	251: 611,  // ucircumflex
+	252: 611,  // udieresis
+	253: 556,  // yacute
+	254: 611,  // thorn
+	255: 556,  // ydieresis
+}
+
+var helveticaBoldObliqueKerning = map[kernPair]int16{
+	{32, 84}:   -100, // space T
+	{32, 86}:   -80,  // space V
+	{32, 87}:   -80,  // space W
+	{32, 89}:   -120, // space Y
+	{32, 145}:  -60,  // space quoteleft
+	{32, 147}:  -80,  // space quotedblleft
+	{32, 159}:  -120, // space Ydieresis
+	{32, 221}:  -120, // space Yacute
+	{44, 32}:   -40,  // comma space
+	{44, 146}:  -120, // comma quoteright
+	{44, 148}:  -120, // comma quotedblright
+	{44, 160}:  -40,  // comma space
+	{46, 32}:   -40,  // period space
+	{46, 146}:  -120, // period quoteright
+	{46, 148}:  -120, // period quotedblright
+	{46, 160}:  -40,  // period space
+	{58, 32}:   -40,  // colon space
+	{58, 160}:  -40,  // colon space
+	{59, 32}:   -40,  // semicolon space
+	{59, 160}:  -40,  // semicolon space
+	{65, 67}:   -40,  // A C
+	{65, 71}:   -50,  // A G
+	{65, 79}:   -40,  // A O
+	{65, 81}:   -40,  // A Q
+	{65, 84}:   -90,  // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -80,  // A V
+	{65, 87}:   -60,  // A W
+	{65, 89}:   -110, // A Y
+	{65, 117}:  -30,  // A u
+	{65, 118}:  -40,  // A v
+	{65, 119}:  -30,  // A w
+	{65, 121}:  -30,  // A y
+	{65, 159}:  -110, // A Ydieresis
+	{65, 199}:  -40,  // A Ccedilla
+	{65, 210}:  -40,  // A Ograve
+	{65, 211}:  -40,  // A Oacute
+	{65, 212}:  -40,  // A Ocircumflex
+	{65, 213}:  -40,  // A Otilde
+	{65, 214}:  -40,  // A Odieresis
+	{65, 216}:  -40,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -110, // A Yacute
+	{65, 249}:  -30,  // A ugrave
+	{65, 250}:  -30,  // A uacute
+	{65, 251}:  -30,  // A ucircumflex
+	{65, 252}:  -30,  // A udieresis
+	{65, 253}:  -30,  // A yacute
+	{65, 255}:  -30,  // A ydieresis
+	{66, 65}:   -30,  // B A
+	{66, 85}:   -10,  // B U
+	{66, 192}:  -30,  // B Agrave
+	{66, 193}:  -30,  // B Aacute
+	{66, 194}:  -30,  // B Acircumflex
+	{66, 195}:  -30,  // B Atilde
+	{66, 196}:  -30,  // B Adieresis
+	{66, 197}:  -30,  // B Aring
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{68, 44}:   -30,  // D comma
+	{68, 46}:   -30,  // D period
+	{68, 65}:   -40,  // D A
+	{68, 86}:   -40,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -70,  // D Y
+	{68, 159}:  -70,  // D Ydieresis
+	{68, 192}:  -40,  // D Agrave
+	{68, 193}:  -40,  // D Aacute
+	{68, 194}:  -40,  // D Acircumflex
+	{68, 195}:  -40,  // D Atilde
+	{68, 196}:  -40,  // D Adieresis
+	{68, 197}:  -40,  // D Aring
+	{68, 221}:  -70,  // D Yacute
+	{70, 44}:   -100, // F comma
+	{70, 46}:   -100, // F period
+	{70, 65}:   -80,  // F A
+	{70, 97}:   -20,  // F a
+	{70, 192}:  -80,  // F Agrave
+	{70, 193}:  -80,  // F Aacute
+	{70, 194}:  -80,  // F Acircumflex
+	{70, 195}:  -80,  // F Atilde
+	{70, 196}:  -80,  // F Adieresis
+	{70, 197}:  -80,  // F Aring
+	{70, 224}:  -20,  // F agrave
+	{70, 225}:  -20,  // F aacute
+	{70, 226}:  -20,  // F acircumflex
+	{70, 227}:  -20,  // F atilde
+	{70, 228}:  -20,  // F adieresis
+	{70, 229}:  -20,  // F aring
+	{74, 44}:   -20,  // J comma
+	{74, 46}:   -20,  // J period
+	{74, 65}:   -20,  // J A
+	{74, 117}:  -20,  // J u
+	{74, 192}:  -20,  // J Agrave
+	{74, 193}:  -20,  // J Aacute
+	{74, 194}:  -20,  // J Acircumflex
+	{74, 195}:  -20,  // J Atilde
+	{74, 196}:  -20,  // J Adieresis
+	{74, 197}:  -20,  // J Aring
+	{74, 249}:  -20,  // J ugrave
+	{74, 250}:  -20,  // J uacute
+	{74, 251}:  -20,  // J ucircumflex
+	{74, 252}:  -20,  // J udieresis
+	{75, 79}:   -30,  // K O
+	{75, 101}:  -15,  // K e
+	{75, 111}:  -35,  // K o
+	{75, 117}:  -30,  // K u
+	{75, 121}:  -40,  // K y
+	{75, 210}:  -30,  // K Ograve
+	{75, 211}:  -30,  // K Oacute
+	{75, 212}:  -30,  // K Ocircumflex
+	{75, 213}:  -30,  // K Otilde
+	{75, 214}:  -30,  // K Odieresis
+	{75, 216}:  -30,  // K Oslash
+	{75, 232}:  -15,  // K egrave
+	{75, 233}:  -15,  // K eacute
+	{75, 234}:  -15,  // K ecircumflex
+	{75, 235}:  -15,  // K edieresis
+	{75, 242}:  -35,  // K ograve
+	{75, 243}:  -35,  // K oacute
+	{75, 244}:  -35,  // K ocircumflex
+	{75, 245}:  -35,  // K otilde
+	{75, 246}:  -35,  // K odieresis
+	{75, 248}:  -35,  // K oslash
+	{75, 249}:  -30,  // K ugrave
+	{75, 250}:  -30,  // K uacute
+	{75, 251}:  -30,  // K ucircumflex
+	{75, 252}:  -30,  // K udieresis
+	{75, 253}:  -40,  // K yacute
+	{75, 255}:  -40,  // K ydieresis
+	{76, 84}:   -90,  // L T
+	{76, 86}:   -110, // L V
+	{76, 87}:   -80,  // L W
+	{76, 89}:   -120, // L Y
+	{76, 121}:  -30,  // L y
+	{76, 146}:  -140, // L quoteright
+	{76, 148}:  -140, // L quotedblright
+	{76, 159}:  -120, // L Ydieresis
+	{76, 221}:  -120, // L Yacute
+	{76, 253}:  -30,  // L yacute
+	{76, 255}:  -30,  // L ydieresis
+	{79, 44}:   -40,  // O comma
+	{79, 46}:   -40,  // O period
+	{79, 65}:   -50,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -50,  // O W
+	{79, 88}:   -50,  // O X
+	{79, 89}:   -70,  // O Y
+	{79, 159}:  -70,  // O Ydieresis
+	{79, 192}:  -50,  // O Agrave
+	{79, 193}:  -50,  // O Aacute
+	{79, 194}:  -50,  // O Acircumflex
+	{79, 195}:  -50,  // O Atilde
+	{79, 196}:  -50,  // O Adieresis
+	{79, 197}:  -50,  // O Aring
+	{79, 221}:  -70,  // O Yacute
+	{80, 44}:   -120, // P comma
+	{80, 46}:   -120, // P period
+	{80, 65}:   -100, // P A
+	{80, 97}:   -30,  // P a
+	{80, 101}:  -30,  // P e
+	{80, 111}:  -40,  // P o
+	{80, 192}:  -100, // P Agrave
+	{80, 193}:  -100, // P Aacute
+	{80, 194}:  -100, // P Acircumflex
+	{80, 195}:  -100, // P Atilde
+	{80, 196}:  -100, // P Adieresis
+	{80, 197}:  -100, // P Aring
+	{80, 224}:  -30,  // P agrave
+	{80, 225}:  -30,  // P aacute
+	{80, 226}:  -30,  // P acircumflex
+	{80, 227}:  -30,  // P atilde
+	{80, 228}:  -30,  // P adieresis
+	{80, 229}:  -30,  // P aring
+	{80, 232}:  -30,  // P egrave
+	{80, 233}:  -30,  // P eacute
+	{80, 234}:  -30,  // P ecircumflex
+	{80, 235}:  -30,  // P edieresis
+	{80, 242}:  -40,  // P ograve
+	{80, 243}:  -40,  // P oacute
+	{80, 244}:  -40,  // P ocircumflex
+	{80, 245}:  -40,  // P otilde
+	{80, 246}:  -40,  // P odieresis
+	{80, 248}:  -40,  // P oslash
+	{81, 44}:   20,   // Q comma
+	{81, 46}:   20,   // Q period
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -20,  // R O
+	{82, 84}:   -20,  // R T
+	{82, 85}:   -20,  // R U
+	{82, 86}:   -50,  // R V
+	{82, 87}:   -40,  // R W
+	{82, 89}:   -50,  // R Y
+	{82, 159}:  -50,  // R Ydieresis
+	{82, 210}:  -20,  // R Ograve
+	{82, 211}:  -20,  // R Oacute
+	{82, 212}:  -20,  // R Ocircumflex
+	{82, 213}:  -20,  // R Otilde
+	{82, 214}:  -20,  // R Odieresis
+	{82, 216}:  -20,  // R Oslash
+	{82, 217}:  -20,  // R Ugrave
+	{82, 218}:  -20,  // R Uacute
+	{82, 219}:  -20,  // R Ucircumflex
+	{82, 220}:  -20,  // R Udieresis
+	{82, 221}:  -50,  // R Yacute
+	{84, 44}:   -80,  // T comma
+	{84, 45}:   -120, // T hyphen
+	{84, 46}:   -80,  // T period
+	{84, 58}:   -40,  // T colon
+	{84, 59}:   -40,  // T semicolon
+	{84, 65}:   -90,  // T A
+	{84, 79}:   -40,  // T O
+	{84, 97}:   -80,  // T a
+	{84, 101}:  -60,  // T e
+	{84, 111}:  -80,  // T o
+	{84, 114}:  -80,  // T r
+	{84, 117}:  -90,  // T u
+	{84, 119}:  -60,  // T w
+	{84, 121}:  -60,  // T y
+	{84, 173}:  -120, // T hyphen
+	{84, 192}:  -90,  // T Agrave
+	{84, 193}:  -90,  // T Aacute
+	{84, 194}:  -90,  // T Acircumflex
+	{84, 195}:  -90,  // T Atilde
+	{84, 196}:  -90,  // T Adieresis
+	{84, 197}:  -90,  // T Aring
+	{84, 210}:  -40,  // T Ograve
+	{84, 211}:  -40,  // T Oacute
+	{84, 212}:  -40,  // T Ocircumflex
+	{84, 213}:  -40,  // T Otilde
+	{84, 214}:  -40,  // T Odieresis
+	{84, 216}:  -40,  // T Oslash
+	{84, 224}:  -80,  // T agrave
+	{84, 225}:  -80,  // T aacute
+	{84, 226}:  -80,  // T acircumflex
+	{84, 227}:  -80,  // T atilde
+	{84, 228}:  -80,  // T adieresis
+	{84, 229}:  -80,  // T aring
+	{84, 232}:  -60,  // T egrave
+	{84, 233}:  -60,  // T eacute
+	{84, 234}:  -60,  // T ecircumflex
+	{84, 235}:  -60,  // T edieresis
+	{84, 242}:  -80,  // T ograve
+	{84, 243}:  -80,  // T oacute
+	{84, 244}:  -80,  // T ocircumflex
+	{84, 245}:  -80,  // T otilde
+	{84, 246}:  -80,  // T odieresis
+	{84, 248}:  -80,  // T oslash
+	{84, 249}:  -90,  // T ugrave
+	{84, 250}:  -90,  // T uacute
+	{84, 251}:  -90,  // T ucircumflex
+	{84, 252}:  -90,  // T udieresis
+	{84, 253}:  -60,  // T yacute
+	{84, 255}:  -60,  // T ydieresis
+	{85, 44}:   -30,  // U comma
+	{85, 46}:   -30,  // U period
+	{85, 65}:   -50,  // U A
+	{85, 192}:  -50,  // U Agrave
+	{85, 193}:  -50,  // U Aacute
+	{85, 194}:  -50,  // U Acircumflex
+	{85, 195}:  -50,  // U Atilde
+	{85, 196}:  -50,  // U Adieresis
+	{85, 197}:  -50,  // U Aring
+	{86, 44}:   -120, // V comma
+	{86, 45}:   -80,  // V hyphen
+	{86, 46}:   -120, // V period
+	{86, 58}:   -40,  // V colon
+	{86, 59}:   -40,  // V semicolon
+	{86, 65}:   -80,  // V A
+	{86, 71}:   -50,  // V G
+	{86, 79}:   -50,  // V O
+	{86, 97}:   -60,  // V a
+	{86, 101}:  -50,  // V e
+	{86, 111}:  -90,  // V o
+	{86, 117}:  -60,  // V u
+	{86, 173}:  -80,  // V hyphen
+	{86, 192}:  -80,  // V Agrave
+	{86, 193}:  -80,  // V Aacute
+	{86, 194}:  -80,  // V Acircumflex
+	{86, 195}:  -80,  // V Atilde
+	{86, 196}:  -80,  // V Adieresis
+	{86, 197}:  -80,  // V Aring
+	{86, 210}:  -50,  // V Ograve
+	{86, 211}:  -50,  // V Oacute
+	{86, 212}:  -50,  // V Ocircumflex
+	{86, 213}:  -50,  // V Otilde
+	{86, 214}:  -50,  // V Odieresis
+	{86, 216}:  -50,  // V Oslash
+	{86, 224}:  -60,  // V agrave
+	{86, 225}:  -60,  // V aacute
+	{86, 226}:  -60,  // V acircumflex
+	{86, 227}:  -60,  // V atilde
+	{86, 228}:  -60,  // V adieresis
+	{86, 229}:  -60,  // V aring
+	{86, 232}:  -50,  // V egrave
+	{86, 233}:  -50,  // V eacute
+	{86, 234}:  -50,  // V ecircumflex
+	{86, 235}:  -50,  // V edieresis
+	{86, 242}:  -90,  // V ograve
+	{86, 243}:  -90,  // V oacute
+	{86, 244}:  -90,  // V ocircumflex
+	{86, 245}:  -90,  // V otilde
+	{86, 246}:  -90,  // V odieresis
+	{86, 248}:  -90,  // V oslash
+	{86, 249}:  -60,  // V ugrave
+	{86, 250}:  -60,  // V uacute
+	{86, 251}:  -60,  // V ucircumflex
+	{86, 252}:  -60,  // V udieresis
+	{87, 44}:   -80,  // W comma
+	{87, 45}:   -40,  // W hyphen
+	{87, 46}:   -80,  // W period
+	{87, 58}:   -10,  // W colon
+	{87, 59}:   -10,  // W semicolon
+	{87, 65}:   -60,  // W A
+	{87, 79}:   -20,  // W O
+	{87, 97}:   -40,  // W a
+	{87, 101}:  -35,  // W e
+	{87, 111}:  -60,  // W o
+	{87, 117}:  -45,  // W u
+	{87, 121}:  -20,  // W y
+	{87, 173}:  -40,  // W hyphen
+	{87, 192}:  -60,  // W Agrave
+	{87, 193}:  -60,  // W Aacute
+	{87, 194}:  -60,  // W Acircumflex
+	{87, 195}:  -60,  // W Atilde
+	{87, 196}:  -60,  // W Adieresis
+	{87, 197}:  -60,  // W Aring
+	{87, 210}:  -20,  // W Ograve
+	{87, 211}:  -20,  // W Oacute
+	{87, 212}:  -20,  // W Ocircumflex
+	{87, 213}:  -20,  // W Otilde
+	{87, 214}:  -20,  // W Odieresis
+	{87, 216}:  -20,  // W Oslash
+	{87, 224}:  -40,  // W agrave
+	{87, 225}:  -40,  // W aacute
+	{87, 226}:  -40,  // W acircumflex
+	{87, 227}:  -40,  // W atilde
+	{87, 228}:  -40,  // W adieresis
+	{87, 229}:  -40,  // W aring
+	{87, 232}:  -35,  // W egrave
+	{87, 233}:  -35,  // W eacute
+	{87, 234}:  -35,  // W ecircumflex
+	{87, 235}:  -35,  // W edieresis
+	{87, 242}:  -60,  // W ograve
+	{87, 243}:  -60,  // W oacute
+	{87, 244}:  -60,  // W ocircumflex
+	{87, 245}:  -60,  // W otilde
+	{87, 246}:  -60,  // W odieresis
+	{87, 248}:  -60,  // W oslash
+	{87, 249}:  -45,  // W ugrave
+	{87, 250}:  -45,  // W uacute
+	{87, 251}:  -45,  // W ucircumflex
+	{87, 252}:  -45,  // W udieresis
+	{87, 253}:  -20,  // W yacute
+	{87, 255}:  -20,  // W ydieresis
+	{89, 44}:   -100, // Y comma
+	{89, 46}:   -100, // Y period
+	{89, 58}:   -50,  // Y colon
+	{89, 59}:   -50,  // Y semicolon
+	{89, 65}:   -110, // Y A
+	{89, 79}:   -70,  // Y O
+	{89, 97}:   -90,  // Y a
+	{89, 101}:  -80,  // Y e
+	{89, 111}:  -100, // Y o
+	{89, 117}:  -100, // Y u
+	{89, 192}:  -110, // Y Agrave
+	{89, 193}:  -110, // Y Aacute
+	{89, 194}:  -110, // Y Acircumflex
+	{89, 195}:  -110, // Y Atilde
+	{89, 196}:  -110, // Y Adieresis
+	{89, 197}:  -110, // Y Aring
+	{89, 210}:  -70,  // Y Ograve
+	{89, 211}:  -70,  // Y Oacute
+	{89, 212}:  -70,  // Y Ocircumflex
+	{89, 213}:  -70,  // Y Otilde
+	{89, 214}:  -70,  // Y Odieresis
+	{89, 216}:  -70,  // Y Oslash
+	{89, 224}:  -90,  // Y agrave
+	{89, 225}:  -90,  // Y aacute
+	{89, 226}:  -90,  // Y acircumflex
+	{89, 227}:  -90,  // Y atilde
+	{89, 228}:  -90,  // Y adieresis
+	{89, 229}:  -90,  // Y aring
+	{89, 232}:  -80,  // Y egrave
+	{89, 233}:  -80,  // Y eacute
+	{89, 234}:  -80,  // Y ecircumflex
+	{89, 235}:  -80,  // Y edieresis
+	{89, 242}:  -100, // Y ograve
+	{89, 243}:  -100, // Y oacute
+	{89, 244}:  -100, // Y ocircumflex
+	{89, 245}:  -100, // Y otilde
+	{89, 246}:  -100, // Y odieresis
+	{89, 248}:  -100, // Y oslash
+	{89, 249}:  -100, // Y ugrave
+	{89, 250}:  -100, // Y uacute
+	{89, 251}:  -100, // Y ucircumflex
+	{89, 252}:  -100, // Y udieresis
+	{97, 103}:  -10,  // a g
+	{97, 118}:  -15,  // a v
+	{97, 119}:  -15,  // a w
+	{97, 121}:  -20,  // a y
+	{97, 253}:  -20,  // a yacute
+	{97, 255}:  -20,  // a ydieresis
+	{98, 108}:  -10,  // b l
+	{98, 117}:  -20,  // b u
+	{98, 118}:  -20,  // b v
+	{98, 121}:  -20,  // b y
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{98, 253}:  -20,  // b yacute
+	{98, 255}:  -20,  // b ydieresis
+	{99, 104}:  -10,  // c h
+	{99, 107}:  -20,  // c k
+	{99, 108}:  -20,  // c l
+	{99, 121}:  -10,  // c y
+	{99, 253}:  -10,  // c yacute
+	{99, 255}:  -10,  // c ydieresis
+	{100, 100}: -10,  // d d
+	{100, 118}: -15,  // d v
+	{100, 119}: -15,  // d w
+	{100, 121}: -15,  // d y
+	{100, 253}: -15,  // d yacute
+	{100, 255}: -15,  // d ydieresis
+	{101, 44}:  10,   // e comma
+	{101, 46}:  20,   // e period
+	{101, 118}: -15,  // e v
+	{101, 119}: -15,  // e w
+	{101, 120}: -15,  // e x
+	{101, 121}: -15,  // e y
+	{101, 253}: -15,  // e yacute
+	{101, 255}: -15,  // e ydieresis
+	{102, 44}:  -10,  // f comma
+	{102, 46}:  -10,  // f period
+	{102, 101}: -10,  // f e
+	{102, 111}: -20,  // f o
+	{102, 146}: 30,   // f quoteright
+	{102, 148}: 30,   // f quotedblright
+	{102, 232}: -10,  // f egrave
+	{102, 233}: -10,  // f eacute
+	{102, 234}: -10,  // f ecircumflex
+	{102, 235}: -10,  // f edieresis
+	{102, 242}: -20,  // f ograve
+	{102, 243}: -20,  // f oacute
+	{102, 244}: -20,  // f ocircumflex
+	{102, 245}: -20,  // f otilde
+	{102, 246}: -20,  // f odieresis
+	{102, 248}: -20,  // f oslash
+	{103, 101}: 10,   // g e
+	{103, 103}: -10,  // g g
+	{103, 232}: 10,   // g egrave
+	{103, 233}: 10,   // g eacute
+	{103, 234}: 10,   // g ecircumflex
+	{103, 235}: 10,   // g edieresis
+	{104, 121}: -20,  // h y
+	{104, 253}: -20,  // h yacute
+	{104, 255}: -20,  // h ydieresis
+	{107, 111}: -15,  // k o
+	{107, 242}: -15,  // k ograve
+	{107, 243}: -15,  // k oacute
+	{107, 244}: -15,  // k ocircumflex
+	{107, 245}: -15,  // k otilde
+	{107, 246}: -15,  // k odieresis
+	{107, 248}: -15,  // k oslash
+	{108, 119}: -15,  // l w
+	{108, 121}: -15,  // l y
+	{108, 253}: -15,  // l yacute
+	{108, 255}: -15,  // l ydieresis
+	{109, 117}: -20,  // m u
+	{109, 121}: -30,  // m y
+	{109, 249}: -20,  // m ugrave
+	{109, 250}: -20,  // m uacute
+	{109, 251}: -20,  // m ucircumflex
+	{109, 252}: -20,  // m udieresis
+	{109, 253}: -30,  // m yacute
+	{109, 255}: -30,  // m ydieresis
+	{110, 117}: -10,  // n u
+	{110, 118}: -40,  // n v
+	{110, 121}: -20,  // n y
+	{110, 249}: -10,  // n ugrave
+	{110, 250}: -10,  // n uacute
+	{110, 251}: -10,  // n ucircumflex
+	{110, 252}: -10,  // n udieresis
+	{110, 253}: -20,  // n yacute
+	{110, 255}: -20,  // n ydieresis
+	{111, 118}: -20,  // o v
+	{111, 119}: -15,  // o w
+	{111, 120}: -30,  // o x
+	{111, 121}: -20,  // o y
+	{111, 253}: -20,  // o yacute
+	{111, 255}: -20,  // o ydieresis
+	{112, 121}: -15,  // p y
+	{112, 253}: -15,  // p yacute
+	{112, 255}: -15,  // p ydieresis
+	{114, 44}:  -60,  // r comma
+	{114, 45}:  -20,  // r hyphen
+	{114, 46}:  -60,  // r period
+	{114, 99}:  -20,  // r c
+	{114, 100}: -20,  // r d
+	{114, 103}: -15,  // r g
+	{114, 111}: -20,  // r o
+	{114, 113}: -20,  // r q
+	{114, 115}: -15,  // r s
+	{114, 116}: 20,   // r t
+	{114, 118}: 10,   // r v
+	{114, 121}: 10,   // r y
+	{114, 154}: -15,  // r scaron
+	{114, 173}: -20,  // r hyphen
+	{114, 231}: -20,  // r ccedilla
+	{114, 242}: -20,  // r ograve
+	{114, 243}: -20,  // r oacute
+	{114, 244}: -20,  // r ocircumflex
+	{114, 245}: -20,  // r otilde
+	{114, 246}: -20,  // r odieresis
+	{114, 248}: -20,  // r oslash
+	{114, 253}: 10,   // r yacute
+	{114, 255}: 10,   // r ydieresis
+	{115, 119}: -15,  // s w
+	{118, 44}:  -80,  // v comma
+	{118, 46}:  -80,  // v period
+	{118, 97}:  -20,  // v a
+	{118, 111}: -30,  // v o
+	{118, 224}: -20,  // v agrave
+	{118, 225}: -20,  // v aacute
+	{118, 226}: -20,  // v acircumflex
+	{118, 227}: -20,  // v atilde
+	{118, 228}: -20,  // v adieresis
+	{118, 229}: -20,  // v aring
+	{118, 242}: -30,  // v ograve
+	{118, 243}: -30,  // v oacute
+	{118, 244}: -30,  // v ocircumflex
+	{118, 245}: -30,  // v otilde
+	{118, 246}: -30,  // v odieresis
+	{118, 248}: -30,  // v oslash
+	{119, 44}:  -40,  // w comma
+	{119, 46}:  -40,  // w period
+	{119, 111}: -20,  // w o
+	{119, 242}: -20,  // w ograve
+	{119, 243}: -20,  // w oacute
+	{119, 244}: -20,  // w ocircumflex
+	{119, 245}: -20,  // w otilde
+	{119, 246}: -20,  // w odieresis
+	{119, 248}: -20,  // w oslash
+	{120, 101}: -10,  // x e
+	{120, 232}: -10,  // x egrave
+	{120, 233}: -10,  // x eacute
+	{120, 234}: -10,  // x ecircumflex
+	{120, 235}: -10,  // x edieresis
+	{121, 44}:  -80,  // y comma
+	{121, 46}:  -80,  // y period
+	{121, 97}:  -30,  // y a
+	{121, 101}: -10,  // y e
+	{121, 111}: -25,  // y o
+	{121, 224}: -30,  // y agrave
+	{121, 225}: -30,  // y aacute
+	{121, 226}: -30,  // y acircumflex
+	{121, 227}: -30,  // y atilde
+	{121, 228}: -30,  // y adieresis
+	{121, 229}: -30,  // y aring
+	{121, 232}: -10,  // y egrave
+	{121, 233}: -10,  // y eacute
+	{121, 234}: -10,  // y ecircumflex
+	{121, 235}: -10,  // y edieresis
+	{121, 242}: -25,  // y ograve
+	{121, 243}: -25,  // y oacute
+	{121, 244}: -25,  // y ocircumflex
+	{121, 245}: -25,  // y otilde
+	{121, 246}: -25,  // y odieresis
+	{121, 248}: -25,  // y oslash
+	{122, 101}: 10,   // z e
+	{122, 232}: 10,   // z egrave
+	{122, 233}: 10,   // z eacute
+	{122, 234}: 10,   // z ecircumflex
+	{122, 235}: 10,   // z edieresis
+	{145, 145}: -46,  // quoteleft quoteleft
+	{146, 32}:  -80,  // quoteright space
+	{146, 100}: -80,  // quoteright d
+	{146, 108}: -20,  // quoteright l
+	{146, 114}: -40,  // quoteright r
+	{146, 115}: -60,  // quoteright s
+	{146, 118}: -20,  // quoteright v
+	{146, 146}: -46,  // quoteright quoteright
+	{146, 154}: -60,  // quoteright scaron
+	{146, 160}: -80,  // quoteright space
+	{148, 32}:  -80,  // quotedblright space
+	{148, 160}: -80,  // quotedblright space
+	{154, 119}: -15,  // scaron w
+	{158, 101}: 10,   // zcaron e
+	{158, 232}: 10,   // zcaron egrave
+	{158, 233}: 10,   // zcaron eacute
+	{158, 234}: 10,   // zcaron ecircumflex
+	{158, 235}: 10,   // zcaron edieresis
+	{159, 44}:  -100, // Ydieresis comma
+	{159, 46}:  -100, // Ydieresis period
+	{159, 58}:  -50,  // Ydieresis colon
+	{159, 59}:  -50,  // Ydieresis semicolon
+	{159, 65}:  -110, // Ydieresis A
+	{159, 79}:  -70,  // Ydieresis O
+	{159, 97}:  -90,  // Ydieresis a
+	{159, 101}: -80,  // Ydieresis e
+	{159, 111}: -100, // Ydieresis o
+	{159, 117}: -100, // Ydieresis u
+	{159, 192}: -110, // Ydieresis Agrave
+	{159, 193}: -110, // Ydieresis Aacute
+	{159, 194}: -110, // Ydieresis Acircumflex
+	{159, 195}: -110, // Ydieresis Atilde
+	{159, 196}: -110, // Ydieresis Adieresis
+	{159, 197}: -110, // Ydieresis Aring
+	{159, 210}: -70,  // Ydieresis Ograve
+	{159, 211}: -70,  // Ydieresis Oacute
+	{159, 212}: -70,  // Ydieresis Ocircumflex
+	{159, 213}: -70,  // Ydieresis Otilde
+	{159, 214}: -70,  // Ydieresis Odieresis
+	{159, 216}: -70,  // Ydieresis Oslash
+	{159, 224}: -90,  // Ydieresis agrave
+	{159, 225}: -90,  // Ydieresis aacute
+	{159, 226}: -90,  // Ydieresis acircumflex
+	{159, 227}: -90,  // Ydieresis atilde
+	{159, 228}: -90,  // Ydieresis adieresis
+	{159, 229}: -90,  // Ydieresis aring
+	{159, 232}: -80,  // Ydieresis egrave
+	{159, 233}: -80,  // Ydieresis eacute
+	{159, 234}: -80,  // Ydieresis ecircumflex
+	{159, 235}: -80,  // Ydieresis edieresis
+	{159, 242}: -100, // Ydieresis ograve
+	{159, 243}: -100, // Ydieresis oacute
+	{159, 244}: -100, // Ydieresis ocircumflex
+	{159, 245}: -100, // Ydieresis otilde
+	{159, 246}: -100, // Ydieresis odieresis
+	{159, 248}: -100, // Ydieresis oslash
+	{159, 249}: -100, // Ydieresis ugrave
+	{159, 250}: -100, // Ydieresis uacute
+	{159, 251}: -100, // Ydieresis ucircumflex
+	{159, 252}: -100, // Ydieresis udieresis
+	{160, 84}:  -100, // space T
+	{160, 86}:  -80,  // space V
+	{160, 87}:  -80,  // space W
+	{160, 89}:  -120, // space Y
+	{160, 145}: -60,  // space quoteleft
+	{160, 147}: -80,  // space quotedblleft
+	{160, 159}: -120, // space Ydieresis
+	{160, 221}: -120, // space Yacute
+	{192, 67}:  -40,  // Agrave C
+	{192, 71}:  -50,  // Agrave G
+	{192, 79}:  -40,  // Agrave O
+	{192, 81}:  -40,  // Agrave Q
+	{192, 84}:  -90,  // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -80,  // Agrave V
+	{192, 87}:  -60,  // Agrave W
+	{192, 89}:  -110, // Agrave Y
+	{192, 117}: -30,  // Agrave u
+	{192, 118}: -40,  // Agrave v
+	{192, 119}: -30,  // Agrave w
+	{192, 121}: -30,  // Agrave y
+	{192, 159}: -110, // Agrave Ydieresis
+	{192, 199}: -40,  // Agrave Ccedilla
+	{192, 210}: -40,  // Agrave Ograve
+	{192, 211}: -40,  // Agrave Oacute
+	{192, 212}: -40,  // Agrave Ocircumflex
+	{192, 213}: -40,  // Agrave Otilde
+	{192, 214}: -40,  // Agrave Odieresis
+	{192, 216}: -40,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -110, // Agrave Yacute
+	{192, 249}: -30,  // Agrave ugrave
+	{192, 250}: -30,  // Agrave uacute
+	{192, 251}: -30,  // Agrave ucircumflex
+	{192, 252}: -30,  // Agrave udieresis
+	{192, 253}: -30,  // Agrave yacute
+	{192, 255}: -30,  // Agrave ydieresis
+	{193, 67}:  -40,  // Aacute C
+	{193, 71}:  -50,  // Aacute G
+	{193, 79}:  -40,  // Aacute O
+	{193, 81}:  -40,  // Aacute Q
+	{193, 84}:  -90,  // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -80,  // Aacute V
+	{193, 87}:  -60,  // Aacute W
+	{193, 89}:  -110, // Aacute Y
+	{193, 117}: -30,  // Aacute u
+	{193, 118}: -40,  // Aacute v
+	{193, 119}: -30,  // Aacute w
+	{193, 121}: -30,  // Aacute y
+	{193, 159}: -110, // Aacute Ydieresis
+	{193, 199}: -40,  // Aacute Ccedilla
+	{193, 210}: -40,  // Aacute Ograve
+	{193, 211}: -40,  // Aacute Oacute
+	{193, 212}: -40,  // Aacute Ocircumflex
+	{193, 213}: -40,  // Aacute Otilde
+	{193, 214}: -40,  // Aacute Odieresis
+	{193, 216}: -40,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -110, // Aacute Yacute
+	{193, 249}: -30,  // Aacute ugrave
+	{193, 250}: -30,  // Aacute uacute
+	{193, 251}: -30,  // Aacute ucircumflex
+	{193, 252}: -30,  // Aacute udieresis
+	{193, 253}: -30,  // Aacute yacute
+	{193, 255}: -30,  // Aacute ydieresis
+	{194, 67}:  -40,  // Acircumflex C
+	{194, 71}:  -50,  // Acircumflex G
+	{194, 79}:  -40,  // Acircumflex O
+	{194, 81}:  -40,  // Acircumflex Q
+	{194, 84}:  -90,  // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -80,  // Acircumflex V
+	{194, 87}:  -60,  // Acircumflex W
+	{194, 89}:  -110, // Acircumflex Y
+	{194, 117}: -30,  // Acircumflex u
+	{194, 118}: -40,  // Acircumflex v
+	{194, 119}: -30,  // Acircumflex w
+	{194, 121}: -30,  // Acircumflex y
+	{194, 159}: -110, // Acircumflex Ydieresis
+	{194, 199}: -40,  // Acircumflex Ccedilla
+	{194, 210}: -40,  // Acircumflex Ograve
+	{194, 211}: -40,  // Acircumflex Oacute
+	{194, 212}: -40,  // Acircumflex Ocircumflex
+	{194, 213}: -40,  // Acircumflex Otilde
+	{194, 214}: -40,  // Acircumflex Odieresis
+	{194, 216}: -40,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -110, // Acircumflex Yacute
+	{194, 249}: -30,  // Acircumflex ugrave
+	{194, 250}: -30,  // Acircumflex uacute
+	{194, 251}: -30,  // Acircumflex ucircumflex
+	{194, 252}: -30,  // Acircumflex udieresis
+	{194, 253}: -30,  // Acircumflex yacute
+	{194, 255}: -30,  // Acircumflex ydieresis
+	{195, 67}:  -40,  // Atilde C
+	{195, 71}:  -50,  // Atilde G
+	{195, 79}:  -40,  // Atilde O
+	{195, 81}:  -40,  // Atilde Q
+	{195, 84}:  -90,  // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -80,  // Atilde V
+	{195, 87}:  -60,  // Atilde W
+	{195, 89}:  -110, // Atilde Y
+	{195, 117}: -30,  // Atilde u
+	{195, 118}: -40,  // Atilde v
+	{195, 119}: -30,  // Atilde w
+	{195, 121}: -30,  // Atilde y
+	{195, 159}: -110, // Atilde Ydieresis
+	{195, 199}: -40,  // Atilde Ccedilla
+	{195, 210}: -40,  // Atilde Ograve
+	{195, 211}: -40,  // Atilde Oacute
+	{195, 212}: -40,  // Atilde Ocircumflex
+	{195, 213}: -40,  // Atilde Otilde
+	{195, 214}: -40,  // Atilde Odieresis
+	{195, 216}: -40,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -110, // Atilde Yacute
+	{195, 249}: -30,  // Atilde ugrave
+	{195, 250}: -30,  // Atilde uacute
+	{195, 251}: -30,  // Atilde ucircumflex
+	{195, 252}: -30,  // Atilde udieresis
+	{195, 253}: -30,  // Atilde yacute
+	{195, 255}: -30,  // Atilde ydieresis
+	{196, 67}:  -40,  // Adieresis C
+	{196, 71}:  -50,  // Adieresis G
+	{196, 79}:  -40,  // Adieresis O
+	{196, 81}:  -40,  // Adieresis Q
+	{196, 84}:  -90,  // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -80,  // Adieresis V
+	{196, 87}:  -60,  // Adieresis W
+	{196, 89}:  -110, // Adieresis Y
+	{196, 117}: -30,  // Adieresis u
+	{196, 118}: -40,  // Adieresis v
+	{196, 119}: -30,  // Adieresis w
+	{196, 121}: -30,  // Adieresis y
+	{196, 159}: -110, // Adieresis Ydieresis
+	{196, 199}: -40,  // Adieresis Ccedilla
+	{196, 210}: -40,  // Adieresis Ograve
+	{196, 211}: -40,  // Adieresis Oacute
+	{196, 212}: -40,  // Adieresis Ocircumflex
+	{196, 213}: -40,  // Adieresis Otilde
+	{196, 214}: -40,  // Adieresis Odieresis
+	{196, 216}: -40,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -110, // Adieresis Yacute
+	{196, 249}: -30,  // Adieresis ugrave
+	{196, 250}: -30,  // Adieresis uacute
+	{196, 251}: -30,  // Adieresis ucircumflex
+	{196, 252}: -30,  // Adieresis udieresis
+	{196, 253}: -30,  // Adieresis yacute
+	{196, 255}: -30,  // Adieresis ydieresis
+	{197, 67}:  -40,  // Aring C
+	{197, 71}:  -50,  // Aring G
+	{197, 79}:  -40,  // Aring O
+	{197, 81}:  -40,  // Aring Q
+	{197, 84}:  -90,  // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -80,  // Aring V
+	{197, 87}:  -60,  // Aring W
+	{197, 89}:  -110, // Aring Y
+	{197, 117}: -30,  // Aring u
+	{197, 118}: -40,  // Aring v
+	{197, 119}: -30,  // Aring w
+	{197, 121}: -30,  // Aring y
+	{197, 159}: -110, // Aring Ydieresis
+	{197, 199}: -40,  // Aring Ccedilla
+	{197, 210}: -40,  // Aring Ograve
+	{197, 211}: -40,  // Aring Oacute
+	{197, 212}: -40,  // Aring Ocircumflex
+	{197, 213}: -40,  // Aring Otilde
+	{197, 214}: -40,  // Aring Odieresis
+	{197, 216}: -40,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -110, // Aring Yacute
+	{197, 249}: -30,  // Aring ugrave
+	{197, 250}: -30,  // Aring uacute
+	{197, 251}: -30,  // Aring ucircumflex
+	{197, 252}: -30,  // Aring udieresis
+	{197, 253}: -30,  // Aring yacute
+	{197, 255}: -30,  // Aring ydieresis
+	{210, 44}:  -40,  // Ograve comma
+	{210, 46}:  -40,  // Ograve period
+	{210, 65}:  -50,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -50,  // Ograve W
+	{210, 88}:  -50,  // Ograve X
+	{210, 89}:  -70,  // Ograve Y
+	{210, 159}: -70,  // Ograve Ydieresis
+	{210, 192}: -50,  // Ograve Agrave
+	{210, 193}: -50,  // Ograve Aacute
+	{210, 194}: -50,  // Ograve Acircumflex
+	{210, 195}: -50,  // Ograve Atilde
+	{210, 196}: -50,  // Ograve Adieresis
+	{210, 197}: -50,  // Ograve Aring
+	{210, 221}: -70,  // Ograve Yacute
+	{211, 44}:  -40,  // Oacute comma
+	{211, 46}:  -40,  // Oacute period
+	{211, 65}:  -50,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -50,  // Oacute W
+	{211, 88}:  -50,  // Oacute X
+	{211, 89}:  -70,  // Oacute Y
+	{211, 159}: -70,  // Oacute Ydieresis
+	{211, 192}: -50,  // Oacute Agrave
+	{211, 193}: -50,  // Oacute Aacute
+	{211, 194}: -50,  // Oacute Acircumflex
+	{211, 195}: -50,  // Oacute Atilde
+	{211, 196}: -50,  // Oacute Adieresis
+	{211, 197}: -50,  // Oacute Aring
+	{211, 221}: -70,  // Oacute Yacute
+	{212, 44}:  -40,  // Ocircumflex comma
+	{212, 46}:  -40,  // Ocircumflex period
+	{212, 65}:  -50,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -50,  // Ocircumflex W
+	{212, 88}:  -50,  // Ocircumflex X
+	{212, 89}:  -70,  // Ocircumflex Y
+	{212, 159}: -70,  // Ocircumflex Ydieresis
+	{212, 192}: -50,  // Ocircumflex Agrave
+	{212, 193}: -50,  // Ocircumflex Aacute
+	{212, 194}: -50,  // Ocircumflex Acircumflex
+	{212, 195}: -50,  // Ocircumflex Atilde
+	{212, 196}: -50,  // Ocircumflex Adieresis
+	{212, 197}: -50,  // Ocircumflex Aring
+	{212, 221}: -70,  // Ocircumflex Yacute
+	{213, 44}:  -40,  // Otilde comma
+	{213, 46}:  -40,  // Otilde period
+	{213, 65}:  -50,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -50,  // Otilde W
+	{213, 88}:  -50,  // Otilde X
+	{213, 89}:  -70,  // Otilde Y
+	{213, 159}: -70,  // Otilde Ydieresis
+	{213, 192}: -50,  // Otilde Agrave
+	{213, 193}: -50,  // Otilde Aacute
+	{213, 194}: -50,  // Otilde Acircumflex
+	{213, 195}: -50,  // Otilde Atilde
+	{213, 196}: -50,  // Otilde Adieresis
+	{213, 197}: -50,  // Otilde Aring
+	{213, 221}: -70,  // Otilde Yacute
+	{214, 44}:  -40,  // Odieresis comma
+	{214, 46}:  -40,  // Odieresis period
+	{214, 65}:  -50,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -50,  // Odieresis W
+	{214, 88}:  -50,  // Odieresis X
+	{214, 89}:  -70,  // Odieresis Y
+	{214, 159}: -70,  // Odieresis Ydieresis
+	{214, 192}: -50,  // Odieresis Agrave
+	{214, 193}: -50,  // Odieresis Aacute
+	{214, 194}: -50,  // Odieresis Acircumflex
+	{214, 195}: -50,  // Odieresis Atilde
+	{214, 196}: -50,  // Odieresis Adieresis
+	{214, 197}: -50,  // Odieresis Aring
+	{214, 221}: -70,  // Odieresis Yacute
+	{216, 44}:  -40,  // Oslash comma
+	{216, 46}:  -40,  // Oslash period
+	{216, 65}:  -50,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -50,  // Oslash W
+	{216, 88}:  -50,  // Oslash X
+	{216, 89}:  -70,  // Oslash Y
+	{216, 159}: -70,  // Oslash Ydieresis
+	{216, 192}: -50,  // Oslash Agrave
+	{216, 193}: -50,  // Oslash Aacute
+	{216, 194}: -50,  // Oslash Acircumflex
+	{216, 195}: -50,  // Oslash Atilde
+	{216, 196}: -50,  // Oslash Adieresis
+	{216, 197}: -50,  // Oslash Aring
+	{216, 221}: -70,  // Oslash Yacute
+	{217, 44}:  -30,  // Ugrave comma
+	{217, 46}:  -30,  // Ugrave period
+	{217, 65}:  -50,  // Ugrave A
+	{217, 192}: -50,  // Ugrave Agrave
+	{217, 193}: -50,  // Ugrave Aacute
+	{217, 194}: -50,  // Ugrave Acircumflex
+	{217, 195}: -50,  // Ugrave Atilde
+	{217, 196}: -50,  // Ugrave Adieresis
+	{217, 197}: -50,  // Ugrave Aring
+	{218, 44}:  -30,  // Uacute comma
+	{218, 46}:  -30,  // Uacute period
+	{218, 65}:  -50,  // Uacute A
+	{218, 192}: -50,  // Uacute Agrave
+	{218, 193}: -50,  // Uacute Aacute
+	{218, 194}: -50,  // Uacute Acircumflex
+	{218, 195}: -50,  // Uacute Atilde
+	{218, 196}: -50,  // Uacute Adieresis
+	{218, 197}: -50,  // Uacute Aring
+	{219, 44}:  -30,  // Ucircumflex comma
+	{219, 46}:  -30,  // Ucircumflex period
+	{219, 65}:  -50,  // Ucircumflex A
+	{219, 192}: -50,  // Ucircumflex Agrave
+	{219, 193}: -50,  // Ucircumflex Aacute
+	{219, 194}: -50,  // Ucircumflex Acircumflex
+	{219, 195}: -50,  // Ucircumflex Atilde
+	{219, 196}: -50,  // Ucircumflex Adieresis
+	{219, 197}: -50,  // Ucircumflex Aring
+	{220, 44}:  -30,  // Udieresis comma
+	{220, 46}:  -30,  // Udieresis period
+	{220, 65}:  -50,  // Udieresis A
+	{220, 192}: -50,  // Udieresis Agrave
+	{220, 193}: -50,  // Udieresis Aacute
+	{220, 194}: -50,  // Udieresis Acircumflex
+	{220, 195}: -50,  // Udieresis Atilde
+	{220, 196}: -50,  // Udieresis Adieresis
+	{220, 197}: -50,  // Udieresis Aring
+	{221, 44}:  -100, // Yacute comma
+	{221, 46}:  -100, // Yacute period
+	{221, 58}:  -50,  // Yacute colon
+	{221, 59}:  -50,  // Yacute semicolon
+	{221, 65}:  -110, // Yacute A
+	{221, 79}:  -70,  // Yacute O
+	{221, 97}:  -90,  // Yacute a
+	{221, 101}: -80,  // Yacute e
+	{221, 111}: -100, // Yacute o
+	{221, 117}: -100, // Yacute u
+	{221, 192}: -110, // Yacute Agrave
+	{221, 193}: -110, // Yacute Aacute
+	{221, 194}: -110, // Yacute Acircumflex
+	{221, 195}: -110, // Yacute Atilde
+	{221, 196}: -110, // Yacute Adieresis
+	{221, 197}: -110, // Yacute Aring
+	{221, 210}: -70,  // Yacute Ograve
+	{221, 211}: -70,  // Yacute Oacute
+	{221, 212}: -70,  // Yacute Ocircumflex
+	{221, 213}: -70,  // Yacute Otilde
+	{221, 214}: -70,  // Yacute Odieresis
+	{221, 216}: -70,  // Yacute Oslash
+	{221, 224}: -90,  // Yacute agrave
+	{221, 225}: -90,  // Yacute aacute
+	{221, 226}: -90,  // Yacute acircumflex
+	{221, 227}: -90,  // Yacute atilde
+	{221, 228}: -90,  // Yacute adieresis
+	{221, 229}: -90,  // Yacute aring
+	{221, 232}: -80,  // Yacute egrave
+	{221, 233}: -80,  // Yacute eacute
+	{221, 234}: -80,  // Yacute ecircumflex
+	{221, 235}: -80,  // Yacute edieresis
+	{221, 242}: -100, // Yacute ograve
+	{221, 243}: -100, // Yacute oacute
+	{221, 244}: -100, // Yacute ocircumflex
+	{221, 245}: -100, // Yacute otilde
+	{221, 246}: -100, // Yacute odieresis
+	{221, 248}: -100, // Yacute oslash
+	{221, 249}: -100, // Yacute ugrave
+	{221, 250}: -100, // Yacute uacute
+	{221, 251}: -100, // Yacute ucircumflex
+	{221, 252}: -100, // Yacute udieresis
+	{224, 103}: -10,  // agrave g
+	{224, 118}: -15,  // agrave v
+	{224, 119}: -15,  // agrave w
+	{224, 121}: -20,  // agrave y
+	{224, 253}: -20,  // agrave yacute
+	{224, 255}: -20,  // agrave ydieresis
+	{225, 103}: -10,  // aacute g
+	{225, 118}: -15,  // aacute v
+	{225, 119}: -15,  // aacute w
+	{225, 121}: -20,  // aacute y
+	{225, 253}: -20,  // aacute yacute
+	{225, 255}: -20,  // aacute ydieresis
+	{226, 103}: -10,  // acircumflex g
+	{226, 118}: -15,  // acircumflex v
+	{226, 119}: -15,  // acircumflex w
+	{226, 121}: -20,  // acircumflex y
+	{226, 253}: -20,  // acircumflex yacute
+	{226, 255}: -20,  // acircumflex ydieresis
+	{227, 103}: -10,  // atilde g
+	{227, 118}: -15,  // atilde v
+	{227, 119}: -15,  // atilde w
+	{227, 121}: -20,  // atilde y
+	{227, 253}: -20,  // atilde yacute
+	{227, 255}: -20,  // atilde ydieresis
+	{228, 103}: -10,  // adieresis g
+	{228, 118}: -15,  // adieresis v
+	{228, 119}: -15,  // adieresis w
+	{228, 121}: -20,  // adieresis y
+	{228, 253}: -20,  // adieresis yacute
+	{228, 255}: -20,  // adieresis ydieresis
+	{229, 103}: -10,  // aring g
+	{229, 118}: -15,  // aring v
+	{229, 119}: -15,  // aring w
+	{229, 121}: -20,  // aring y
+	{229, 253}: -20,  // aring yacute
+	{229, 255}: -20,  // aring ydieresis
+	{231, 104}: -10,  // ccedilla h
+	{231, 107}: -20,  // ccedilla k
+	{231, 108}: -20,  // ccedilla l
+	{231, 121}: -10,  // ccedilla y
+	{231, 253}: -10,  // ccedilla yacute
+	{231, 255}: -10,  // ccedilla ydieresis
+	{232, 44}:  10,   // egrave comma
+	{232, 46}:  20,   // egrave period
+	{232, 118}: -15,  // egrave v
+	{232, 119}: -15,  // egrave w
+	{232, 120}: -15,  // egrave x
+	{232, 121}: -15,  // egrave y
+	{232, 253}: -15,  // egrave yacute
+	{232, 255}: -15,  // egrave ydieresis
+	{233, 44}:  10,   // eacute comma
+	{233, 46}:  20,   // eacute period
+	{233, 118}: -15,  // eacute v
+	{233, 119}: -15,  // eacute w
+	{233, 120}: -15,  // eacute x
+	{233, 121}: -15,  // eacute y
+	{233, 253}: -15,  // eacute yacute
+	{233, 255}: -15,  // eacute ydieresis
+	{234, 44}:  10,   // ecircumflex comma
+	{234, 46}:  20,   // ecircumflex period
+	{234, 118}: -15,  // ecircumflex v
+	{234, 119}: -15,  // ecircumflex w
+	{234, 120}: -15,  // ecircumflex x
+	{234, 121}: -15,  // ecircumflex y
+	{234, 253}: -15,  // ecircumflex yacute
+	{234, 255}: -15,  // ecircumflex ydieresis
+	{235, 44}:  10,   // edieresis comma
+	{235, 46}:  20,   // edieresis period
+	{235, 118}: -15,  // edieresis v
+	{235, 119}: -15,  // edieresis w
+	{235, 120}: -15,  // edieresis x
+	{235, 121}: -15,  // edieresis y
+	{235, 253}: -15,  // edieresis yacute
+	{235, 255}: -15,  // edieresis ydieresis
+	{241, 117}: -10,  // ntilde u
+	{241, 118}: -40,  // ntilde v
+	{241, 121}: -20,  // ntilde y
+	{241, 249}: -10,  // ntilde ugrave
+	{241, 250}: -10,  // ntilde uacute
+	{241, 251}: -10,  // ntilde ucircumflex
+	{241, 252}: -10,  // ntilde udieresis
+	{241, 253}: -20,  // ntilde yacute
+	{241, 255}: -20,  // ntilde ydieresis
+	{242, 118}: -20,  // ograve v
+	{242, 119}: -15,  // ograve w
+	{242, 120}: -30,  // ograve x
+	{242, 121}: -20,  // ograve y
+	{242, 253}: -20,  // ograve yacute
+	{242, 255}: -20,  // ograve ydieresis
+	{243, 118}: -20,  // oacute v
+	{243, 119}: -15,  // oacute w
+	{243, 120}: -30,  // oacute x
+	{243, 121}: -20,  // oacute y
+	{243, 253}: -20,  // oacute yacute
+	{243, 255}: -20,  // oacute ydieresis
+	{244, 118}: -20,  // ocircumflex v
+	{244, 119}: -15,  // ocircumflex w
+	{244, 120}: -30,  // ocircumflex x
+	{244, 121}: -20,  // ocircumflex y
+	{244, 253}: -20,  // ocircumflex yacute
+	{244, 255}: -20,  // ocircumflex ydieresis
+	{245, 118}: -20,  // otilde v
+	{245, 119}: -15,  // otilde w
+	{245, 120}: -30,  // otilde x
+	{245, 121}: -20,  // otilde y
+	{245, 253}: -20,  // otilde yacute
+	{245, 255}: -20,  // otilde ydieresis
+	{246, 118}: -20,  // odieresis v
+	{246, 119}: -15,  // odieresis w
+	{246, 120}: -30,  // odieresis x
+	{246, 121}: -20,  // odieresis y
+	{246, 253}: -20,  // odieresis yacute
+	{246, 255}: -20,  // odieresis ydieresis
+	{248, 118}: -20,  // oslash v
+	{248, 119}: -15,  // oslash w
+	{248, 120}: -30,  // oslash x
+	{248, 121}: -20,  // oslash y
+	{248, 253}: -20,  // oslash yacute
+	{248, 255}: -20,  // oslash ydieresis
+	{253, 44}:  -80,  // yacute comma
+	{253, 46}:  -80,  // yacute period
+	{253, 97}:  -30,  // yacute a
+	{253, 101}: -10,  // yacute e
+	{253, 111}: -25,  // yacute o
+	{253, 224}: -30,  // yacute agrave
+	{253, 225}: -30,  // yacute aacute
+	{253, 226}: -30,  // yacute acircumflex
+	{253, 227}: -30,  // yacute atilde
+	{253, 228}: -30,  // yacute adieresis
+	{253, 229}: -30,  // yacute aring
+	{253, 232}: -10,  // yacute egrave
+	{253, 233}: -10,  // yacute eacute
+	{253, 234}: -10,  // yacute ecircumflex
+	{253, 235}: -10,  // yacute edieresis
+	{253, 242}: -25,  // yacute ograve
+	{253, 243}: -25,  // yacute oacute
+	{253, 244}: -25,  // yacute ocircumflex
+	{253, 245}: -25,  // yacute otilde
+	{253, 246}: -25,  // yacute odieresis
+	{253, 248}: -25,  // yacute oslash
+	{255, 44}:  -80,  // ydieresis comma
+	{255, 46}:  -80,  // ydieresis period
+	{255, 97}:  -30,  // ydieresis a
+	{255, 101}: -10,  // ydieresis e
+	{255, 111}: -25,  // ydieresis o
+	{255, 224}: -30,  // ydieresis agrave
+	{255, 225}: -30,  // ydieresis aacute
+	{255, 226}: -30,  // ydieresis acircumflex
+	{255, 227}: -30,  // ydieresis atilde
+	{255, 228}: -30,  // ydieresis adieresis
+	{255, 229}: -30,  // ydieresis aring
+	{255, 232}: -10,  // ydieresis egrave
+	{255, 233}: -10,  // ydieresis eacute
+	{255, 234}: -10,  // ydieresis ecircumflex
+	{255, 235}: -10,  // ydieresis edieresis
+	{255, 242}: -25,  // ydieresis ograve
+	{255, 243}: -25,  // ydieresis oacute
+	{255, 244}: -25,  // ydieresis ocircumflex
+	{255, 245}: -25,  // ydieresis otilde
+	{255, 246}: -25,  // ydieresis odieresis
+	{255, 248}: -25,  // ydieresis oslash
 }
 
 // Helvetica-Oblique
+var helveticaObliqueMetrics = FontMetrics{
+	Ascender:    718,
+	Descender:   -207,
+	CapHeight:   718,
+	XHeight:     523,
+	ItalicAngle: -12,
+	FontBBox:    Rectangle{Point{-170, -225}, Point{1116, 931}},
+}
+
 var helveticaObliqueWidths = []uint16{
 	32:  278,  // space
 	33:  278,  // exclam
@@ -702,7 +5306,7 @@ var helveticaObliqueWidths = []uint16{
 	36:  556,  // dollar
 	37:  889,  // percent
 	38:  667,  // ampersand
-	39:  222,  // quoteright
+	39:  191,  // quotesingle
 	40:  333,  // parenleft
 	41:  333,  // parenright
 	42:  389,  // asterisk
@@ -759,7 +5363,7 @@ var helveticaObliqueWidths = []uint16{
 	93:  278,  // bracketright
 	94:  469,  // asciicircum
 	95:  556,  // underscore
-	96:  222,  // quoteleft
+	96:  333,  // grave
 	97:  556,  // a
 	98:  556,  // b
 	99:  500,  // c
@@ -790,108 +5394,1648 @@ var helveticaObliqueWidths = []uint16{
 	124: 260,  // bar
 	125: 334,  // braceright
 	126: 584,  // asciitilde
+	128: 556,  // Euro
+	130: 222,  // quotesinglbase
+	131: 556,  // florin
+	132: 333,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 556,  // dagger
+	135: 556,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 667,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 1000, // OE
+	142: 611,  // Zcaron
+	145: 222,  // quoteleft
+	146: 222,  // quoteright
+	147: 333,  // quotedblleft
+	148: 333,  // quotedblright
+	149: 350,  // bullet
+	150: 556,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 1000, // trademark
+	154: 500,  // scaron
+	155: 333,  // guilsinglright
+	156: 944,  // oe
+	158: 500,  // zcaron
+	159: 667,  // Ydieresis
+	160: 278,  // space
+	161: 333,  // exclamdown
+	162: 556,  // cent
+	163: 556,  // sterling
+	164: 556,  // currency
+	165: 556,  // yen
+	166: 260,  // brokenbar
+	167: 556,  // section
+	168: 333,  // dieresis
+	169: 737,  // copyright
+	170: 370,  // ordfeminine
+	171: 556,  // guillemotleft
+	172: 584,  // logicalnot
+	173: 333,  // hyphen
+	174: 737,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 584,  // plusminus
+	178: 333,  // twosuperior
+	179: 333,  // threesuperior
+	180: 333,  // acute
+	181: 556,  // mu
+	182: 537,  // paragraph
+	183: 278,  // periodcentered
+	184: 333,  // cedilla
+	185: 333,  // onesuperior
+	186: 365,  // ordmasculine
+	187: 556,  // guillemotright
+	188: 834,  // onequarter
+	189: 834,  // onehalf
+	190: 834,  // threequarters
+	191: 611,  // questiondown
+	192: 667,  // Agrave
+	193: 667,  // Aacute
+	194: 667,  // Acircumflex
+	195: 667,  // Atilde
+	196: 667,  // Adieresis
+	197: 667,  // Aring
+	198: 1000, // AE
+	199: 722,  // Ccedilla
+	200: 667,  // Egrave
+	201: 667,  // Eacute
+	202: 667,  // Ecircumflex
+	203: 667,  // Edieresis
+	204: 278,  // Igrave
+	205: 278,  // Iacute
+	206: 278,  // Icircumflex
+	207: 278,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 778,  // Ograve
+	211: 778,  // Oacute
+	212: 778,  // Ocircumflex
+	213: 778,  // Otilde
+	214: 778,  // Odieresis
+	215: 584,  // multiply
+	216: 778,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 667,  // Yacute
+	222: 667,  // Thorn
+	223: 611,  // germandbls
+	224: 556,  // agrave
+	225: 556,  // aacute
+	226: 556,  // acircumflex
+	227: 556,  // atilde
+	228: 556,  // adieresis
+	229: 556,  // aring
+	230: 889,  // ae
+	231: 500,  // ccedilla
+	232: 556,  // egrave
+	233: 556,  // eacute
+	234: 556,  // ecircumflex
+	235: 556,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 556,  // eth
+	241: 556,  // ntilde
+	242: 556,  // ograve
+	243: 556,  // oacute
+	244: 556,  // ocircumflex
+	245: 556,  // otilde
+	246: 556,  // odieresis
+	247: 584,  // divide
+	248: 611,  // oslash
+	249: 556,  // ugrave
+	250: 556,  // uacute
+	251: 556,  // ucircumflex
+	252: 556,  // udieresis
+	253: 500,  // yacute
+	254: 556,  // thorn
+	255: 500,  // ydieresis
+}
+
+var helveticaObliqueKerning = map[kernPair]int16{
+	{32, 84}:   -50,  // space T
+	{32, 86}:   -50,  // space V
+	{32, 87}:   -40,  // space W
+	{32, 89}:   -90,  // space Y
+	{32, 145}:  -60,  // space quoteleft
+	{32, 147}:  -30,  // space quotedblleft
+	{32, 159}:  -90,  // space Ydieresis
+	{32, 221}:  -90,  // space Yacute
+	{44, 146}:  -100, // comma quoteright
+	{44, 148}:  -100, // comma quotedblright
+	{46, 32}:   -60,  // period space
+	{46, 146}:  -100, // period quoteright
+	{46, 148}:  -100, // period quotedblright
+	{46, 160}:  -60,  // period space
+	{58, 32}:   -50,  // colon space
+	{58, 160}:  -50,  // colon space
+	{59, 32}:   -50,  // semicolon space
+	{59, 160}:  -50,  // semicolon space
+	{65, 67}:   -30,  // A C
+	{65, 71}:   -30,  // A G
+	{65, 79}:   -30,  // A O
+	{65, 81}:   -30,  // A Q
+	{65, 84}:   -120, // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -70,  // A V
+	{65, 87}:   -50,  // A W
+	{65, 89}:   -100, // A Y
+	{65, 117}:  -30,  // A u
+	{65, 118}:  -40,  // A v
+	{65, 119}:  -40,  // A w
+	{65, 121}:  -40,  // A y
+	{65, 159}:  -100, // A Ydieresis
+	{65, 199}:  -30,  // A Ccedilla
+	{65, 210}:  -30,  // A Ograve
+	{65, 211}:  -30,  // A Oacute
+	{65, 212}:  -30,  // A Ocircumflex
+	{65, 213}:  -30,  // A Otilde
+	{65, 214}:  -30,  // A Odieresis
+	{65, 216}:  -30,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -100, // A Yacute
+	{65, 249}:  -30,  // A ugrave
+	{65, 250}:  -30,  // A uacute
+	{65, 251}:  -30,  // A ucircumflex
+	{65, 252}:  -30,  // A udieresis
+	{65, 253}:  -40,  // A yacute
+	{65, 255}:  -40,  // A ydieresis
+	{66, 44}:   -20,  // B comma
+	{66, 46}:   -20,  // B period
+	{66, 85}:   -10,  // B U
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{67, 44}:   -30,  // C comma
+	{67, 46}:   -30,  // C period
+	{68, 44}:   -70,  // D comma
+	{68, 46}:   -70,  // D period
+	{68, 65}:   -40,  // D A
+	{68, 86}:   -70,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -90,  // D Y
+	{68, 159}:  -90,  // D Ydieresis
+	{68, 192}:  -40,  // D Agrave
+	{68, 193}:  -40,  // D Aacute
+	{68, 194}:  -40,  // D Acircumflex
+	{68, 195}:  -40,  // D Atilde
+	{68, 196}:  -40,  // D Adieresis
+	{68, 197}:  -40,  // D Aring
+	{68, 221}:  -90,  // D Yacute
+	{70, 44}:   -150, // F comma
+	{70, 46}:   -150, // F period
+	{70, 65}:   -80,  // F A
+	{70, 97}:   -50,  // F a
+	{70, 101}:  -30,  // F e
+	{70, 111}:  -30,  // F o
+	{70, 114}:  -45,  // F r
+	{70, 192}:  -80,  // F Agrave
+	{70, 193}:  -80,  // F Aacute
+	{70, 194}:  -80,  // F Acircumflex
+	{70, 195}:  -80,  // F Atilde
+	{70, 196}:  -80,  // F Adieresis
+	{70, 197}:  -80,  // F Aring
+	{70, 224}:  -50,  // F agrave
+	{70, 225}:  -50,  // F aacute
+	{70, 226}:  -50,  // F acircumflex
+	{70, 227}:  -50,  // F atilde
+	{70, 228}:  -50,  // F adieresis
+	{70, 229}:  -50,  // F aring
+	{70, 232}:  -30,  // F egrave
+	{70, 233}:  -30,  // F eacute
+	{70, 234}:  -30,  // F ecircumflex
+	{70, 235}:  -30,  // F edieresis
+	{70, 242}:  -30,  // F ograve
+	{70, 243}:  -30,  // F oacute
+	{70, 244}:  -30,  // F ocircumflex
+	{70, 245}:  -30,  // F otilde
+	{70, 246}:  -30,  // F odieresis
+	{70, 248}:  -30,  // F oslash
+	{74, 44}:   -30,  // J comma
+	{74, 46}:   -30,  // J period
+	{74, 65}:   -20,  // J A
+	{74, 97}:   -20,  // J a
+	{74, 117}:  -20,  // J u
+	{74, 192}:  -20,  // J Agrave
+	{74, 193}:  -20,  // J Aacute
+	{74, 194}:  -20,  // J Acircumflex
+	{74, 195}:  -20,  // J Atilde
+	{74, 196}:  -20,  // J Adieresis
+	{74, 197}:  -20,  // J Aring
+	{74, 224}:  -20,  // J agrave
+	{74, 225}:  -20,  // J aacute
+	{74, 226}:  -20,  // J acircumflex
+	{74, 227}:  -20,  // J atilde
+	{74, 228}:  -20,  // J adieresis
+	{74, 229}:  -20,  // J aring
+	{74, 249}:  -20,  // J ugrave
+	{74, 250}:  -20,  // J uacute
+	{74, 251}:  -20,  // J ucircumflex
+	{74, 252}:  -20,  // J udieresis
+	{75, 79}:   -50,  // K O
+	{75, 101}:  -40,  // K e
+	{75, 111}:  -40,  // K o
+	{75, 117}:  -30,  // K u
+	{75, 121}:  -50,  // K y
+	{75, 210}:  -50,  // K Ograve
+	{75, 211}:  -50,  // K Oacute
+	{75, 212}:  -50,  // K Ocircumflex
+	{75, 213}:  -50,  // K Otilde
+	{75, 214}:  -50,  // K Odieresis
+	{75, 216}:  -50,  // K Oslash
+	{75, 232}:  -40,  // K egrave
+	{75, 233}:  -40,  // K eacute
+	{75, 234}:  -40,  // K ecircumflex
+	{75, 235}:  -40,  // K edieresis
+	{75, 242}:  -40,  // K ograve
+	{75, 243}:  -40,  // K oacute
+	{75, 244}:  -40,  // K ocircumflex
+	{75, 245}:  -40,  // K otilde
+	{75, 246}:  -40,  // K odieresis
+	{75, 248}:  -40,  // K oslash
+	{75, 249}:  -30,  // K ugrave
+	{75, 250}:  -30,  // K uacute
+	{75, 251}:  -30,  // K ucircumflex
+	{75, 252}:  -30,  // K udieresis
+	{75, 253}:  -50,  // K yacute
+	{75, 255}:  -50,  // K ydieresis
+	{76, 84}:   -110, // L T
+	{76, 86}:   -110, // L V
+	{76, 87}:   -70,  // L W
+	{76, 89}:   -140, // L Y
+	{76, 121}:  -30,  // L y
+	{76, 146}:  -160, // L quoteright
+	{76, 148}:  -140, // L quotedblright
+	{76, 159}:  -140, // L Ydieresis
+	{76, 221}:  -140, // L Yacute
+	{76, 253}:  -30,  // L yacute
+	{76, 255}:  -30,  // L ydieresis
+	{79, 44}:   -40,  // O comma
+	{79, 46}:   -40,  // O period
+	{79, 65}:   -20,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -30,  // O W
+	{79, 88}:   -60,  // O X
+	{79, 89}:   -70,  // O Y
+	{79, 159}:  -70,  // O Ydieresis
+	{79, 192}:  -20,  // O Agrave
+	{79, 193}:  -20,  // O Aacute
+	{79, 194}:  -20,  // O Acircumflex
+	{79, 195}:  -20,  // O Atilde
+	{79, 196}:  -20,  // O Adieresis
+	{79, 197}:  -20,  // O Aring
+	{79, 221}:  -70,  // O Yacute
+	{80, 44}:   -180, // P comma
+	{80, 46}:   -180, // P period
+	{80, 65}:   -120, // P A
+	{80, 97}:   -40,  // P a
+	{80, 101}:  -50,  // P e
+	{80, 111}:  -50,  // P o
+	{80, 192}:  -120, // P Agrave
+	{80, 193}:  -120, // P Aacute
+	{80, 194}:  -120, // P Acircumflex
+	{80, 195}:  -120, // P Atilde
+	{80, 196}:  -120, // P Adieresis
+	{80, 197}:  -120, // P Aring
+	{80, 224}:  -40,  // P agrave
+	{80, 225}:  -40,  // P aacute
+	{80, 226}:  -40,  // P acircumflex
+	{80, 227}:  -40,  // P atilde
+	{80, 228}:  -40,  // P adieresis
+	{80, 229}:  -40,  // P aring
+	{80, 232}:  -50,  // P egrave
+	{80, 233}:  -50,  // P eacute
+	{80, 234}:  -50,  // P ecircumflex
+	{80, 235}:  -50,  // P edieresis
+	{80, 242}:  -50,  // P ograve
+	{80, 243}:  -50,  // P oacute
+	{80, 244}:  -50,  // P ocircumflex
+	{80, 245}:  -50,  // P otilde
+	{80, 246}:  -50,  // P odieresis
+	{80, 248}:  -50,  // P oslash
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -20,  // R O
+	{82, 84}:   -30,  // R T
+	{82, 85}:   -40,  // R U
+	{82, 86}:   -50,  // R V
+	{82, 87}:   -30,  // R W
+	{82, 89}:   -50,  // R Y
+	{82, 159}:  -50,  // R Ydieresis
+	{82, 210}:  -20,  // R Ograve
+	{82, 211}:  -20,  // R Oacute
+	{82, 212}:  -20,  // R Ocircumflex
+	{82, 213}:  -20,  // R Otilde
+	{82, 214}:  -20,  // R Odieresis
+	{82, 216}:  -20,  // R Oslash
+	{82, 217}:  -40,  // R Ugrave
+	{82, 218}:  -40,  // R Uacute
+	{82, 219}:  -40,  // R Ucircumflex
+	{82, 220}:  -40,  // R Udieresis
+	{82, 221}:  -50,  // R Yacute
+	{83, 44}:   -20,  // S comma
+	{83, 46}:   -20,  // S period
+	{84, 44}:   -120, // T comma
+	{84, 45}:   -140, // T hyphen
+	{84, 46}:   -120, // T period
+	{84, 58}:   -20,  // T colon
+	{84, 59}:   -20,  // T semicolon
+	{84, 65}:   -120, // T A
+	{84, 79}:   -40,  // T O
+	{84, 97}:   -120, // T a
+	{84, 101}:  -120, // T e
+	{84, 111}:  -120, // T o
+	{84, 114}:  -120, // T r
+	{84, 117}:  -120, // T u
+	{84, 119}:  -120, // T w
+	{84, 121}:  -120, // T y
+	{84, 173}:  -140, // T hyphen
+	{84, 192}:  -120, // T Agrave
+	{84, 193}:  -120, // T Aacute
+	{84, 194}:  -120, // T Acircumflex
+	{84, 195}:  -120, // T Atilde
+	{84, 196}:  -120, // T Adieresis
+	{84, 197}:  -120, // T Aring
+	{84, 210}:  -40,  // T Ograve
+	{84, 211}:  -40,  // T Oacute
+	{84, 212}:  -40,  // T Ocircumflex
+	{84, 213}:  -40,  // T Otilde
+	{84, 214}:  -40,  // T Odieresis
+	{84, 216}:  -40,  // T Oslash
+	{84, 224}:  -120, // T agrave
+	{84, 225}:  -120, // T aacute
+	{84, 226}:  -120, // T acircumflex
+	{84, 227}:  -60,  // T atilde
+	{84, 228}:  -120, // T adieresis
+	{84, 229}:  -120, // T aring
+	{84, 232}:  -60,  // T egrave
+	{84, 233}:  -120, // T eacute
+	{84, 234}:  -120, // T ecircumflex
+	{84, 235}:  -120, // T edieresis
+	{84, 242}:  -120, // T ograve
+	{84, 243}:  -120, // T oacute
+	{84, 244}:  -120, // T ocircumflex
+	{84, 245}:  -60,  // T otilde
+	{84, 246}:  -120, // T odieresis
+	{84, 248}:  -120, // T oslash
+	{84, 249}:  -120, // T ugrave
+	{84, 250}:  -120, // T uacute
+	{84, 251}:  -120, // T ucircumflex
+	{84, 252}:  -120, // T udieresis
+	{84, 253}:  -120, // T yacute
+	{84, 255}:  -60,  // T ydieresis
+	{85, 44}:   -40,  // U comma
+	{85, 46}:   -40,  // U period
+	{85, 65}:   -40,  // U A
+	{85, 192}:  -40,  // U Agrave
+	{85, 193}:  -40,  // U Aacute
+	{85, 194}:  -40,  // U Acircumflex
+	{85, 195}:  -40,  // U Atilde
+	{85, 196}:  -40,  // U Adieresis
+	{85, 197}:  -40,  // U Aring
+	{86, 44}:   -125, // V comma
+	{86, 45}:   -80,  // V hyphen
+	{86, 46}:   -125, // V period
+	{86, 58}:   -40,  // V colon
+	{86, 59}:   -40,  // V semicolon
+	{86, 65}:   -80,  // V A
+	{86, 71}:   -40,  // V G
+	{86, 79}:   -40,  // V O
+	{86, 97}:   -70,  // V a
+	{86, 101}:  -80,  // V e
+	{86, 111}:  -80,  // V o
+	{86, 117}:  -70,  // V u
+	{86, 173}:  -80,  // V hyphen
+	{86, 192}:  -80,  // V Agrave
+	{86, 193}:  -80,  // V Aacute
+	{86, 194}:  -80,  // V Acircumflex
+	{86, 195}:  -80,  // V Atilde
+	{86, 196}:  -80,  // V Adieresis
+	{86, 197}:  -80,  // V Aring
+	{86, 210}:  -40,  // V Ograve
+	{86, 211}:  -40,  // V Oacute
+	{86, 212}:  -40,  // V Ocircumflex
+	{86, 213}:  -40,  // V Otilde
+	{86, 214}:  -40,  // V Odieresis
+	{86, 216}:  -40,  // V Oslash
+	{86, 224}:  -70,  // V agrave
+	{86, 225}:  -70,  // V aacute
+	{86, 226}:  -70,  // V acircumflex
+	{86, 227}:  -70,  // V atilde
+	{86, 228}:  -70,  // V adieresis
+	{86, 229}:  -70,  // V aring
+	{86, 232}:  -80,  // V egrave
+	{86, 233}:  -80,  // V eacute
+	{86, 234}:  -80,  // V ecircumflex
+	{86, 235}:  -80,  // V edieresis
+	{86, 242}:  -80,  // V ograve
+	{86, 243}:  -80,  // V oacute
+	{86, 244}:  -80,  // V ocircumflex
+	{86, 245}:  -80,  // V otilde
+	{86, 246}:  -80,  // V odieresis
+	{86, 248}:  -80,  // V oslash
+	{86, 249}:  -70,  // V ugrave
+	{86, 250}:  -70,  // V uacute
+	{86, 251}:  -70,  // V ucircumflex
+	{86, 252}:  -70,  // V udieresis
+	{87, 44}:   -80,  // W comma
+	{87, 45}:   -40,  // W hyphen
+	{87, 46}:   -80,  // W period
+	{87, 65}:   -50,  // W A
+	{87, 79}:   -20,  // W O
+	{87, 97}:   -40,  // W a
+	{87, 101}:  -30,  // W e
+	{87, 111}:  -30,  // W o
+	{87, 117}:  -30,  // W u
+	{87, 121}:  -20,  // W y
+	{87, 173}:  -40,  // W hyphen
+	{87, 192}:  -50,  // W Agrave
+	{87, 193}:  -50,  // W Aacute
+	{87, 194}:  -50,  // W Acircumflex
+	{87, 195}:  -50,  // W Atilde
+	{87, 196}:  -50,  // W Adieresis
+	{87, 197}:  -50,  // W Aring
+	{87, 210}:  -20,  // W Ograve
+	{87, 211}:  -20,  // W Oacute
+	{87, 212}:  -20,  // W Ocircumflex
+	{87, 213}:  -20,  // W Otilde
+	{87, 214}:  -20,  // W Odieresis
+	{87, 216}:  -20,  // W Oslash
+	{87, 224}:  -40,  // W agrave
+	{87, 225}:  -40,  // W aacute
+	{87, 226}:  -40,  // W acircumflex
+	{87, 227}:  -40,  // W atilde
+	{87, 228}:  -40,  // W adieresis
+	{87, 229}:  -40,  // W aring
+	{87, 232}:  -30,  // W egrave
+	{87, 233}:  -30,  // W eacute
+	{87, 234}:  -30,  // W ecircumflex
+	{87, 235}:  -30,  // W edieresis
+	{87, 242}:  -30,  // W ograve
+	{87, 243}:  -30,  // W oacute
+	{87, 244}:  -30,  // W ocircumflex
+	{87, 245}:  -30,  // W otilde
+	{87, 246}:  -30,  // W odieresis
+	{87, 248}:  -30,  // W oslash
+	{87, 249}:  -30,  // W ugrave
+	{87, 250}:  -30,  // W uacute
+	{87, 251}:  -30,  // W ucircumflex
+	{87, 252}:  -30,  // W udieresis
+	{87, 253}:  -20,  // W yacute
+	{87, 255}:  -20,  // W ydieresis
+	{89, 44}:   -140, // Y comma
+	{89, 45}:   -140, // Y hyphen
+	{89, 46}:   -140, // Y period
+	{89, 58}:   -60,  // Y colon
+	{89, 59}:   -60,  // Y semicolon
+	{89, 65}:   -110, // Y A
+	{89, 79}:   -85,  // Y O
+	{89, 97}:   -140, // Y a
+	{89, 101}:  -140, // Y e
+	{89, 105}:  -20,  // Y i
+	{89, 111}:  -140, // Y o
+	{89, 117}:  -110, // Y u
+	{89, 173}:  -140, // Y hyphen
+	{89, 192}:  -110, // Y Agrave
+	{89, 193}:  -110, // Y Aacute
+	{89, 194}:  -110, // Y Acircumflex
+	{89, 195}:  -110, // Y Atilde
+	{89, 196}:  -110, // Y Adieresis
+	{89, 197}:  -110, // Y Aring
+	{89, 210}:  -85,  // Y Ograve
+	{89, 211}:  -85,  // Y Oacute
+	{89, 212}:  -85,  // Y Ocircumflex
+	{89, 213}:  -85,  // Y Otilde
+	{89, 214}:  -85,  // Y Odieresis
+	{89, 216}:  -85,  // Y Oslash
+	{89, 224}:  -140, // Y agrave
+	{89, 225}:  -140, // Y aacute
+	{89, 226}:  -140, // Y acircumflex
+	{89, 227}:  -140, // Y atilde
+	{89, 228}:  -140, // Y adieresis
+	{89, 229}:  -140, // Y aring
+	{89, 232}:  -140, // Y egrave
+	{89, 233}:  -140, // Y eacute
+	{89, 234}:  -140, // Y ecircumflex
+	{89, 235}:  -140, // Y edieresis
+	{89, 237}:  -20,  // Y iacute
+	{89, 242}:  -140, // Y ograve
+	{89, 243}:  -140, // Y oacute
+	{89, 244}:  -140, // Y ocircumflex
+	{89, 245}:  -140, // Y otilde
+	{89, 246}:  -140, // Y odieresis
+	{89, 248}:  -140, // Y oslash
+	{89, 249}:  -110, // Y ugrave
+	{89, 250}:  -110, // Y uacute
+	{89, 251}:  -110, // Y ucircumflex
+	{89, 252}:  -110, // Y udieresis
+	{97, 118}:  -20,  // a v
+	{97, 119}:  -20,  // a w
+	{97, 121}:  -30,  // a y
+	{97, 253}:  -30,  // a yacute
+	{97, 255}:  -30,  // a ydieresis
+	{98, 44}:   -40,  // b comma
+	{98, 46}:   -40,  // b period
+	{98, 98}:   -10,  // b b
+	{98, 108}:  -20,  // b l
+	{98, 117}:  -20,  // b u
+	{98, 118}:  -20,  // b v
+	{98, 121}:  -20,  // b y
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{98, 253}:  -20,  // b yacute
+	{98, 255}:  -20,  // b ydieresis
+	{99, 44}:   -15,  // c comma
+	{99, 107}:  -20,  // c k
+	{101, 44}:  -15,  // e comma
+	{101, 46}:  -15,  // e period
+	{101, 118}: -30,  // e v
+	{101, 119}: -20,  // e w
+	{101, 120}: -30,  // e x
+	{101, 121}: -20,  // e y
+	{101, 253}: -20,  // e yacute
+	{101, 255}: -20,  // e ydieresis
+	{102, 44}:  -30,  // f comma
+	{102, 46}:  -30,  // f period
+	{102, 97}:  -30,  // f a
+	{102, 101}: -30,  // f e
+	{102, 111}: -30,  // f o
+	{102, 146}: 50,   // f quoteright
+	{102, 148}: 60,   // f quotedblright
+	{102, 224}: -30,  // f agrave
+	{102, 225}: -30,  // f aacute
+	{102, 226}: -30,  // f acircumflex
+	{102, 227}: -30,  // f atilde
+	{102, 228}: -30,  // f adieresis
+	{102, 229}: -30,  // f aring
+	{102, 232}: -30,  // f egrave
+	{102, 233}: -30,  // f eacute
+	{102, 234}: -30,  // f ecircumflex
+	{102, 235}: -30,  // f edieresis
+	{102, 242}: -30,  // f ograve
+	{102, 243}: -30,  // f oacute
+	{102, 244}: -30,  // f ocircumflex
+	{102, 245}: -30,  // f otilde
+	{102, 246}: -30,  // f odieresis
+	{102, 248}: -30,  // f oslash
+	{103, 114}: -10,  // g r
+	{104, 121}: -30,  // h y
+	{104, 253}: -30,  // h yacute
+	{104, 255}: -30,  // h ydieresis
+	{107, 101}: -20,  // k e
+	{107, 111}: -20,  // k o
+	{107, 232}: -20,  // k egrave
+	{107, 233}: -20,  // k eacute
+	{107, 234}: -20,  // k ecircumflex
+	{107, 235}: -20,  // k edieresis
+	{107, 242}: -20,  // k ograve
+	{107, 243}: -20,  // k oacute
+	{107, 244}: -20,  // k ocircumflex
+	{107, 245}: -20,  // k otilde
+	{107, 246}: -20,  // k odieresis
+	{107, 248}: -20,  // k oslash
+	{109, 117}: -10,  // m u
+	{109, 121}: -15,  // m y
+	{109, 249}: -10,  // m ugrave
+	{109, 250}: -10,  // m uacute
+	{109, 251}: -10,  // m ucircumflex
+	{109, 252}: -10,  // m udieresis
+	{109, 253}: -15,  // m yacute
+	{109, 255}: -15,  // m ydieresis
+	{110, 117}: -10,  // n u
+	{110, 118}: -20,  // n v
+	{110, 121}: -15,  // n y
+	{110, 249}: -10,  // n ugrave
+	{110, 250}: -10,  // n uacute
+	{110, 251}: -10,  // n ucircumflex
+	{110, 252}: -10,  // n udieresis
+	{110, 253}: -15,  // n yacute
+	{110, 255}: -15,  // n ydieresis
+	{111, 44}:  -40,  // o comma
+	{111, 46}:  -40,  // o period
+	{111, 118}: -15,  // o v
+	{111, 119}: -15,  // o w
+	{111, 120}: -30,  // o x
+	{111, 121}: -30,  // o y
+	{111, 253}: -30,  // o yacute
+	{111, 255}: -30,  // o ydieresis
+	{112, 44}:  -35,  // p comma
+	{112, 46}:  -35,  // p period
+	{112, 121}: -30,  // p y
+	{112, 253}: -30,  // p yacute
+	{112, 255}: -30,  // p ydieresis
+	{114, 44}:  -50,  // r comma
+	{114, 46}:  -50,  // r period
+	{114, 58}:  30,   // r colon
+	{114, 59}:  30,   // r semicolon
+	{114, 97}:  -10,  // r a
+	{114, 105}: 15,   // r i
+	{114, 107}: 15,   // r k
+	{114, 108}: 15,   // r l
+	{114, 109}: 25,   // r m
+	{114, 110}: 25,   // r n
+	{114, 112}: 30,   // r p
+	{114, 116}: 40,   // r t
+	{114, 117}: 15,   // r u
+	{114, 118}: 30,   // r v
+	{114, 121}: 30,   // r y
+	{114, 224}: -10,  // r agrave
+	{114, 225}: -10,  // r aacute
+	{114, 226}: -10,  // r acircumflex
+	{114, 227}: -10,  // r atilde
+	{114, 228}: -10,  // r adieresis
+	{114, 229}: -10,  // r aring
+	{114, 236}: 15,   // r igrave
+	{114, 237}: 15,   // r iacute
+	{114, 238}: 15,   // r icircumflex
+	{114, 239}: 15,   // r idieresis
+	{114, 241}: 25,   // r ntilde
+	{114, 249}: 15,   // r ugrave
+	{114, 250}: 15,   // r uacute
+	{114, 251}: 15,   // r ucircumflex
+	{114, 252}: 15,   // r udieresis
+	{114, 253}: 30,   // r yacute
+	{114, 255}: 30,   // r ydieresis
+	{115, 44}:  -15,  // s comma
+	{115, 46}:  -15,  // s period
+	{115, 119}: -30,  // s w
+	{118, 44}:  -80,  // v comma
+	{118, 46}:  -80,  // v period
+	{118, 97}:  -25,  // v a
+	{118, 101}: -25,  // v e
+	{118, 111}: -25,  // v o
+	{118, 224}: -25,  // v agrave
+	{118, 225}: -25,  // v aacute
+	{118, 226}: -25,  // v acircumflex
+	{118, 227}: -25,  // v atilde
+	{118, 228}: -25,  // v adieresis
+	{118, 229}: -25,  // v aring
+	{118, 232}: -25,  // v egrave
+	{118, 233}: -25,  // v eacute
+	{118, 234}: -25,  // v ecircumflex
+	{118, 235}: -25,  // v edieresis
+	{118, 242}: -25,  // v ograve
+	{118, 243}: -25,  // v oacute
+	{118, 244}: -25,  // v ocircumflex
+	{118, 245}: -25,  // v otilde
+	{118, 246}: -25,  // v odieresis
+	{118, 248}: -25,  // v oslash
+	{119, 44}:  -60,  // w comma
+	{119, 46}:  -60,  // w period
+	{119, 97}:  -15,  // w a
+	{119, 101}: -10,  // w e
+	{119, 111}: -10,  // w o
+	{119, 224}: -15,  // w agrave
+	{119, 225}: -15,  // w aacute
+	{119, 226}: -15,  // w acircumflex
+	{119, 227}: -15,  // w atilde
+	{119, 228}: -15,  // w adieresis
+	{119, 229}: -15,  // w aring
+	{119, 232}: -10,  // w egrave
+	{119, 233}: -10,  // w eacute
+	{119, 234}: -10,  // w ecircumflex
+	{119, 235}: -10,  // w edieresis
+	{119, 242}: -10,  // w ograve
+	{119, 243}: -10,  // w oacute
+	{119, 244}: -10,  // w ocircumflex
+	{119, 245}: -10,  // w otilde
+	{119, 246}: -10,  // w odieresis
+	{119, 248}: -10,  // w oslash
+	{120, 101}: -30,  // x e
+	{120, 232}: -30,  // x egrave
+	{120, 233}: -30,  // x eacute
+	{120, 234}: -30,  // x ecircumflex
+	{120, 235}: -30,  // x edieresis
+	{121, 44}:  -100, // y comma
+	{121, 46}:  -100, // y period
+	{121, 97}:  -20,  // y a
+	{121, 101}: -20,  // y e
+	{121, 111}: -20,  // y o
+	{121, 224}: -20,  // y agrave
+	{121, 225}: -20,  // y aacute
+	{121, 226}: -20,  // y acircumflex
+	{121, 227}: -20,  // y atilde
+	{121, 228}: -20,  // y adieresis
+	{121, 229}: -20,  // y aring
+	{121, 232}: -20,  // y egrave
+	{121, 233}: -20,  // y eacute
+	{121, 234}: -20,  // y ecircumflex
+	{121, 235}: -20,  // y edieresis
+	{121, 242}: -20,  // y ograve
+	{121, 243}: -20,  // y oacute
+	{121, 244}: -20,  // y ocircumflex
+	{121, 245}: -20,  // y otilde
+	{121, 246}: -20,  // y odieresis
+	{121, 248}: -20,  // y oslash
+	{122, 101}: -15,  // z e
+	{122, 111}: -15,  // z o
+	{122, 232}: -15,  // z egrave
+	{122, 233}: -15,  // z eacute
+	{122, 234}: -15,  // z ecircumflex
+	{122, 235}: -15,  // z edieresis
+	{122, 242}: -15,  // z ograve
+	{122, 243}: -15,  // z oacute
+	{122, 244}: -15,  // z ocircumflex
+	{122, 245}: -15,  // z otilde
+	{122, 246}: -15,  // z odieresis
+	{122, 248}: -15,  // z oslash
+	{138, 44}:  -20,  // Scaron comma
+	{138, 46}:  -20,  // Scaron period
+	{145, 145}: -57,  // quoteleft quoteleft
+	{146, 32}:  -70,  // quoteright space
+	{146, 100}: -50,  // quoteright d
+	{146, 114}: -50,  // quoteright r
+	{146, 115}: -50,  // quoteright s
+	{146, 146}: -57,  // quoteright quoteright
+	{146, 154}: -50,  // quoteright scaron
+	{146, 160}: -70,  // quoteright space
+	{148, 32}:  -40,  // quotedblright space
+	{148, 160}: -40,  // quotedblright space
+	{154, 44}:  -15,  // scaron comma
+	{154, 46}:  -15,  // scaron period
+	{154, 119}: -30,  // scaron w
+	{158, 101}: -15,  // zcaron e
+	{158, 111}: -15,  // zcaron o
+	{158, 232}: -15,  // zcaron egrave
+	{158, 233}: -15,  // zcaron eacute
+	{158, 234}: -15,  // zcaron ecircumflex
+	{158, 235}: -15,  // zcaron edieresis
+	{158, 242}: -15,  // zcaron ograve
+	{158, 243}: -15,  // zcaron oacute
+	{158, 244}: -15,  // zcaron ocircumflex
+	{158, 245}: -15,  // zcaron otilde
+	{158, 246}: -15,  // zcaron odieresis
+	{158, 248}: -15,  // zcaron oslash
+	{159, 44}:  -140, // Ydieresis comma
+	{159, 45}:  -140, // Ydieresis hyphen
+	{159, 46}:  -140, // Ydieresis period
+	{159, 58}:  -60,  // Ydieresis colon
+	{159, 59}:  -60,  // Ydieresis semicolon
+	{159, 65}:  -110, // Ydieresis A
+	{159, 79}:  -85,  // Ydieresis O
+	{159, 97}:  -140, // Ydieresis a
+	{159, 101}: -140, // Ydieresis e
+	{159, 105}: -20,  // Ydieresis i
+	{159, 111}: -140, // Ydieresis o
+	{159, 117}: -110, // Ydieresis u
+	{159, 173}: -140, // Ydieresis hyphen
+	{159, 192}: -110, // Ydieresis Agrave
+	{159, 193}: -110, // Ydieresis Aacute
+	{159, 194}: -110, // Ydieresis Acircumflex
+	{159, 195}: -110, // Ydieresis Atilde
+	{159, 196}: -110, // Ydieresis Adieresis
+	{159, 197}: -110, // Ydieresis Aring
+	{159, 210}: -85,  // Ydieresis Ograve
+	{159, 211}: -85,  // Ydieresis Oacute
+	{159, 212}: -85,  // Ydieresis Ocircumflex
+	{159, 213}: -85,  // Ydieresis Otilde
+	{159, 214}: -85,  // Ydieresis Odieresis
+	{159, 216}: -85,  // Ydieresis Oslash
+	{159, 224}: -140, // Ydieresis agrave
+	{159, 225}: -140, // Ydieresis aacute
+	{159, 226}: -140, // Ydieresis acircumflex
+	{159, 227}: -70,  // Ydieresis atilde
+	{159, 228}: -140, // Ydieresis adieresis
+	{159, 229}: -140, // Ydieresis aring
+	{159, 232}: -140, // Ydieresis egrave
+	{159, 233}: -140, // Ydieresis eacute
+	{159, 234}: -140, // Ydieresis ecircumflex
+	{159, 235}: -140, // Ydieresis edieresis
+	{159, 237}: -20,  // Ydieresis iacute
+	{159, 242}: -140, // Ydieresis ograve
+	{159, 243}: -140, // Ydieresis oacute
+	{159, 244}: -140, // Ydieresis ocircumflex
+	{159, 245}: -140, // Ydieresis otilde
+	{159, 246}: -140, // Ydieresis odieresis
+	{159, 248}: -140, // Ydieresis oslash
+	{159, 249}: -110, // Ydieresis ugrave
+	{159, 250}: -110, // Ydieresis uacute
+	{159, 251}: -110, // Ydieresis ucircumflex
+	{159, 252}: -110, // Ydieresis udieresis
+	{160, 84}:  -50,  // space T
+	{160, 86}:  -50,  // space V
+	{160, 87}:  -40,  // space W
+	{160, 89}:  -90,  // space Y
+	{160, 145}: -60,  // space quoteleft
+	{160, 147}: -30,  // space quotedblleft
+	{160, 159}: -90,  // space Ydieresis
+	{160, 221}: -90,  // space Yacute
+	{192, 67}:  -30,  // Agrave C
+	{192, 71}:  -30,  // Agrave G
+	{192, 79}:  -30,  // Agrave O
+	{192, 81}:  -30,  // Agrave Q
+	{192, 84}:  -120, // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -70,  // Agrave V
+	{192, 87}:  -50,  // Agrave W
+	{192, 89}:  -100, // Agrave Y
+	{192, 117}: -30,  // Agrave u
+	{192, 118}: -40,  // Agrave v
+	{192, 119}: -40,  // Agrave w
+	{192, 121}: -40,  // Agrave y
+	{192, 159}: -100, // Agrave Ydieresis
+	{192, 199}: -30,  // Agrave Ccedilla
+	{192, 210}: -30,  // Agrave Ograve
+	{192, 211}: -30,  // Agrave Oacute
+	{192, 212}: -30,  // Agrave Ocircumflex
+	{192, 213}: -30,  // Agrave Otilde
+	{192, 214}: -30,  // Agrave Odieresis
+	{192, 216}: -30,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -100, // Agrave Yacute
+	{192, 249}: -30,  // Agrave ugrave
+	{192, 250}: -30,  // Agrave uacute
+	{192, 251}: -30,  // Agrave ucircumflex
+	{192, 252}: -30,  // Agrave udieresis
+	{192, 253}: -40,  // Agrave yacute
+	{192, 255}: -40,  // Agrave ydieresis
+	{193, 67}:  -30,  // Aacute C
+	{193, 71}:  -30,  // Aacute G
+	{193, 79}:  -30,  // Aacute O
+	{193, 81}:  -30,  // Aacute Q
+	{193, 84}:  -120, // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -70,  // Aacute V
+	{193, 87}:  -50,  // Aacute W
+	{193, 89}:  -100, // Aacute Y
+	{193, 117}: -30,  // Aacute u
+	{193, 118}: -40,  // Aacute v
+	{193, 119}: -40,  // Aacute w
+	{193, 121}: -40,  // Aacute y
+	{193, 159}: -100, // Aacute Ydieresis
+	{193, 199}: -30,  // Aacute Ccedilla
+	{193, 210}: -30,  // Aacute Ograve
+	{193, 211}: -30,  // Aacute Oacute
+	{193, 212}: -30,  // Aacute Ocircumflex
+	{193, 213}: -30,  // Aacute Otilde
+	{193, 214}: -30,  // Aacute Odieresis
+	{193, 216}: -30,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -100, // Aacute Yacute
+	{193, 249}: -30,  // Aacute ugrave
+	{193, 250}: -30,  // Aacute uacute
+	{193, 251}: -30,  // Aacute ucircumflex
+	{193, 252}: -30,  // Aacute udieresis
+	{193, 253}: -40,  // Aacute yacute
+	{193, 255}: -40,  // Aacute ydieresis
+	{194, 67}:  -30,  // Acircumflex C
+	{194, 71}:  -30,  // Acircumflex G
+	{194, 79}:  -30,  // Acircumflex O
+	{194, 81}:  -30,  // Acircumflex Q
+	{194, 84}:  -120, // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -70,  // Acircumflex V
+	{194, 87}:  -50,  // Acircumflex W
+	{194, 89}:  -100, // Acircumflex Y
+	{194, 117}: -30,  // Acircumflex u
+	{194, 118}: -40,  // Acircumflex v
+	{194, 119}: -40,  // Acircumflex w
+	{194, 121}: -40,  // Acircumflex y
+	{194, 159}: -100, // Acircumflex Ydieresis
+	{194, 199}: -30,  // Acircumflex Ccedilla
+	{194, 210}: -30,  // Acircumflex Ograve
+	{194, 211}: -30,  // Acircumflex Oacute
+	{194, 212}: -30,  // Acircumflex Ocircumflex
+	{194, 213}: -30,  // Acircumflex Otilde
+	{194, 214}: -30,  // Acircumflex Odieresis
+	{194, 216}: -30,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -100, // Acircumflex Yacute
+	{194, 249}: -30,  // Acircumflex ugrave
+	{194, 250}: -30,  // Acircumflex uacute
+	{194, 251}: -30,  // Acircumflex ucircumflex
+	{194, 252}: -30,  // Acircumflex udieresis
+	{194, 253}: -40,  // Acircumflex yacute
+	{194, 255}: -40,  // Acircumflex ydieresis
+	{195, 67}:  -30,  // Atilde C
+	{195, 71}:  -30,  // Atilde G
+	{195, 79}:  -30,  // Atilde O
+	{195, 81}:  -30,  // Atilde Q
+	{195, 84}:  -120, // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -70,  // Atilde V
+	{195, 87}:  -50,  // Atilde W
+	{195, 89}:  -100, // Atilde Y
+	{195, 117}: -30,  // Atilde u
+	{195, 118}: -40,  // Atilde v
+	{195, 119}: -40,  // Atilde w
+	{195, 121}: -40,  // Atilde y
+	{195, 159}: -100, // Atilde Ydieresis
+	{195, 199}: -30,  // Atilde Ccedilla
+	{195, 210}: -30,  // Atilde Ograve
+	{195, 211}: -30,  // Atilde Oacute
+	{195, 212}: -30,  // Atilde Ocircumflex
+	{195, 213}: -30,  // Atilde Otilde
+	{195, 214}: -30,  // Atilde Odieresis
+	{195, 216}: -30,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -100, // Atilde Yacute
+	{195, 249}: -30,  // Atilde ugrave
+	{195, 250}: -30,  // Atilde uacute
+	{195, 251}: -30,  // Atilde ucircumflex
+	{195, 252}: -30,  // Atilde udieresis
+	{195, 253}: -40,  // Atilde yacute
+	{195, 255}: -40,  // Atilde ydieresis
+	{196, 67}:  -30,  // Adieresis C
+	{196, 71}:  -30,  // Adieresis G
+	{196, 79}:  -30,  // Adieresis O
+	{196, 81}:  -30,  // Adieresis Q
+	{196, 84}:  -120, // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -70,  // Adieresis V
+	{196, 87}:  -50,  // Adieresis W
+	{196, 89}:  -100, // Adieresis Y
+	{196, 117}: -30,  // Adieresis u
+	{196, 118}: -40,  // Adieresis v
+	{196, 119}: -40,  // Adieresis w
+	{196, 121}: -40,  // Adieresis y
+	{196, 159}: -100, // Adieresis Ydieresis
+	{196, 199}: -30,  // Adieresis Ccedilla
+	{196, 210}: -30,  // Adieresis Ograve
+	{196, 211}: -30,  // Adieresis Oacute
+	{196, 212}: -30,  // Adieresis Ocircumflex
+	{196, 213}: -30,  // Adieresis Otilde
+	{196, 214}: -30,  // Adieresis Odieresis
+	{196, 216}: -30,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -100, // Adieresis Yacute
+	{196, 249}: -30,  // Adieresis ugrave
+	{196, 250}: -30,  // Adieresis uacute
+	{196, 251}: -30,  // Adieresis ucircumflex
+	{196, 252}: -30,  // Adieresis udieresis
+	{196, 253}: -40,  // Adieresis yacute
+	{196, 255}: -40,  // Adieresis ydieresis
+	{197, 67}:  -30,  // Aring C
+	{197, 71}:  -30,  // Aring G
+	{197, 79}:  -30,  // Aring O
+	{197, 81}:  -30,  // Aring Q
+	{197, 84}:  -120, // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -70,  // Aring V
+	{197, 87}:  -50,  // Aring W
+	{197, 89}:  -100, // Aring Y
+	{197, 117}: -30,  // Aring u
+	{197, 118}: -40,  // Aring v
+	{197, 119}: -40,  // Aring w
+	{197, 121}: -40,  // Aring y
+	{197, 159}: -100, // Aring Ydieresis
+	{197, 199}: -30,  // Aring Ccedilla
+	{197, 210}: -30,  // Aring Ograve
+	{197, 211}: -30,  // Aring Oacute
+	{197, 212}: -30,  // Aring Ocircumflex
+	{197, 213}: -30,  // Aring Otilde
+	{197, 214}: -30,  // Aring Odieresis
+	{197, 216}: -30,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -100, // Aring Yacute
+	{197, 249}: -30,  // Aring ugrave
+	{197, 250}: -30,  // Aring uacute
+	{197, 251}: -30,  // Aring ucircumflex
+	{197, 252}: -30,  // Aring udieresis
+	{197, 253}: -40,  // Aring yacute
+	{197, 255}: -40,  // Aring ydieresis
+	{199, 44}:  -30,  // Ccedilla comma
+	{199, 46}:  -30,  // Ccedilla period
+	{210, 44}:  -40,  // Ograve comma
+	{210, 46}:  -40,  // Ograve period
+	{210, 65}:  -20,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -30,  // Ograve W
+	{210, 88}:  -60,  // Ograve X
+	{210, 89}:  -70,  // Ograve Y
+	{210, 159}: -70,  // Ograve Ydieresis
+	{210, 192}: -20,  // Ograve Agrave
+	{210, 193}: -20,  // Ograve Aacute
+	{210, 194}: -20,  // Ograve Acircumflex
+	{210, 195}: -20,  // Ograve Atilde
+	{210, 196}: -20,  // Ograve Adieresis
+	{210, 197}: -20,  // Ograve Aring
+	{210, 221}: -70,  // Ograve Yacute
+	{211, 44}:  -40,  // Oacute comma
+	{211, 46}:  -40,  // Oacute period
+	{211, 65}:  -20,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -30,  // Oacute W
+	{211, 88}:  -60,  // Oacute X
+	{211, 89}:  -70,  // Oacute Y
+	{211, 159}: -70,  // Oacute Ydieresis
+	{211, 192}: -20,  // Oacute Agrave
+	{211, 193}: -20,  // Oacute Aacute
+	{211, 194}: -20,  // Oacute Acircumflex
+	{211, 195}: -20,  // Oacute Atilde
+	{211, 196}: -20,  // Oacute Adieresis
+	{211, 197}: -20,  // Oacute Aring
+	{211, 221}: -70,  // Oacute Yacute
+	{212, 44}:  -40,  // Ocircumflex comma
+	{212, 46}:  -40,  // Ocircumflex period
+	{212, 65}:  -20,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -30,  // Ocircumflex W
+	{212, 88}:  -60,  // Ocircumflex X
+	{212, 89}:  -70,  // Ocircumflex Y
+	{212, 159}: -70,  // Ocircumflex Ydieresis
+	{212, 192}: -20,  // Ocircumflex Agrave
+	{212, 193}: -20,  // Ocircumflex Aacute
+	{212, 194}: -20,  // Ocircumflex Acircumflex
+	{212, 195}: -20,  // Ocircumflex Atilde
+	{212, 196}: -20,  // Ocircumflex Adieresis
+	{212, 197}: -20,  // Ocircumflex Aring
+	{212, 221}: -70,  // Ocircumflex Yacute
+	{213, 44}:  -40,  // Otilde comma
+	{213, 46}:  -40,  // Otilde period
+	{213, 65}:  -20,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -30,  // Otilde W
+	{213, 88}:  -60,  // Otilde X
+	{213, 89}:  -70,  // Otilde Y
+	{213, 159}: -70,  // Otilde Ydieresis
+	{213, 192}: -20,  // Otilde Agrave
+	{213, 193}: -20,  // Otilde Aacute
+	{213, 194}: -20,  // Otilde Acircumflex
+	{213, 195}: -20,  // Otilde Atilde
+	{213, 196}: -20,  // Otilde Adieresis
+	{213, 197}: -20,  // Otilde Aring
+	{213, 221}: -70,  // Otilde Yacute
+	{214, 44}:  -40,  // Odieresis comma
+	{214, 46}:  -40,  // Odieresis period
+	{214, 65}:  -20,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -30,  // Odieresis W
+	{214, 88}:  -60,  // Odieresis X
+	{214, 89}:  -70,  // Odieresis Y
+	{214, 159}: -70,  // Odieresis Ydieresis
+	{214, 192}: -20,  // Odieresis Agrave
+	{214, 193}: -20,  // Odieresis Aacute
+	{214, 194}: -20,  // Odieresis Acircumflex
+	{214, 195}: -20,  // Odieresis Atilde
+	{214, 196}: -20,  // Odieresis Adieresis
+	{214, 197}: -20,  // Odieresis Aring
+	{214, 221}: -70,  // Odieresis Yacute
+	{216, 44}:  -40,  // Oslash comma
+	{216, 46}:  -40,  // Oslash period
+	{216, 65}:  -20,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -30,  // Oslash W
+	{216, 88}:  -60,  // Oslash X
+	{216, 89}:  -70,  // Oslash Y
+	{216, 159}: -70,  // Oslash Ydieresis
+	{216, 192}: -20,  // Oslash Agrave
+	{216, 193}: -20,  // Oslash Aacute
+	{216, 194}: -20,  // Oslash Acircumflex
+	{216, 195}: -20,  // Oslash Atilde
+	{216, 196}: -20,  // Oslash Adieresis
+	{216, 197}: -20,  // Oslash Aring
+	{216, 221}: -70,  // Oslash Yacute
+	{217, 44}:  -40,  // Ugrave comma
+	{217, 46}:  -40,  // Ugrave period
+	{217, 65}:  -40,  // Ugrave A
+	{217, 192}: -40,  // Ugrave Agrave
+	{217, 193}: -40,  // Ugrave Aacute
+	{217, 194}: -40,  // Ugrave Acircumflex
+	{217, 195}: -40,  // Ugrave Atilde
+	{217, 196}: -40,  // Ugrave Adieresis
+	{217, 197}: -40,  // Ugrave Aring
+	{218, 44}:  -40,  // Uacute comma
+	{218, 46}:  -40,  // Uacute period
+	{218, 65}:  -40,  // Uacute A
+	{218, 192}: -40,  // Uacute Agrave
+	{218, 193}: -40,  // Uacute Aacute
+	{218, 194}: -40,  // Uacute Acircumflex
+	{218, 195}: -40,  // Uacute Atilde
+	{218, 196}: -40,  // Uacute Adieresis
+	{218, 197}: -40,  // Uacute Aring
+	{219, 44}:  -40,  // Ucircumflex comma
+	{219, 46}:  -40,  // Ucircumflex period
+	{219, 65}:  -40,  // Ucircumflex A
+	{219, 192}: -40,  // Ucircumflex Agrave
+	{219, 193}: -40,  // Ucircumflex Aacute
+	{219, 194}: -40,  // Ucircumflex Acircumflex
+	{219, 195}: -40,  // Ucircumflex Atilde
+	{219, 196}: -40,  // Ucircumflex Adieresis
+	{219, 197}: -40,  // Ucircumflex Aring
+	{220, 44}:  -40,  // Udieresis comma
+	{220, 46}:  -40,  // Udieresis period
+	{220, 65}:  -40,  // Udieresis A
+	{220, 192}: -40,  // Udieresis Agrave
+	{220, 193}: -40,  // Udieresis Aacute
+	{220, 194}: -40,  // Udieresis Acircumflex
+	{220, 195}: -40,  // Udieresis Atilde
+	{220, 196}: -40,  // Udieresis Adieresis
+	{220, 197}: -40,  // Udieresis Aring
+	{221, 44}:  -140, // Yacute comma
+	{221, 45}:  -140, // Yacute hyphen
+	{221, 46}:  -140, // Yacute period
+	{221, 58}:  -60,  // Yacute colon
+	{221, 59}:  -60,  // Yacute semicolon
+	{221, 65}:  -110, // Yacute A
+	{221, 79}:  -85,  // Yacute O
+	{221, 97}:  -140, // Yacute a
+	{221, 101}: -140, // Yacute e
+	{221, 105}: -20,  // Yacute i
+	{221, 111}: -140, // Yacute o
+	{221, 117}: -110, // Yacute u
+	{221, 173}: -140, // Yacute hyphen
+	{221, 192}: -110, // Yacute Agrave
+	{221, 193}: -110, // Yacute Aacute
+	{221, 194}: -110, // Yacute Acircumflex
+	{221, 195}: -110, // Yacute Atilde
+	{221, 196}: -110, // Yacute Adieresis
+	{221, 197}: -110, // Yacute Aring
+	{221, 210}: -85,  // Yacute Ograve
+	{221, 211}: -85,  // Yacute Oacute
+	{221, 212}: -85,  // Yacute Ocircumflex
+	{221, 213}: -85,  // Yacute Otilde
+	{221, 214}: -85,  // Yacute Odieresis
+	{221, 216}: -85,  // Yacute Oslash
+	{221, 224}: -140, // Yacute agrave
+	{221, 225}: -140, // Yacute aacute
+	{221, 226}: -140, // Yacute acircumflex
+	{221, 227}: -70,  // Yacute atilde
+	{221, 228}: -140, // Yacute adieresis
+	{221, 229}: -140, // Yacute aring
+	{221, 232}: -140, // Yacute egrave
+	{221, 233}: -140, // Yacute eacute
+	{221, 234}: -140, // Yacute ecircumflex
+	{221, 235}: -140, // Yacute edieresis
+	{221, 237}: -20,  // Yacute iacute
+	{221, 242}: -140, // Yacute ograve
+	{221, 243}: -140, // Yacute oacute
+	{221, 244}: -140, // Yacute ocircumflex
+	{221, 245}: -140, // Yacute otilde
+	{221, 246}: -140, // Yacute odieresis
+	{221, 248}: -140, // Yacute oslash
+	{221, 249}: -110, // Yacute ugrave
+	{221, 250}: -110, // Yacute uacute
+	{221, 251}: -110, // Yacute ucircumflex
+	{221, 252}: -110, // Yacute udieresis
+	{224, 118}: -20,  // agrave v
+	{224, 119}: -20,  // agrave w
+	{224, 121}: -30,  // agrave y
+	{224, 253}: -30,  // agrave yacute
+	{224, 255}: -30,  // agrave ydieresis
+	{225, 118}: -20,  // aacute v
+	{225, 119}: -20,  // aacute w
+	{225, 121}: -30,  // aacute y
+	{225, 253}: -30,  // aacute yacute
+	{225, 255}: -30,  // aacute ydieresis
+	{226, 118}: -20,  // acircumflex v
+	{226, 119}: -20,  // acircumflex w
+	{226, 121}: -30,  // acircumflex y
+	{226, 253}: -30,  // acircumflex yacute
+	{226, 255}: -30,  // acircumflex ydieresis
+	{227, 118}: -20,  // atilde v
+	{227, 119}: -20,  // atilde w
+	{227, 121}: -30,  // atilde y
+	{227, 253}: -30,  // atilde yacute
+	{227, 255}: -30,  // atilde ydieresis
+	{228, 118}: -20,  // adieresis v
+	{228, 119}: -20,  // adieresis w
+	{228, 121}: -30,  // adieresis y
+	{228, 253}: -30,  // adieresis yacute
+	{228, 255}: -30,  // adieresis ydieresis
+	{229, 118}: -20,  // aring v
+	{229, 119}: -20,  // aring w
+	{229, 121}: -30,  // aring y
+	{229, 253}: -30,  // aring yacute
+	{229, 255}: -30,  // aring ydieresis
+	{231, 44}:  -15,  // ccedilla comma
+	{231, 107}: -20,  // ccedilla k
+	{232, 44}:  -15,  // egrave comma
+	{232, 46}:  -15,  // egrave period
+	{232, 118}: -30,  // egrave v
+	{232, 119}: -20,  // egrave w
+	{232, 120}: -30,  // egrave x
+	{232, 121}: -20,  // egrave y
+	{232, 253}: -20,  // egrave yacute
+	{232, 255}: -20,  // egrave ydieresis
+	{233, 44}:  -15,  // eacute comma
+	{233, 46}:  -15,  // eacute period
+	{233, 118}: -30,  // eacute v
+	{233, 119}: -20,  // eacute w
+	{233, 120}: -30,  // eacute x
+	{233, 121}: -20,  // eacute y
+	{233, 253}: -20,  // eacute yacute
+	{233, 255}: -20,  // eacute ydieresis
+	{234, 44}:  -15,  // ecircumflex comma
+	{234, 46}:  -15,  // ecircumflex period
+	{234, 118}: -30,  // ecircumflex v
+	{234, 119}: -20,  // ecircumflex w
+	{234, 120}: -30,  // ecircumflex x
+	{234, 121}: -20,  // ecircumflex y
+	{234, 253}: -20,  // ecircumflex yacute
+	{234, 255}: -20,  // ecircumflex ydieresis
+	{235, 44}:  -15,  // edieresis comma
+	{235, 46}:  -15,  // edieresis period
+	{235, 118}: -30,  // edieresis v
+	{235, 119}: -20,  // edieresis w
+	{235, 120}: -30,  // edieresis x
+	{235, 121}: -20,  // edieresis y
+	{235, 253}: -20,  // edieresis yacute
+	{235, 255}: -20,  // edieresis ydieresis
+	{241, 117}: -10,  // ntilde u
+	{241, 118}: -20,  // ntilde v
+	{241, 121}: -15,  // ntilde y
+	{241, 249}: -10,  // ntilde ugrave
+	{241, 250}: -10,  // ntilde uacute
+	{241, 251}: -10,  // ntilde ucircumflex
+	{241, 252}: -10,  // ntilde udieresis
+	{241, 253}: -15,  // ntilde yacute
+	{241, 255}: -15,  // ntilde ydieresis
+	{242, 44}:  -40,  // ograve comma
+	{242, 46}:  -40,  // ograve period
+	{242, 118}: -15,  // ograve v
+	{242, 119}: -15,  // ograve w
+	{242, 120}: -30,  // ograve x
+	{242, 121}: -30,  // ograve y
+	{242, 253}: -30,  // ograve yacute
+	{242, 255}: -30,  // ograve ydieresis
+	{243, 44}:  -40,  // oacute comma
+	{243, 46}:  -40,  // oacute period
+	{243, 118}: -15,  // oacute v
+	{243, 119}: -15,  // oacute w
+	{243, 120}: -30,  // oacute x
+	{243, 121}: -30,  // oacute y
+	{243, 253}: -30,  // oacute yacute
+	{243, 255}: -30,  // oacute ydieresis
+	{244, 44}:  -40,  // ocircumflex comma
+	{244, 46}:  -40,  // ocircumflex period
+	{244, 118}: -15,  // ocircumflex v
+	{244, 119}: -15,  // ocircumflex w
+	{244, 120}: -30,  // ocircumflex x
+	{244, 121}: -30,  // ocircumflex y
+	{244, 253}: -30,  // ocircumflex yacute
+	{244, 255}: -30,  // ocircumflex ydieresis
+	{245, 44}:  -40,  // otilde comma
+	{245, 46}:  -40,  // otilde period
+	{245, 118}: -15,  // otilde v
+	{245, 119}: -15,  // otilde w
+	{245, 120}: -30,  // otilde x
+	{245, 121}: -30,  // otilde y
+	{245, 253}: -30,  // otilde yacute
+	{245, 255}: -30,  // otilde ydieresis
+	{246, 44}:  -40,  // odieresis comma
+	{246, 46}:  -40,  // odieresis period
+	{246, 118}: -15,  // odieresis v
+	{246, 119}: -15,  // odieresis w
+	{246, 120}: -30,  // odieresis x
+	{246, 121}: -30,  // odieresis y
+	{246, 253}: -30,  // odieresis yacute
+	{246, 255}: -30,  // odieresis ydieresis
+	{248, 44}:  -95,  // oslash comma
+	{248, 46}:  -95,  // oslash period
+	{248, 97}:  -55,  // oslash a
+	{248, 98}:  -55,  // oslash b
+	{248, 99}:  -55,  // oslash c
+	{248, 100}: -55,  // oslash d
+	{248, 101}: -55,  // oslash e
+	{248, 102}: -55,  // oslash f
+	{248, 103}: -55,  // oslash g
+	{248, 104}: -55,  // oslash h
+	{248, 105}: -55,  // oslash i
+	{248, 106}: -55,  // oslash j
+	{248, 107}: -55,  // oslash k
+	{248, 108}: -55,  // oslash l
+	{248, 109}: -55,  // oslash m
+	{248, 110}: -55,  // oslash n
+	{248, 111}: -55,  // oslash o
+	{248, 112}: -55,  // oslash p
+	{248, 113}: -55,  // oslash q
+	{248, 114}: -55,  // oslash r
+	{248, 115}: -55,  // oslash s
+	{248, 116}: -55,  // oslash t
+	{248, 117}: -55,  // oslash u
+	{248, 118}: -70,  // oslash v
+	{248, 119}: -70,  // oslash w
+	{248, 120}: -85,  // oslash x
+	{248, 121}: -70,  // oslash y
+	{248, 122}: -55,  // oslash z
+	{248, 154}: -55,  // oslash scaron
+	{248, 158}: -55,  // oslash zcaron
+	{248, 224}: -55,  // oslash agrave
+	{248, 225}: -55,  // oslash aacute
+	{248, 226}: -55,  // oslash acircumflex
+	{248, 227}: -55,  // oslash atilde
+	{248, 228}: -55,  // oslash adieresis
+	{248, 229}: -55,  // oslash aring
+	{248, 231}: -55,  // oslash ccedilla
+	{248, 232}: -55,  // oslash egrave
+	{248, 233}: -55,  // oslash eacute
+	{248, 234}: -55,  // oslash ecircumflex
+	{248, 235}: -55,  // oslash edieresis
+	{248, 236}: -55,  // oslash igrave
+	{248, 237}: -55,  // oslash iacute
+	{248, 238}: -55,  // oslash icircumflex
+	{248, 239}: -55,  // oslash idieresis
+	{248, 241}: -55,  // oslash ntilde
+	{248, 242}: -55,  // oslash ograve
+	{248, 243}: -55,  // oslash oacute
+	{248, 244}: -55,  // oslash ocircumflex
+	{248, 245}: -55,  // oslash otilde
+	{248, 246}: -55,  // oslash odieresis
+	{248, 248}: -55,  // oslash oslash
+	{248, 249}: -55,  // oslash ugrave
+	{248, 250}: -55,  // oslash uacute
+	{248, 251}: -55,  // oslash ucircumflex
+	{248, 252}: -55,  // oslash udieresis
+	{248, 253}: -70,  // oslash yacute
+	{248, 255}: -70,  // oslash ydieresis
+	{253, 44}:  -100, // yacute comma
+	{253, 46}:  -100, // yacute period
+	{253, 97}:  -20,  // yacute a
+	{253, 101}: -20,  // yacute e
+	{253, 111}: -20,  // yacute o
+	{253, 224}: -20,  // yacute agrave
+	{253, 225}: -20,  // yacute aacute
+	{253, 226}: -20,  // yacute acircumflex
+	{253, 227}: -20,  // yacute atilde
+	{253, 228}: -20,  // yacute adieresis
+	{253, 229}: -20,  // yacute aring
+	{253, 232}: -20,  // yacute egrave
+	{253, 233}: -20,  // yacute eacute
+	{253, 234}: -20,  // yacute ecircumflex
+	{253, 235}: -20,  // yacute edieresis
+	{253, 242}: -20,  // yacute ograve
+	{253, 243}: -20,  // yacute oacute
+	{253, 244}: -20,  // yacute ocircumflex
+	{253, 245}: -20,  // yacute otilde
+	{253, 246}: -20,  // yacute odieresis
+	{253, 248}: -20,  // yacute oslash
+	{255, 44}:  -100, // ydieresis comma
+	{255, 46}:  -100, // ydieresis period
+	{255, 97}:  -20,  // ydieresis a
+	{255, 101}: -20,  // ydieresis e
+	{255, 111}: -20,  // ydieresis o
+	{255, 224}: -20,  // ydieresis agrave
+	{255, 225}: -20,  // ydieresis aacute
+	{255, 226}: -20,  // ydieresis acircumflex
+	{255, 227}: -20,  // ydieresis atilde
+	{255, 228}: -20,  // ydieresis adieresis
+	{255, 229}: -20,  // ydieresis aring
+	{255, 232}: -20,  // ydieresis egrave
+	{255, 233}: -20,  // ydieresis eacute
+	{255, 234}: -20,  // ydieresis ecircumflex
+	{255, 235}: -20,  // ydieresis edieresis
+	{255, 242}: -20,  // ydieresis ograve
+	{255, 243}: -20,  // ydieresis oacute
+	{255, 244}: -20,  // ydieresis ocircumflex
+	{255, 245}: -20,  // ydieresis otilde
+	{255, 246}: -20,  // ydieresis odieresis
+	{255, 248}: -20,  // ydieresis oslash
 }
 
 // Symbol
+var symbolMetrics = FontMetrics{
+	Ascender:    1010,
+	Descender:   -293,
+	CapHeight:   0,
+	XHeight:     0,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-180, -293}, Point{1090, 1010}},
+}
+
 var symbolWidths = []uint16{
-	32:  250, // space
-	33:  333, // exclam
-	34:  713, // universal
-	35:  500, // numbersign
-	36:  549, // existential
-	37:  833, // percent
-	38:  778, // ampersand
-	39:  439, // suchthat
-	40:  333, // parenleft
-	41:  333, // parenright
-	42:  500, // asteriskmath
-	43:  549, // plus
-	44:  250, // comma
-	45:  549, // minus
-	46:  250, // period
-	47:  278, // slash
-	48:  500, // zero
-	49:  500, // one
-	50:  500, // two
-	51:  500, // three
-	52:  500, // four
-	53:  500, // five
-	54:  500, // six
-	55:  500, // seven
-	56:  500, // eight
-	57:  500, // nine
-	58:  278, // colon
-	59:  278, // semicolon
-	60:  549, // less
-	61:  549, // equal
-	62:  549, // greater
-	63:  444, // question
-	64:  549, // congruent
-	65:  722, // Alpha
-	66:  667, // Beta
-	67:  722, // Chi
-	68:  612, // Delta
-	69:  611, // Epsilon
-	70:  763, // Phi
-	71:  603, // Gamma
-	72:  722, // Eta
-	73:  333, // Iota
-	74:  631, // theta
-	75:  722, // Kappa
-	76:  686, // Lambda
-	77:  889, // Mu
-	78:  722, // Nu
-	79:  722, // Omicron
-	80:  768, // Pi
-	81:  741, // Theta
-	82:  556, // Rho
-	83:  592, // Sigma
-	84:  611, // Tau
-	85:  690, // Upsilon
-	86:  439, // sigma
-	87:  768, // Omega
-	88:  645, // Xi
-	89:  795, // Psi
-	90:  611, // Zeta
-	91:  333, // bracketleft
-	92:  863, // therefore
-	93:  333, // bracketright
-	94:  658, // perpendicular
-	95:  500, // underscore
-	96:  500, // radicalex
-	97:  631, // alpha
-	98:  549, // beta
-	99:  549, // chi
-	100: 494, // delta
-	101: 439, // epsilon
-	102: 521, // phi
-	103: 411, // gamma
-	104: 603, // eta
-	105: 329, // iota
-	106: 603, // phi
-	107: 549, // kappa
-	108: 549, // lambda
-	109: 576, // mu
-	110: 521, // nu
-	111: 549, // omicron
-	112: 549, // pi
-	113: 521, // theta
-	114: 549, // rho
-	115: 603, // sigma
-	116: 439, // tau
-	117: 576, // upsilon
-	118: 713, // omega
-	119: 686, // omega
-	120: 493, // xi
-	121: 686, // psi
-	122: 494, // zeta
-	123: 480, // braceleft
-	124: 200, // bar
-	125: 480, // braceright
-	126: 549, // similar
+	32:  250,  // space
+	33:  333,  // exclam
+	34:  713,  // universal
+	35:  500,  // numbersign
+	36:  549,  // existential
+	37:  833,  // percent
+	38:  778,  // ampersand
+	39:  439,  // suchthat
+	40:  333,  // parenleft
+	41:  333,  // parenright
+	42:  500,  // asteriskmath
+	43:  549,  // plus
+	44:  250,  // comma
+	45:  549,  // minus
+	46:  250,  // period
+	47:  278,  // slash
+	48:  500,  // zero
+	49:  500,  // one
+	50:  500,  // two
+	51:  500,  // three
+	52:  500,  // four
+	53:  500,  // five
+	54:  500,  // six
+	55:  500,  // seven
+	56:  500,  // eight
+	57:  500,  // nine
+	58:  278,  // colon
+	59:  278,  // semicolon
+	60:  549,  // less
+	61:  549,  // equal
+	62:  549,  // greater
+	63:  444,  // question
+	64:  549,  // congruent
+	65:  722,  // Alpha
+	66:  667,  // Beta
+	67:  722,  // Chi
+	68:  612,  // Delta
+	69:  611,  // Epsilon
+	70:  763,  // Phi
+	71:  603,  // Gamma
+	72:  722,  // Eta
+	73:  333,  // Iota
+	74:  631,  // theta1
+	75:  722,  // Kappa
+	76:  686,  // Lambda
+	77:  889,  // Mu
+	78:  722,  // Nu
+	79:  722,  // Omicron
+	80:  768,  // Pi
+	81:  741,  // Theta
+	82:  556,  // Rho
+	83:  592,  // Sigma
+	84:  611,  // Tau
+	85:  690,  // Upsilon
+	86:  439,  // sigma1
+	87:  768,  // Omega
+	88:  645,  // Xi
+	89:  795,  // Psi
+	90:  611,  // Zeta
+	91:  333,  // bracketleft
+	92:  863,  // therefore
+	93:  333,  // bracketright
+	94:  658,  // perpendicular
+	95:  500,  // underscore
+	96:  500,  // radicalex
+	97:  631,  // alpha
+	98:  549,  // beta
+	99:  549,  // chi
+	100: 494,  // delta
+	101: 439,  // epsilon
+	102: 521,  // phi
+	103: 411,  // gamma
+	104: 603,  // eta
+	105: 329,  // iota
+	106: 603,  // phi1
+	107: 549,  // kappa
+	108: 549,  // lambda
+	109: 576,  // mu
+	110: 521,  // nu
+	111: 549,  // omicron
+	112: 549,  // pi
+	113: 521,  // theta
+	114: 549,  // rho
+	115: 603,  // sigma
+	116: 439,  // tau
+	117: 576,  // upsilon
+	118: 713,  // omega1
+	119: 686,  // omega
+	120: 493,  // xi
+	121: 686,  // psi
+	122: 494,  // zeta
+	123: 480,  // braceleft
+	124: 200,  // bar
+	125: 480,  // braceright
+	126: 549,  // similar
+	160: 750,  // Euro
+	161: 620,  // Upsilon1
+	162: 247,  // minute
+	163: 549,  // lessequal
+	164: 167,  // fraction
+	165: 713,  // infinity
+	166: 500,  // florin
+	167: 753,  // club
+	168: 753,  // diamond
+	169: 753,  // heart
+	170: 753,  // spade
+	171: 1042, // arrowboth
+	172: 987,  // arrowleft
+	173: 603,  // arrowup
+	174: 987,  // arrowright
+	175: 603,  // arrowdown
+	176: 400,  // degree
+	177: 549,  // plusminus
+	178: 411,  // second
+	179: 549,  // greaterequal
+	180: 549,  // multiply
+	181: 713,  // proportional
+	182: 494,  // partialdiff
+	183: 460,  // bullet
+	184: 549,  // divide
+	185: 549,  // notequal
+	186: 549,  // equivalence
+	187: 549,  // approxequal
+	188: 1000, // ellipsis
+	189: 603,  // arrowvertex
+	190: 1000, // arrowhorizex
+	191: 658,  // carriagereturn
+	192: 823,  // aleph
+	193: 686,  // Ifraktur
+	194: 795,  // Rfraktur
+	195: 987,  // weierstrass
+	196: 768,  // circlemultiply
+	197: 768,  // circleplus
+	198: 823,  // emptyset
+	199: 768,  // intersection
+	200: 768,  // union
+	201: 713,  // propersuperset
+	202: 713,  // reflexsuperset
+	203: 713,  // notsubset
+	204: 713,  // propersubset
+	205: 713,  // reflexsubset
+	206: 713,  // element
+	207: 713,  // notelement
+	208: 768,  // angle
+	209: 713,  // gradient
+	210: 790,  // registerserif
+	211: 790,  // copyrightserif
+	212: 890,  // trademarkserif
+	213: 823,  // product
+	214: 549,  // radical
+	215: 250,  // dotmath
+	216: 713,  // logicalnot
+	217: 603,  // logicaland
+	218: 603,  // logicalor
+	219: 1042, // arrowdblboth
+	220: 987,  // arrowdblleft
+	221: 603,  // arrowdblup
+	222: 987,  // arrowdblright
+	223: 603,  // arrowdbldown
+	224: 494,  // lozenge
+	225: 329,  // angleleft
+	226: 790,  // registersans
+	227: 790,  // copyrightsans
+	228: 786,  // trademarksans
+	229: 713,  // summation
+	230: 384,  // parenlefttp
+	231: 384,  // parenleftex
+	232: 384,  // parenleftbt
+	233: 384,  // bracketlefttp
+	234: 384,  // bracketleftex
+	235: 384,  // bracketleftbt
+	236: 494,  // bracelefttp
+	237: 494,  // braceleftmid
+	238: 494,  // braceleftbt
+	239: 494,  // braceex
+	241: 329,  // angleright
+	242: 274,  // integral
+	243: 686,  // integraltp
+	244: 686,  // integralex
+	245: 686,  // integralbt
+	246: 384,  // parenrighttp
+	247: 384,  // parenrightex
+	248: 384,  // parenrightbt
+	249: 384,  // bracketrighttp
+	250: 384,  // bracketrightex
+	251: 384,  // bracketrightbt
+	252: 494,  // bracerighttp
+	253: 494,  // bracerightmid
+	254: 494,  // bracerightbt
 }
 
 // Times-Bold
+var timesBoldMetrics = FontMetrics{
+	Ascender:    683,
+	Descender:   -217,
+	CapHeight:   676,
+	XHeight:     461,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-168, -218}, Point{1000, 935}},
+}
+
 var timesBoldWidths = []uint16{
 	32:  250,  // space
 	33:  333,  // exclam
@@ -900,7 +7044,7 @@ var timesBoldWidths = []uint16{
 	36:  500,  // dollar
 	37:  1000, // percent
 	38:  833,  // ampersand
-	39:  333,  // quoteright
+	39:  278,  // quotesingle
 	40:  333,  // parenleft
 	41:  333,  // parenright
 	42:  500,  // asterisk
@@ -957,7 +7101,7 @@ var timesBoldWidths = []uint16{
 	93:  333,  // bracketright
 	94:  581,  // asciicircum
 	95:  500,  // underscore
-	96:  333,  // quoteleft
+	96:  333,  // grave
 	97:  500,  // a
 	98:  556,  // b
 	99:  444,  // c
@@ -988,402 +7132,5206 @@ var timesBoldWidths = []uint16{
 	124: 220,  // bar
 	125: 394,  // braceright
 	126: 520,  // asciitilde
+	128: 500,  // Euro
+	130: 333,  // quotesinglbase
+	131: 500,  // florin
+	132: 500,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 500,  // dagger
+	135: 500,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 556,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 1000, // OE
+	142: 667,  // Zcaron
+	145: 333,  // quoteleft
+	146: 333,  // quoteright
+	147: 500,  // quotedblleft
+	148: 500,  // quotedblright
+	149: 350,  // bullet
+	150: 500,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 1000, // trademark
+	154: 389,  // scaron
+	155: 333,  // guilsinglright
+	156: 722,  // oe
+	158: 444,  // zcaron
+	159: 722,  // Ydieresis
+	160: 250,  // space
+	161: 333,  // exclamdown
+	162: 500,  // cent
+	163: 500,  // sterling
+	164: 500,  // currency
+	165: 500,  // yen
+	166: 220,  // brokenbar
+	167: 500,  // section
+	168: 333,  // dieresis
+	169: 747,  // copyright
+	170: 300,  // ordfeminine
+	171: 500,  // guillemotleft
+	172: 570,  // logicalnot
+	173: 333,  // hyphen
+	174: 747,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 570,  // plusminus
+	178: 300,  // twosuperior
+	179: 300,  // threesuperior
+	180: 333,  // acute
+	181: 556,  // mu
+	182: 540,  // paragraph
+	183: 250,  // periodcentered
+	184: 333,  // cedilla
+	185: 300,  // onesuperior
+	186: 330,  // ordmasculine
+	187: 500,  // guillemotright
+	188: 750,  // onequarter
+	189: 750,  // onehalf
+	190: 750,  // threequarters
+	191: 500,  // questiondown
+	192: 722,  // Agrave
+	193: 722,  // Aacute
+	194: 722,  // Acircumflex
+	195: 722,  // Atilde
+	196: 722,  // Adieresis
+	197: 722,  // Aring
+	198: 1000, // AE
+	199: 722,  // Ccedilla
+	200: 667,  // Egrave
+	201: 667,  // Eacute
+	202: 667,  // Ecircumflex
+	203: 667,  // Edieresis
+	204: 389,  // Igrave
+	205: 389,  // Iacute
+	206: 389,  // Icircumflex
+	207: 389,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 778,  // Ograve
+	211: 778,  // Oacute
+	212: 778,  // Ocircumflex
+	213: 778,  // Otilde
+	214: 778,  // Odieresis
+	215: 570,  // multiply
+	216: 778,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 722,  // Yacute
+	222: 611,  // Thorn
+	223: 556,  // germandbls
+	224: 500,  // agrave
+	225: 500,  // aacute
+	226: 500,  // acircumflex
+	227: 500,  // atilde
+	228: 500,  // adieresis
+	229: 500,  // aring
+	230: 722,  // ae
+	231: 444,  // ccedilla
+	232: 444,  // egrave
+	233: 444,  // eacute
+	234: 444,  // ecircumflex
+	235: 444,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 500,  // eth
+	241: 556,  // ntilde
+	242: 500,  // ograve
+	243: 500,  // oacute
+	244: 500,  // ocircumflex
+	245: 500,  // otilde
+	246: 500,  // odieresis
+	247: 570,  // divide
+	248: 500,  // oslash
+	249: 556,  // ugrave
+	250: 556,  // uacute
+	251: 556,  // ucircumflex
+	252: 556,  // udieresis
+	253: 500,  // yacute
+	254: 556,  // thorn
+	255: 500,  // ydieresis
+}
+
+var timesBoldKerning = map[kernPair]int16{
+	{32, 65}:   -55,  // space A
+	{32, 84}:   -30,  // space T
+	{32, 86}:   -45,  // space V
+	{32, 87}:   -30,  // space W
+	{32, 89}:   -55,  // space Y
+	{32, 159}:  -55,  // space Ydieresis
+	{32, 192}:  -55,  // space Agrave
+	{32, 193}:  -55,  // space Aacute
+	{32, 194}:  -55,  // space Acircumflex
+	{32, 195}:  -55,  // space Atilde
+	{32, 196}:  -55,  // space Adieresis
+	{32, 197}:  -55,  // space Aring
+	{32, 221}:  -55,  // space Yacute
+	{44, 146}:  -55,  // comma quoteright
+	{44, 148}:  -45,  // comma quotedblright
+	{46, 146}:  -55,  // period quoteright
+	{46, 148}:  -55,  // period quotedblright
+	{65, 67}:   -55,  // A C
+	{65, 71}:   -55,  // A G
+	{65, 79}:   -45,  // A O
+	{65, 81}:   -45,  // A Q
+	{65, 84}:   -95,  // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -145, // A V
+	{65, 87}:   -130, // A W
+	{65, 89}:   -100, // A Y
+	{65, 112}:  -25,  // A p
+	{65, 117}:  -50,  // A u
+	{65, 118}:  -100, // A v
+	{65, 119}:  -90,  // A w
+	{65, 121}:  -74,  // A y
+	{65, 146}:  -74,  // A quoteright
+	{65, 159}:  -100, // A Ydieresis
+	{65, 199}:  -55,  // A Ccedilla
+	{65, 210}:  -45,  // A Ograve
+	{65, 211}:  -45,  // A Oacute
+	{65, 212}:  -45,  // A Ocircumflex
+	{65, 213}:  -45,  // A Otilde
+	{65, 214}:  -45,  // A Odieresis
+	{65, 216}:  -45,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -100, // A Yacute
+	{65, 249}:  -50,  // A ugrave
+	{65, 250}:  -50,  // A uacute
+	{65, 251}:  -50,  // A ucircumflex
+	{65, 252}:  -50,  // A udieresis
+	{65, 253}:  -74,  // A yacute
+	{65, 255}:  -74,  // A ydieresis
+	{66, 65}:   -30,  // B A
+	{66, 85}:   -10,  // B U
+	{66, 192}:  -30,  // B Agrave
+	{66, 193}:  -30,  // B Aacute
+	{66, 194}:  -30,  // B Acircumflex
+	{66, 195}:  -30,  // B Atilde
+	{66, 196}:  -30,  // B Adieresis
+	{66, 197}:  -30,  // B Aring
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{68, 46}:   -20,  // D period
+	{68, 65}:   -35,  // D A
+	{68, 86}:   -40,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -40,  // D Y
+	{68, 159}:  -40,  // D Ydieresis
+	{68, 192}:  -35,  // D Agrave
+	{68, 193}:  -35,  // D Aacute
+	{68, 194}:  -35,  // D Acircumflex
+	{68, 195}:  -35,  // D Atilde
+	{68, 196}:  -35,  // D Adieresis
+	{68, 197}:  -35,  // D Aring
+	{68, 221}:  -40,  // D Yacute
+	{70, 44}:   -92,  // F comma
+	{70, 46}:   -110, // F period
+	{70, 65}:   -90,  // F A
+	{70, 97}:   -25,  // F a
+	{70, 101}:  -25,  // F e
+	{70, 111}:  -25,  // F o
+	{70, 192}:  -90,  // F Agrave
+	{70, 193}:  -90,  // F Aacute
+	{70, 194}:  -90,  // F Acircumflex
+	{70, 195}:  -90,  // F Atilde
+	{70, 196}:  -90,  // F Adieresis
+	{70, 197}:  -90,  // F Aring
+	{70, 224}:  -25,  // F agrave
+	{70, 225}:  -25,  // F aacute
+	{70, 226}:  -25,  // F acircumflex
+	{70, 227}:  -25,  // F atilde
+	{70, 228}:  -25,  // F adieresis
+	{70, 229}:  -25,  // F aring
+	{70, 232}:  -25,  // F egrave
+	{70, 233}:  -25,  // F eacute
+	{70, 234}:  -25,  // F ecircumflex
+	{70, 235}:  -25,  // F edieresis
+	{70, 242}:  -25,  // F ograve
+	{70, 243}:  -25,  // F oacute
+	{70, 244}:  -25,  // F ocircumflex
+	{70, 245}:  -25,  // F otilde
+	{70, 246}:  -25,  // F odieresis
+	{70, 248}:  -25,  // F oslash
+	{74, 46}:   -20,  // J period
+	{74, 65}:   -30,  // J A
+	{74, 97}:   -15,  // J a
+	{74, 101}:  -15,  // J e
+	{74, 111}:  -15,  // J o
+	{74, 117}:  -15,  // J u
+	{74, 192}:  -30,  // J Agrave
+	{74, 193}:  -30,  // J Aacute
+	{74, 194}:  -30,  // J Acircumflex
+	{74, 195}:  -30,  // J Atilde
+	{74, 196}:  -30,  // J Adieresis
+	{74, 197}:  -30,  // J Aring
+	{74, 224}:  -15,  // J agrave
+	{74, 225}:  -15,  // J aacute
+	{74, 226}:  -15,  // J acircumflex
+	{74, 227}:  -15,  // J atilde
+	{74, 228}:  -15,  // J adieresis
+	{74, 229}:  -15,  // J aring
+	{74, 232}:  -15,  // J egrave
+	{74, 233}:  -15,  // J eacute
+	{74, 234}:  -15,  // J ecircumflex
+	{74, 235}:  -15,  // J edieresis
+	{74, 242}:  -15,  // J ograve
+	{74, 243}:  -15,  // J oacute
+	{74, 244}:  -15,  // J ocircumflex
+	{74, 245}:  -15,  // J otilde
+	{74, 246}:  -15,  // J odieresis
+	{74, 248}:  -15,  // J oslash
+	{74, 249}:  -15,  // J ugrave
+	{74, 250}:  -15,  // J uacute
+	{74, 251}:  -15,  // J ucircumflex
+	{74, 252}:  -15,  // J udieresis
+	{75, 79}:   -30,  // K O
+	{75, 101}:  -25,  // K e
+	{75, 111}:  -25,  // K o
+	{75, 117}:  -15,  // K u
+	{75, 121}:  -45,  // K y
+	{75, 210}:  -30,  // K Ograve
+	{75, 211}:  -30,  // K Oacute
+	{75, 212}:  -30,  // K Ocircumflex
+	{75, 213}:  -30,  // K Otilde
+	{75, 214}:  -30,  // K Odieresis
+	{75, 216}:  -30,  // K Oslash
+	{75, 232}:  -25,  // K egrave
+	{75, 233}:  -25,  // K eacute
+	{75, 234}:  -25,  // K ecircumflex
+	{75, 235}:  -25,  // K edieresis
+	{75, 242}:  -25,  // K ograve
+	{75, 243}:  -25,  // K oacute
+	{75, 244}:  -25,  // K ocircumflex
+	{75, 245}:  -25,  // K otilde
+	{75, 246}:  -25,  // K odieresis
+	{75, 248}:  -25,  // K oslash
+	{75, 249}:  -15,  // K ugrave
+	{75, 250}:  -15,  // K uacute
+	{75, 251}:  -15,  // K ucircumflex
+	{75, 252}:  -15,  // K udieresis
+	{75, 253}:  -45,  // K yacute
+	{75, 255}:  -45,  // K ydieresis
+	{76, 84}:   -92,  // L T
+	{76, 86}:   -92,  // L V
+	{76, 87}:   -92,  // L W
+	{76, 89}:   -92,  // L Y
+	{76, 121}:  -55,  // L y
+	{76, 146}:  -110, // L quoteright
+	{76, 148}:  -20,  // L quotedblright
+	{76, 159}:  -92,  // L Ydieresis
+	{76, 221}:  -92,  // L Yacute
+	{76, 253}:  -55,  // L yacute
+	{76, 255}:  -55,  // L ydieresis
+	{78, 65}:   -20,  // N A
+	{78, 192}:  -20,  // N Agrave
+	{78, 193}:  -20,  // N Aacute
+	{78, 194}:  -20,  // N Acircumflex
+	{78, 195}:  -20,  // N Atilde
+	{78, 196}:  -20,  // N Adieresis
+	{78, 197}:  -20,  // N Aring
+	{79, 65}:   -40,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -50,  // O W
+	{79, 88}:   -40,  // O X
+	{79, 89}:   -50,  // O Y
+	{79, 159}:  -50,  // O Ydieresis
+	{79, 192}:  -40,  // O Agrave
+	{79, 193}:  -40,  // O Aacute
+	{79, 194}:  -40,  // O Acircumflex
+	{79, 195}:  -40,  // O Atilde
+	{79, 196}:  -40,  // O Adieresis
+	{79, 197}:  -40,  // O Aring
+	{79, 221}:  -50,  // O Yacute
+	{80, 44}:   -92,  // P comma
+	{80, 46}:   -110, // P period
+	{80, 65}:   -74,  // P A
+	{80, 97}:   -10,  // P a
+	{80, 101}:  -20,  // P e
+	{80, 111}:  -20,  // P o
+	{80, 192}:  -74,  // P Agrave
+	{80, 193}:  -74,  // P Aacute
+	{80, 194}:  -74,  // P Acircumflex
+	{80, 195}:  -74,  // P Atilde
+	{80, 196}:  -74,  // P Adieresis
+	{80, 197}:  -74,  // P Aring
+	{80, 224}:  -10,  // P agrave
+	{80, 225}:  -10,  // P aacute
+	{80, 226}:  -10,  // P acircumflex
+	{80, 227}:  -10,  // P atilde
+	{80, 228}:  -10,  // P adieresis
+	{80, 229}:  -10,  // P aring
+	{80, 232}:  -20,  // P egrave
+	{80, 233}:  -20,  // P eacute
+	{80, 234}:  -20,  // P ecircumflex
+	{80, 235}:  -20,  // P edieresis
+	{80, 242}:  -20,  // P ograve
+	{80, 243}:  -20,  // P oacute
+	{80, 244}:  -20,  // P ocircumflex
+	{80, 245}:  -20,  // P otilde
+	{80, 246}:  -20,  // P odieresis
+	{80, 248}:  -20,  // P oslash
+	{81, 46}:   -20,  // Q period
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -30,  // R O
+	{82, 84}:   -40,  // R T
+	{82, 85}:   -30,  // R U
+	{82, 86}:   -55,  // R V
+	{82, 87}:   -35,  // R W
+	{82, 89}:   -35,  // R Y
+	{82, 159}:  -35,  // R Ydieresis
+	{82, 210}:  -30,  // R Ograve
+	{82, 211}:  -30,  // R Oacute
+	{82, 212}:  -30,  // R Ocircumflex
+	{82, 213}:  -30,  // R Otilde
+	{82, 214}:  -30,  // R Odieresis
+	{82, 216}:  -30,  // R Oslash
+	{82, 217}:  -30,  // R Ugrave
+	{82, 218}:  -30,  // R Uacute
+	{82, 219}:  -30,  // R Ucircumflex
+	{82, 220}:  -30,  // R Udieresis
+	{82, 221}:  -35,  // R Yacute
+	{84, 44}:   -74,  // T comma
+	{84, 45}:   -92,  // T hyphen
+	{84, 46}:   -90,  // T period
+	{84, 58}:   -74,  // T colon
+	{84, 59}:   -74,  // T semicolon
+	{84, 65}:   -90,  // T A
+	{84, 79}:   -18,  // T O
+	{84, 97}:   -92,  // T a
+	{84, 101}:  -92,  // T e
+	{84, 105}:  -18,  // T i
+	{84, 111}:  -92,  // T o
+	{84, 114}:  -74,  // T r
+	{84, 117}:  -92,  // T u
+	{84, 119}:  -74,  // T w
+	{84, 121}:  -34,  // T y
+	{84, 173}:  -92,  // T hyphen
+	{84, 192}:  -90,  // T Agrave
+	{84, 193}:  -90,  // T Aacute
+	{84, 194}:  -90,  // T Acircumflex
+	{84, 195}:  -90,  // T Atilde
+	{84, 196}:  -90,  // T Adieresis
+	{84, 197}:  -90,  // T Aring
+	{84, 210}:  -18,  // T Ograve
+	{84, 211}:  -18,  // T Oacute
+	{84, 212}:  -18,  // T Ocircumflex
+	{84, 213}:  -18,  // T Otilde
+	{84, 214}:  -18,  // T Odieresis
+	{84, 216}:  -18,  // T Oslash
+	{84, 224}:  -52,  // T agrave
+	{84, 225}:  -92,  // T aacute
+	{84, 226}:  -52,  // T acircumflex
+	{84, 227}:  -52,  // T atilde
+	{84, 228}:  -52,  // T adieresis
+	{84, 229}:  -92,  // T aring
+	{84, 232}:  -52,  // T egrave
+	{84, 233}:  -92,  // T eacute
+	{84, 234}:  -92,  // T ecircumflex
+	{84, 235}:  -52,  // T edieresis
+	{84, 237}:  -18,  // T iacute
+	{84, 242}:  -92,  // T ograve
+	{84, 243}:  -92,  // T oacute
+	{84, 244}:  -92,  // T ocircumflex
+	{84, 245}:  -92,  // T otilde
+	{84, 246}:  -92,  // T odieresis
+	{84, 248}:  -92,  // T oslash
+	{84, 249}:  -92,  // T ugrave
+	{84, 250}:  -92,  // T uacute
+	{84, 251}:  -92,  // T ucircumflex
+	{84, 252}:  -92,  // T udieresis
+	{84, 253}:  -34,  // T yacute
+	{84, 255}:  -34,  // T ydieresis
+	{85, 44}:   -50,  // U comma
+	{85, 46}:   -50,  // U period
+	{85, 65}:   -60,  // U A
+	{85, 192}:  -60,  // U Agrave
+	{85, 193}:  -60,  // U Aacute
+	{85, 194}:  -60,  // U Acircumflex
+	{85, 195}:  -60,  // U Atilde
+	{85, 196}:  -60,  // U Adieresis
+	{85, 197}:  -60,  // U Aring
+	{86, 44}:   -129, // V comma
+	{86, 45}:   -74,  // V hyphen
+	{86, 46}:   -145, // V period
+	{86, 58}:   -92,  // V colon
+	{86, 59}:   -92,  // V semicolon
+	{86, 65}:   -135, // V A
+	{86, 71}:   -30,  // V G
+	{86, 79}:   -45,  // V O
+	{86, 97}:   -92,  // V a
+	{86, 101}:  -100, // V e
+	{86, 105}:  -37,  // V i
+	{86, 111}:  -100, // V o
+	{86, 117}:  -92,  // V u
+	{86, 173}:  -74,  // V hyphen
+	{86, 192}:  -135, // V Agrave
+	{86, 193}:  -135, // V Aacute
+	{86, 194}:  -135, // V Acircumflex
+	{86, 195}:  -135, // V Atilde
+	{86, 196}:  -135, // V Adieresis
+	{86, 197}:  -135, // V Aring
+	{86, 210}:  -45,  // V Ograve
+	{86, 211}:  -45,  // V Oacute
+	{86, 212}:  -45,  // V Ocircumflex
+	{86, 213}:  -45,  // V Otilde
+	{86, 214}:  -45,  // V Odieresis
+	{86, 216}:  -45,  // V Oslash
+	{86, 224}:  -92,  // V agrave
+	{86, 225}:  -92,  // V aacute
+	{86, 226}:  -92,  // V acircumflex
+	{86, 227}:  -92,  // V atilde
+	{86, 228}:  -92,  // V adieresis
+	{86, 229}:  -92,  // V aring
+	{86, 232}:  -100, // V egrave
+	{86, 233}:  -100, // V eacute
+	{86, 234}:  -100, // V ecircumflex
+	{86, 235}:  -100, // V edieresis
+	{86, 236}:  -37,  // V igrave
+	{86, 237}:  -37,  // V iacute
+	{86, 238}:  -37,  // V icircumflex
+	{86, 239}:  -37,  // V idieresis
+	{86, 242}:  -100, // V ograve
+	{86, 243}:  -100, // V oacute
+	{86, 244}:  -100, // V ocircumflex
+	{86, 245}:  -100, // V otilde
+	{86, 246}:  -100, // V odieresis
+	{86, 248}:  -100, // V oslash
+	{86, 249}:  -92,  // V ugrave
+	{86, 250}:  -92,  // V uacute
+	{86, 251}:  -92,  // V ucircumflex
+	{86, 252}:  -92,  // V udieresis
+	{87, 44}:   -92,  // W comma
+	{87, 45}:   -37,  // W hyphen
+	{87, 46}:   -92,  // W period
+	{87, 58}:   -55,  // W colon
+	{87, 59}:   -55,  // W semicolon
+	{87, 65}:   -120, // W A
+	{87, 79}:   -10,  // W O
+	{87, 97}:   -65,  // W a
+	{87, 101}:  -65,  // W e
+	{87, 105}:  -18,  // W i
+	{87, 111}:  -75,  // W o
+	{87, 117}:  -50,  // W u
+	{87, 121}:  -60,  // W y
+	{87, 173}:  -37,  // W hyphen
+	{87, 192}:  -120, // W Agrave
+	{87, 193}:  -120, // W Aacute
+	{87, 194}:  -120, // W Acircumflex
+	{87, 195}:  -120, // W Atilde
+	{87, 196}:  -120, // W Adieresis
+	{87, 197}:  -120, // W Aring
+	{87, 210}:  -10,  // W Ograve
+	{87, 211}:  -10,  // W Oacute
+	{87, 212}:  -10,  // W Ocircumflex
+	{87, 213}:  -10,  // W Otilde
+	{87, 214}:  -10,  // W Odieresis
+	{87, 216}:  -10,  // W Oslash
+	{87, 224}:  -65,  // W agrave
+	{87, 225}:  -65,  // W aacute
+	{87, 226}:  -65,  // W acircumflex
+	{87, 227}:  -65,  // W atilde
+	{87, 228}:  -65,  // W adieresis
+	{87, 229}:  -65,  // W aring
+	{87, 232}:  -65,  // W egrave
+	{87, 233}:  -65,  // W eacute
+	{87, 234}:  -65,  // W ecircumflex
+	{87, 235}:  -65,  // W edieresis
+	{87, 237}:  -18,  // W iacute
+	{87, 242}:  -75,  // W ograve
+	{87, 243}:  -75,  // W oacute
+	{87, 244}:  -75,  // W ocircumflex
+	{87, 245}:  -75,  // W otilde
+	{87, 246}:  -75,  // W odieresis
+	{87, 248}:  -75,  // W oslash
+	{87, 249}:  -50,  // W ugrave
+	{87, 250}:  -50,  // W uacute
+	{87, 251}:  -50,  // W ucircumflex
+	{87, 252}:  -50,  // W udieresis
+	{87, 253}:  -60,  // W yacute
+	{87, 255}:  -60,  // W ydieresis
+	{89, 44}:   -92,  // Y comma
+	{89, 45}:   -92,  // Y hyphen
+	{89, 46}:   -92,  // Y period
+	{89, 58}:   -92,  // Y colon
+	{89, 59}:   -92,  // Y semicolon
+	{89, 65}:   -110, // Y A
+	{89, 79}:   -35,  // Y O
+	{89, 97}:   -85,  // Y a
+	{89, 101}:  -111, // Y e
+	{89, 105}:  -37,  // Y i
+	{89, 111}:  -111, // Y o
+	{89, 117}:  -92,  // Y u
+	{89, 173}:  -92,  // Y hyphen
+	{89, 192}:  -110, // Y Agrave
+	{89, 193}:  -110, // Y Aacute
+	{89, 194}:  -110, // Y Acircumflex
+	{89, 195}:  -110, // Y Atilde
+	{89, 196}:  -110, // Y Adieresis
+	{89, 197}:  -110, // Y Aring
+	{89, 210}:  -35,  // Y Ograve
+	{89, 211}:  -35,  // Y Oacute
+	{89, 212}:  -35,  // Y Ocircumflex
+	{89, 213}:  -35,  // Y Otilde
+	{89, 214}:  -35,  // Y Odieresis
+	{89, 216}:  -35,  // Y Oslash
+	{89, 224}:  -85,  // Y agrave
+	{89, 225}:  -85,  // Y aacute
+	{89, 226}:  -85,  // Y acircumflex
+	{89, 227}:  -85,  // Y atilde
+	{89, 228}:  -85,  // Y adieresis
+	{89, 229}:  -85,  // Y aring
+	{89, 232}:  -71,  // Y egrave
+	{89, 233}:  -111, // Y eacute
+	{89, 234}:  -111, // Y ecircumflex
+	{89, 235}:  -71,  // Y edieresis
+	{89, 237}:  -37,  // Y iacute
+	{89, 242}:  -111, // Y ograve
+	{89, 243}:  -111, // Y oacute
+	{89, 244}:  -111, // Y ocircumflex
+	{89, 245}:  -111, // Y otilde
+	{89, 246}:  -111, // Y odieresis
+	{89, 248}:  -111, // Y oslash
+	{89, 249}:  -92,  // Y ugrave
+	{89, 250}:  -92,  // Y uacute
+	{89, 251}:  -92,  // Y ucircumflex
+	{89, 252}:  -92,  // Y udieresis
+	{97, 118}:  -25,  // a v
+	{98, 46}:   -40,  // b period
+	{98, 98}:   -10,  // b b
+	{98, 117}:  -20,  // b u
+	{98, 118}:  -15,  // b v
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{100, 119}: -15,  // d w
+	{101, 118}: -15,  // e v
+	{102, 44}:  -15,  // f comma
+	{102, 46}:  -15,  // f period
+	{102, 105}: -25,  // f i
+	{102, 111}: -25,  // f o
+	{102, 146}: 55,   // f quoteright
+	{102, 148}: 50,   // f quotedblright
+	{102, 242}: -25,  // f ograve
+	{102, 243}: -25,  // f oacute
+	{102, 244}: -25,  // f ocircumflex
+	{102, 245}: -25,  // f otilde
+	{102, 246}: -25,  // f odieresis
+	{102, 248}: -25,  // f oslash
+	{103, 46}:  -15,  // g period
+	{104, 121}: -15,  // h y
+	{104, 253}: -15,  // h yacute
+	{104, 255}: -15,  // h ydieresis
+	{105, 118}: -10,  // i v
+	{107, 101}: -10,  // k e
+	{107, 111}: -15,  // k o
+	{107, 121}: -15,  // k y
+	{107, 232}: -10,  // k egrave
+	{107, 233}: -10,  // k eacute
+	{107, 234}: -10,  // k ecircumflex
+	{107, 235}: -10,  // k edieresis
+	{107, 242}: -15,  // k ograve
+	{107, 243}: -15,  // k oacute
+	{107, 244}: -15,  // k ocircumflex
+	{107, 245}: -15,  // k otilde
+	{107, 246}: -15,  // k odieresis
+	{107, 248}: -15,  // k oslash
+	{107, 253}: -15,  // k yacute
+	{107, 255}: -15,  // k ydieresis
+	{110, 118}: -40,  // n v
+	{111, 118}: -10,  // o v
+	{111, 119}: -10,  // o w
+	{114, 44}:  -92,  // r comma
+	{114, 45}:  -37,  // r hyphen
+	{114, 46}:  -100, // r period
+	{114, 99}:  -18,  // r c
+	{114, 101}: -18,  // r e
+	{114, 103}: -10,  // r g
+	{114, 110}: -15,  // r n
+	{114, 111}: -18,  // r o
+	{114, 112}: -10,  // r p
+	{114, 113}: -18,  // r q
+	{114, 118}: -10,  // r v
+	{114, 173}: -37,  // r hyphen
+	{114, 231}: -18,  // r ccedilla
+	{114, 232}: -18,  // r egrave
+	{114, 233}: -18,  // r eacute
+	{114, 234}: -18,  // r ecircumflex
+	{114, 235}: -18,  // r edieresis
+	{114, 241}: -15,  // r ntilde
+	{114, 242}: -18,  // r ograve
+	{114, 243}: -18,  // r oacute
+	{114, 244}: -18,  // r ocircumflex
+	{114, 245}: -18,  // r otilde
+	{114, 246}: -18,  // r odieresis
+	{114, 248}: -18,  // r oslash
+	{118, 44}:  -55,  // v comma
+	{118, 46}:  -70,  // v period
+	{118, 97}:  -10,  // v a
+	{118, 101}: -10,  // v e
+	{118, 111}: -10,  // v o
+	{118, 224}: -10,  // v agrave
+	{118, 225}: -10,  // v aacute
+	{118, 226}: -10,  // v acircumflex
+	{118, 227}: -10,  // v atilde
+	{118, 228}: -10,  // v adieresis
+	{118, 229}: -10,  // v aring
+	{118, 232}: -10,  // v egrave
+	{118, 233}: -10,  // v eacute
+	{118, 234}: -10,  // v ecircumflex
+	{118, 235}: -10,  // v edieresis
+	{118, 242}: -10,  // v ograve
+	{118, 243}: -10,  // v oacute
+	{118, 244}: -10,  // v ocircumflex
+	{118, 245}: -10,  // v otilde
+	{118, 246}: -10,  // v odieresis
+	{118, 248}: -10,  // v oslash
+	{119, 44}:  -55,  // w comma
+	{119, 46}:  -70,  // w period
+	{119, 111}: -10,  // w o
+	{119, 242}: -10,  // w ograve
+	{119, 243}: -10,  // w oacute
+	{119, 244}: -10,  // w ocircumflex
+	{119, 245}: -10,  // w otilde
+	{119, 246}: -10,  // w odieresis
+	{119, 248}: -10,  // w oslash
+	{121, 44}:  -55,  // y comma
+	{121, 46}:  -70,  // y period
+	{121, 101}: -10,  // y e
+	{121, 111}: -25,  // y o
+	{121, 232}: -10,  // y egrave
+	{121, 233}: -10,  // y eacute
+	{121, 234}: -10,  // y ecircumflex
+	{121, 235}: -10,  // y edieresis
+	{121, 242}: -25,  // y ograve
+	{121, 243}: -25,  // y oacute
+	{121, 244}: -25,  // y ocircumflex
+	{121, 245}: -25,  // y otilde
+	{121, 246}: -25,  // y odieresis
+	{121, 248}: -25,  // y oslash
+	{145, 65}:  -10,  // quoteleft A
+	{145, 145}: -63,  // quoteleft quoteleft
+	{145, 192}: -10,  // quoteleft Agrave
+	{145, 193}: -10,  // quoteleft Aacute
+	{145, 194}: -10,  // quoteleft Acircumflex
+	{145, 195}: -10,  // quoteleft Atilde
+	{145, 196}: -10,  // quoteleft Adieresis
+	{145, 197}: -10,  // quoteleft Aring
+	{146, 32}:  -74,  // quoteright space
+	{146, 100}: -20,  // quoteright d
+	{146, 114}: -20,  // quoteright r
+	{146, 115}: -37,  // quoteright s
+	{146, 118}: -20,  // quoteright v
+	{146, 146}: -63,  // quoteright quoteright
+	{146, 154}: -37,  // quoteright scaron
+	{146, 160}: -74,  // quoteright space
+	{147, 65}:  -10,  // quotedblleft A
+	{147, 192}: -10,  // quotedblleft Agrave
+	{147, 193}: -10,  // quotedblleft Aacute
+	{147, 194}: -10,  // quotedblleft Acircumflex
+	{147, 195}: -10,  // quotedblleft Atilde
+	{147, 196}: -10,  // quotedblleft Adieresis
+	{147, 197}: -10,  // quotedblleft Aring
+	{159, 44}:  -92,  // Ydieresis comma
+	{159, 45}:  -92,  // Ydieresis hyphen
+	{159, 46}:  -92,  // Ydieresis period
+	{159, 58}:  -92,  // Ydieresis colon
+	{159, 59}:  -92,  // Ydieresis semicolon
+	{159, 65}:  -110, // Ydieresis A
+	{159, 79}:  -35,  // Ydieresis O
+	{159, 97}:  -85,  // Ydieresis a
+	{159, 101}: -111, // Ydieresis e
+	{159, 105}: -37,  // Ydieresis i
+	{159, 111}: -111, // Ydieresis o
+	{159, 117}: -92,  // Ydieresis u
+	{159, 173}: -92,  // Ydieresis hyphen
+	{159, 192}: -110, // Ydieresis Agrave
+	{159, 193}: -110, // Ydieresis Aacute
+	{159, 194}: -110, // Ydieresis Acircumflex
+	{159, 195}: -110, // Ydieresis Atilde
+	{159, 196}: -110, // Ydieresis Adieresis
+	{159, 197}: -110, // Ydieresis Aring
+	{159, 210}: -35,  // Ydieresis Ograve
+	{159, 211}: -35,  // Ydieresis Oacute
+	{159, 212}: -35,  // Ydieresis Ocircumflex
+	{159, 213}: -35,  // Ydieresis Otilde
+	{159, 214}: -35,  // Ydieresis Odieresis
+	{159, 216}: -35,  // Ydieresis Oslash
+	{159, 224}: -85,  // Ydieresis agrave
+	{159, 225}: -85,  // Ydieresis aacute
+	{159, 226}: -85,  // Ydieresis acircumflex
+	{159, 227}: -85,  // Ydieresis atilde
+	{159, 228}: -85,  // Ydieresis adieresis
+	{159, 229}: -85,  // Ydieresis aring
+	{159, 232}: -71,  // Ydieresis egrave
+	{159, 233}: -111, // Ydieresis eacute
+	{159, 234}: -111, // Ydieresis ecircumflex
+	{159, 235}: -71,  // Ydieresis edieresis
+	{159, 237}: -37,  // Ydieresis iacute
+	{159, 242}: -111, // Ydieresis ograve
+	{159, 243}: -111, // Ydieresis oacute
+	{159, 244}: -111, // Ydieresis ocircumflex
+	{159, 245}: -111, // Ydieresis otilde
+	{159, 246}: -111, // Ydieresis odieresis
+	{159, 248}: -111, // Ydieresis oslash
+	{159, 249}: -92,  // Ydieresis ugrave
+	{159, 250}: -92,  // Ydieresis uacute
+	{159, 251}: -92,  // Ydieresis ucircumflex
+	{159, 252}: -92,  // Ydieresis udieresis
+	{160, 65}:  -55,  // space A
+	{160, 84}:  -30,  // space T
+	{160, 86}:  -45,  // space V
+	{160, 87}:  -30,  // space W
+	{160, 89}:  -55,  // space Y
+	{160, 159}: -55,  // space Ydieresis
+	{160, 192}: -55,  // space Agrave
+	{160, 193}: -55,  // space Aacute
+	{160, 194}: -55,  // space Acircumflex
+	{160, 195}: -55,  // space Atilde
+	{160, 196}: -55,  // space Adieresis
+	{160, 197}: -55,  // space Aring
+	{160, 221}: -55,  // space Yacute
+	{192, 67}:  -55,  // Agrave C
+	{192, 71}:  -55,  // Agrave G
+	{192, 79}:  -45,  // Agrave O
+	{192, 81}:  -45,  // Agrave Q
+	{192, 84}:  -95,  // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -145, // Agrave V
+	{192, 87}:  -130, // Agrave W
+	{192, 89}:  -100, // Agrave Y
+	{192, 112}: -25,  // Agrave p
+	{192, 117}: -50,  // Agrave u
+	{192, 118}: -100, // Agrave v
+	{192, 119}: -90,  // Agrave w
+	{192, 121}: -74,  // Agrave y
+	{192, 146}: -74,  // Agrave quoteright
+	{192, 159}: -100, // Agrave Ydieresis
+	{192, 199}: -55,  // Agrave Ccedilla
+	{192, 210}: -45,  // Agrave Ograve
+	{192, 211}: -45,  // Agrave Oacute
+	{192, 212}: -45,  // Agrave Ocircumflex
+	{192, 213}: -45,  // Agrave Otilde
+	{192, 214}: -45,  // Agrave Odieresis
+	{192, 216}: -45,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -100, // Agrave Yacute
+	{192, 249}: -50,  // Agrave ugrave
+	{192, 250}: -50,  // Agrave uacute
+	{192, 251}: -50,  // Agrave ucircumflex
+	{192, 252}: -50,  // Agrave udieresis
+	{192, 253}: -74,  // Agrave yacute
+	{192, 255}: -74,  // Agrave ydieresis
+	{193, 67}:  -55,  // Aacute C
+	{193, 71}:  -55,  // Aacute G
+	{193, 79}:  -45,  // Aacute O
+	{193, 81}:  -45,  // Aacute Q
+	{193, 84}:  -95,  // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -145, // Aacute V
+	{193, 87}:  -130, // Aacute W
+	{193, 89}:  -100, // Aacute Y
+	{193, 112}: -25,  // Aacute p
+	{193, 117}: -50,  // Aacute u
+	{193, 118}: -100, // Aacute v
+	{193, 119}: -90,  // Aacute w
+	{193, 121}: -74,  // Aacute y
+	{193, 146}: -74,  // Aacute quoteright
+	{193, 159}: -100, // Aacute Ydieresis
+	{193, 199}: -55,  // Aacute Ccedilla
+	{193, 210}: -45,  // Aacute Ograve
+	{193, 211}: -45,  // Aacute Oacute
+	{193, 212}: -45,  // Aacute Ocircumflex
+	{193, 213}: -45,  // Aacute Otilde
+	{193, 214}: -45,  // Aacute Odieresis
+	{193, 216}: -45,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -100, // Aacute Yacute
+	{193, 249}: -50,  // Aacute ugrave
+	{193, 250}: -50,  // Aacute uacute
+	{193, 251}: -50,  // Aacute ucircumflex
+	{193, 252}: -50,  // Aacute udieresis
+	{193, 253}: -74,  // Aacute yacute
+	{193, 255}: -74,  // Aacute ydieresis
+	{194, 67}:  -55,  // Acircumflex C
+	{194, 71}:  -55,  // Acircumflex G
+	{194, 79}:  -45,  // Acircumflex O
+	{194, 81}:  -45,  // Acircumflex Q
+	{194, 84}:  -95,  // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -145, // Acircumflex V
+	{194, 87}:  -130, // Acircumflex W
+	{194, 89}:  -100, // Acircumflex Y
+	{194, 112}: -25,  // Acircumflex p
+	{194, 117}: -50,  // Acircumflex u
+	{194, 118}: -100, // Acircumflex v
+	{194, 119}: -90,  // Acircumflex w
+	{194, 121}: -74,  // Acircumflex y
+	{194, 146}: -74,  // Acircumflex quoteright
+	{194, 159}: -100, // Acircumflex Ydieresis
+	{194, 199}: -55,  // Acircumflex Ccedilla
+	{194, 210}: -45,  // Acircumflex Ograve
+	{194, 211}: -45,  // Acircumflex Oacute
+	{194, 212}: -45,  // Acircumflex Ocircumflex
+	{194, 213}: -45,  // Acircumflex Otilde
+	{194, 214}: -45,  // Acircumflex Odieresis
+	{194, 216}: -45,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -100, // Acircumflex Yacute
+	{194, 249}: -50,  // Acircumflex ugrave
+	{194, 250}: -50,  // Acircumflex uacute
+	{194, 251}: -50,  // Acircumflex ucircumflex
+	{194, 252}: -50,  // Acircumflex udieresis
+	{194, 253}: -74,  // Acircumflex yacute
+	{194, 255}: -74,  // Acircumflex ydieresis
+	{195, 67}:  -55,  // Atilde C
+	{195, 71}:  -55,  // Atilde G
+	{195, 79}:  -45,  // Atilde O
+	{195, 81}:  -45,  // Atilde Q
+	{195, 84}:  -95,  // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -145, // Atilde V
+	{195, 87}:  -130, // Atilde W
+	{195, 89}:  -100, // Atilde Y
+	{195, 112}: -25,  // Atilde p
+	{195, 117}: -50,  // Atilde u
+	{195, 118}: -100, // Atilde v
+	{195, 119}: -90,  // Atilde w
+	{195, 121}: -74,  // Atilde y
+	{195, 146}: -74,  // Atilde quoteright
+	{195, 159}: -100, // Atilde Ydieresis
+	{195, 199}: -55,  // Atilde Ccedilla
+	{195, 210}: -45,  // Atilde Ograve
+	{195, 211}: -45,  // Atilde Oacute
+	{195, 212}: -45,  // Atilde Ocircumflex
+	{195, 213}: -45,  // Atilde Otilde
+	{195, 214}: -45,  // Atilde Odieresis
+	{195, 216}: -45,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -100, // Atilde Yacute
+	{195, 249}: -50,  // Atilde ugrave
+	{195, 250}: -50,  // Atilde uacute
+	{195, 251}: -50,  // Atilde ucircumflex
+	{195, 252}: -50,  // Atilde udieresis
+	{195, 253}: -74,  // Atilde yacute
+	{195, 255}: -74,  // Atilde ydieresis
+	{196, 67}:  -55,  // Adieresis C
+	{196, 71}:  -55,  // Adieresis G
+	{196, 79}:  -45,  // Adieresis O
+	{196, 81}:  -45,  // Adieresis Q
+	{196, 84}:  -95,  // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -145, // Adieresis V
+	{196, 87}:  -130, // Adieresis W
+	{196, 89}:  -100, // Adieresis Y
+	{196, 112}: -25,  // Adieresis p
+	{196, 117}: -50,  // Adieresis u
+	{196, 118}: -100, // Adieresis v
+	{196, 119}: -90,  // Adieresis w
+	{196, 121}: -74,  // Adieresis y
+	{196, 146}: -74,  // Adieresis quoteright
+	{196, 159}: -100, // Adieresis Ydieresis
+	{196, 199}: -55,  // Adieresis Ccedilla
+	{196, 210}: -45,  // Adieresis Ograve
+	{196, 211}: -45,  // Adieresis Oacute
+	{196, 212}: -45,  // Adieresis Ocircumflex
+	{196, 213}: -45,  // Adieresis Otilde
+	{196, 214}: -45,  // Adieresis Odieresis
+	{196, 216}: -45,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -100, // Adieresis Yacute
+	{196, 249}: -50,  // Adieresis ugrave
+	{196, 250}: -50,  // Adieresis uacute
+	{196, 251}: -50,  // Adieresis ucircumflex
+	{196, 252}: -50,  // Adieresis udieresis
+	{196, 253}: -74,  // Adieresis yacute
+	{196, 255}: -74,  // Adieresis ydieresis
+	{197, 67}:  -55,  // Aring C
+	{197, 71}:  -55,  // Aring G
+	{197, 79}:  -45,  // Aring O
+	{197, 81}:  -45,  // Aring Q
+	{197, 84}:  -95,  // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -145, // Aring V
+	{197, 87}:  -130, // Aring W
+	{197, 89}:  -100, // Aring Y
+	{197, 112}: -25,  // Aring p
+	{197, 117}: -50,  // Aring u
+	{197, 118}: -100, // Aring v
+	{197, 119}: -90,  // Aring w
+	{197, 121}: -74,  // Aring y
+	{197, 146}: -74,  // Aring quoteright
+	{197, 159}: -100, // Aring Ydieresis
+	{197, 199}: -55,  // Aring Ccedilla
+	{197, 210}: -45,  // Aring Ograve
+	{197, 211}: -45,  // Aring Oacute
+	{197, 212}: -45,  // Aring Ocircumflex
+	{197, 213}: -45,  // Aring Otilde
+	{197, 214}: -45,  // Aring Odieresis
+	{197, 216}: -45,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -100, // Aring Yacute
+	{197, 249}: -50,  // Aring ugrave
+	{197, 250}: -50,  // Aring uacute
+	{197, 251}: -50,  // Aring ucircumflex
+	{197, 252}: -50,  // Aring udieresis
+	{197, 253}: -74,  // Aring yacute
+	{197, 255}: -74,  // Aring ydieresis
+	{209, 65}:  -20,  // Ntilde A
+	{209, 192}: -20,  // Ntilde Agrave
+	{209, 193}: -20,  // Ntilde Aacute
+	{209, 194}: -20,  // Ntilde Acircumflex
+	{209, 195}: -20,  // Ntilde Atilde
+	{209, 196}: -20,  // Ntilde Adieresis
+	{209, 197}: -20,  // Ntilde Aring
+	{210, 65}:  -40,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -50,  // Ograve W
+	{210, 88}:  -40,  // Ograve X
+	{210, 89}:  -50,  // Ograve Y
+	{210, 159}: -50,  // Ograve Ydieresis
+	{210, 192}: -40,  // Ograve Agrave
+	{210, 193}: -40,  // Ograve Aacute
+	{210, 194}: -40,  // Ograve Acircumflex
+	{210, 195}: -40,  // Ograve Atilde
+	{210, 196}: -40,  // Ograve Adieresis
+	{210, 197}: -40,  // Ograve Aring
+	{210, 221}: -50,  // Ograve Yacute
+	{211, 65}:  -40,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -50,  // Oacute W
+	{211, 88}:  -40,  // Oacute X
+	{211, 89}:  -50,  // Oacute Y
+	{211, 159}: -50,  // Oacute Ydieresis
+	{211, 192}: -40,  // Oacute Agrave
+	{211, 193}: -40,  // Oacute Aacute
+	{211, 194}: -40,  // Oacute Acircumflex
+	{211, 195}: -40,  // Oacute Atilde
+	{211, 196}: -40,  // Oacute Adieresis
+	{211, 197}: -40,  // Oacute Aring
+	{211, 221}: -50,  // Oacute Yacute
+	{212, 65}:  -40,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -50,  // Ocircumflex W
+	{212, 88}:  -40,  // Ocircumflex X
+	{212, 89}:  -50,  // Ocircumflex Y
+	{212, 159}: -50,  // Ocircumflex Ydieresis
+	{212, 192}: -40,  // Ocircumflex Agrave
+	{212, 193}: -40,  // Ocircumflex Aacute
+	{212, 194}: -40,  // Ocircumflex Acircumflex
+	{212, 195}: -40,  // Ocircumflex Atilde
+	{212, 196}: -40,  // Ocircumflex Adieresis
+	{212, 197}: -40,  // Ocircumflex Aring
+	{212, 221}: -50,  // Ocircumflex Yacute
+	{213, 65}:  -40,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -50,  // Otilde W
+	{213, 88}:  -40,  // Otilde X
+	{213, 89}:  -50,  // Otilde Y
+	{213, 159}: -50,  // Otilde Ydieresis
+	{213, 192}: -40,  // Otilde Agrave
+	{213, 193}: -40,  // Otilde Aacute
+	{213, 194}: -40,  // Otilde Acircumflex
+	{213, 195}: -40,  // Otilde Atilde
+	{213, 196}: -40,  // Otilde Adieresis
+	{213, 197}: -40,  // Otilde Aring
+	{213, 221}: -50,  // Otilde Yacute
+	{214, 65}:  -40,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -50,  // Odieresis W
+	{214, 88}:  -40,  // Odieresis X
+	{214, 89}:  -50,  // Odieresis Y
+	{214, 159}: -50,  // Odieresis Ydieresis
+	{214, 192}: -40,  // Odieresis Agrave
+	{214, 193}: -40,  // Odieresis Aacute
+	{214, 194}: -40,  // Odieresis Acircumflex
+	{214, 195}: -40,  // Odieresis Atilde
+	{214, 196}: -40,  // Odieresis Adieresis
+	{214, 197}: -40,  // Odieresis Aring
+	{214, 221}: -50,  // Odieresis Yacute
+	{216, 65}:  -40,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -50,  // Oslash W
+	{216, 88}:  -40,  // Oslash X
+	{216, 89}:  -50,  // Oslash Y
+	{216, 159}: -50,  // Oslash Ydieresis
+	{216, 192}: -40,  // Oslash Agrave
+	{216, 193}: -40,  // Oslash Aacute
+	{216, 194}: -40,  // Oslash Acircumflex
+	{216, 195}: -40,  // Oslash Atilde
+	{216, 196}: -40,  // Oslash Adieresis
+	{216, 197}: -40,  // Oslash Aring
+	{216, 221}: -50,  // Oslash Yacute
+	{217, 44}:  -50,  // Ugrave comma
+	{217, 46}:  -50,  // Ugrave period
+	{217, 65}:  -60,  // Ugrave A
+	{217, 192}: -60,  // Ugrave Agrave
+	{217, 193}: -60,  // Ugrave Aacute
+	{217, 194}: -60,  // Ugrave Acircumflex
+	{217, 195}: -60,  // Ugrave Atilde
+	{217, 196}: -60,  // Ugrave Adieresis
+	{217, 197}: -60,  // Ugrave Aring
+	{218, 44}:  -50,  // Uacute comma
+	{218, 46}:  -50,  // Uacute period
+	{218, 65}:  -60,  // Uacute A
+	{218, 192}: -60,  // Uacute Agrave
+	{218, 193}: -60,  // Uacute Aacute
+	{218, 194}: -60,  // Uacute Acircumflex
+	{218, 195}: -60,  // Uacute Atilde
+	{218, 196}: -60,  // Uacute Adieresis
+	{218, 197}: -60,  // Uacute Aring
+	{219, 44}:  -50,  // Ucircumflex comma
+	{219, 46}:  -50,  // Ucircumflex period
+	{219, 65}:  -60,  // Ucircumflex A
+	{219, 192}: -60,  // Ucircumflex Agrave
+	{219, 193}: -60,  // Ucircumflex Aacute
+	{219, 194}: -60,  // Ucircumflex Acircumflex
+	{219, 195}: -60,  // Ucircumflex Atilde
+	{219, 196}: -60,  // Ucircumflex Adieresis
+	{219, 197}: -60,  // Ucircumflex Aring
+	{220, 44}:  -50,  // Udieresis comma
+	{220, 46}:  -50,  // Udieresis period
+	{220, 65}:  -60,  // Udieresis A
+	{220, 192}: -60,  // Udieresis Agrave
+	{220, 193}: -60,  // Udieresis Aacute
+	{220, 194}: -60,  // Udieresis Acircumflex
+	{220, 195}: -60,  // Udieresis Atilde
+	{220, 196}: -60,  // Udieresis Adieresis
+	{220, 197}: -60,  // Udieresis Aring
+	{221, 44}:  -92,  // Yacute comma
+	{221, 45}:  -92,  // Yacute hyphen
+	{221, 46}:  -92,  // Yacute period
+	{221, 58}:  -92,  // Yacute colon
+	{221, 59}:  -92,  // Yacute semicolon
+	{221, 65}:  -110, // Yacute A
+	{221, 79}:  -35,  // Yacute O
+	{221, 97}:  -85,  // Yacute a
+	{221, 101}: -111, // Yacute e
+	{221, 105}: -37,  // Yacute i
+	{221, 111}: -111, // Yacute o
+	{221, 117}: -92,  // Yacute u
+	{221, 173}: -92,  // Yacute hyphen
+	{221, 192}: -110, // Yacute Agrave
+	{221, 193}: -110, // Yacute Aacute
+	{221, 194}: -110, // Yacute Acircumflex
+	{221, 195}: -110, // Yacute Atilde
+	{221, 196}: -110, // Yacute Adieresis
+	{221, 197}: -110, // Yacute Aring
+	{221, 210}: -35,  // Yacute Ograve
+	{221, 211}: -35,  // Yacute Oacute
+	{221, 212}: -35,  // Yacute Ocircumflex
+	{221, 213}: -35,  // Yacute Otilde
+	{221, 214}: -35,  // Yacute Odieresis
+	{221, 216}: -35,  // Yacute Oslash
+	{221, 224}: -85,  // Yacute agrave
+	{221, 225}: -85,  // Yacute aacute
+	{221, 226}: -85,  // Yacute acircumflex
+	{221, 227}: -85,  // Yacute atilde
+	{221, 228}: -85,  // Yacute adieresis
+	{221, 229}: -85,  // Yacute aring
+	{221, 232}: -71,  // Yacute egrave
+	{221, 233}: -111, // Yacute eacute
+	{221, 234}: -111, // Yacute ecircumflex
+	{221, 235}: -71,  // Yacute edieresis
+	{221, 237}: -37,  // Yacute iacute
+	{221, 242}: -111, // Yacute ograve
+	{221, 243}: -111, // Yacute oacute
+	{221, 244}: -111, // Yacute ocircumflex
+	{221, 245}: -111, // Yacute otilde
+	{221, 246}: -111, // Yacute odieresis
+	{221, 248}: -111, // Yacute oslash
+	{221, 249}: -92,  // Yacute ugrave
+	{221, 250}: -92,  // Yacute uacute
+	{221, 251}: -92,  // Yacute ucircumflex
+	{221, 252}: -92,  // Yacute udieresis
+	{224, 118}: -25,  // agrave v
+	{225, 118}: -25,  // aacute v
+	{226, 118}: -25,  // acircumflex v
+	{227, 118}: -25,  // atilde v
+	{228, 118}: -25,  // adieresis v
+	{229, 118}: -25,  // aring v
+	{232, 118}: -15,  // egrave v
+	{233, 118}: -15,  // eacute v
+	{234, 118}: -15,  // ecircumflex v
+	{235, 118}: -15,  // edieresis v
+	{236, 118}: -10,  // igrave v
+	{237, 118}: -10,  // iacute v
+	{238, 118}: -10,  // icircumflex v
+	{239, 118}: -10,  // idieresis v
+	{241, 118}: -40,  // ntilde v
+	{242, 118}: -10,  // ograve v
+	{242, 119}: -10,  // ograve w
+	{243, 118}: -10,  // oacute v
+	{243, 119}: -10,  // oacute w
+	{244, 118}: -10,  // ocircumflex v
+	{244, 119}: -10,  // ocircumflex w
+	{245, 118}: -10,  // otilde v
+	{245, 119}: -10,  // otilde w
+	{246, 118}: -10,  // odieresis v
+	{246, 119}: -10,  // odieresis w
+	{248, 118}: -10,  // oslash v
+	{248, 119}: -10,  // oslash w
+	{253, 44}:  -55,  // yacute comma
+	{253, 46}:  -70,  // yacute period
+	{253, 101}: -10,  // yacute e
+	{253, 111}: -25,  // yacute o
+	{253, 232}: -10,  // yacute egrave
+	{253, 233}: -10,  // yacute eacute
+	{253, 234}: -10,  // yacute ecircumflex
+	{253, 235}: -10,  // yacute edieresis
+	{253, 242}: -25,  // yacute ograve
+	{253, 243}: -25,  // yacute oacute
+	{253, 244}: -25,  // yacute ocircumflex
+	{253, 245}: -25,  // yacute otilde
+	{253, 246}: -25,  // yacute odieresis
+	{253, 248}: -25,  // yacute oslash
+	{255, 44}:  -55,  // ydieresis comma
+	{255, 46}:  -70,  // ydieresis period
+	{255, 101}: -10,  // ydieresis e
+	{255, 111}: -25,  // ydieresis o
+	{255, 232}: -10,  // ydieresis egrave
+	{255, 233}: -10,  // ydieresis eacute
+	{255, 234}: -10,  // ydieresis ecircumflex
+	{255, 235}: -10,  // ydieresis edieresis
+	{255, 242}: -25,  // ydieresis ograve
+	{255, 243}: -25,  // ydieresis oacute
+	{255, 244}: -25,  // ydieresis ocircumflex
+	{255, 245}: -25,  // ydieresis otilde
+	{255, 246}: -25,  // ydieresis odieresis
+	{255, 248}: -25,  // ydieresis oslash
 }
 
 // Times-BoldItalic
+var timesBoldItalicMetrics = FontMetrics{
+	Ascender:    683,
+	Descender:   -217,
+	CapHeight:   669,
+	XHeight:     462,
+	ItalicAngle: -15,
+	FontBBox:    Rectangle{Point{-200, -218}, Point{996, 921}},
+}
+
 var timesBoldItalicWidths = []uint16{
-	32:  250, // space
-	33:  389, // exclam
-	34:  555, // quotedbl
-	35:  500, // numbersign
-	36:  500, // dollar
-	37:  833, // percent
-	38:  778, // ampersand
-	39:  333, // quoteright
-	40:  333, // parenleft
-	41:  333, // parenright
-	42:  500, // asterisk
-	43:  570, // plus
-	44:  250, // comma
-	45:  333, // hyphen
-	46:  250, // period
-	47:  278, // slash
-	48:  500, // zero
-	49:  500, // one
-	50:  500, // two
-	51:  500, // three
-	52:  500, // four
-	53:  500, // five
-	54:  500, // six
-	55:  500, // seven
-	56:  500, // eight
-	57:  500, // nine
-	58:  333, // colon
-	59:  333, // semicolon
-	60:  570, // less
-	61:  570, // equal
-	62:  570, // greater
-	63:  500, // question
-	64:  832, // at
-	65:  667, // A
-	66:  667, // B
-	67:  667, // C
-	68:  722, // D
-	69:  667, // E
-	70:  667, // F
-	71:  722, // G
-	72:  778, // H
-	73:  389, // I
-	74:  500, // J
-	75:  667, // K
-	76:  611, // L
-	77:  889, // M
-	78:  722, // N
-	79:  722, // O
-	80:  611, // P
-	81:  722, // Q
-	82:  667, // R
-	83:  556, // S
-	84:  611, // T
-	85:  722, // U
-	86:  667, // V
-	87:  889, // W
-	88:  667, // X
-	89:  611, // Y
-	90:  611, // Z
-	91:  333, // bracketleft
-	92:  278, // backslash
-	93:  333, // bracketright
-	94:  570, // asciicircum
-	95:  500, // underscore
-	96:  333, // quoteleft
-	97:  500, // a
-	98:  500, // b
-	99:  444, // c
-	100: 500, // d
-	101: 444, // e
-	102: 333, // f
-	103: 500, // g
-	104: 556, // h
-	105: 278, // i
-	106: 278, // j
-	107: 500, // k
-	108: 278, // l
-	109: 778, // m
-	110: 556, // n
-	111: 500, // o
-	112: 500, // p
-	113: 500, // q
-	114: 389, // r
-	115: 389, // s
-	116: 278, // t
-	117: 556, // u
-	118: 444, // v
-	119: 667, // w
-	120: 500, // x
-	121: 444, // y
-	122: 389, // z
-	123: 348, // braceleft
-	124: 220, // bar
-	125: 348, // braceright
-	126: 570, // asciitilde
+	32:  250,  // space
+	33:  389,  // exclam
+	34:  555,  // quotedbl
+	35:  500,  // numbersign
+	36:  500,  // dollar
+	37:  833,  // percent
+	38:  778,  // ampersand
+	39:  278,  // quotesingle
+	40:  333,  // parenleft
+	41:  333,  // parenright
+	42:  500,  // asterisk
+	43:  570,  // plus
+	44:  250,  // comma
+	45:  333,  // hyphen
+	46:  250,  // period
+	47:  278,  // slash
+	48:  500,  // zero
+	49:  500,  // one
+	50:  500,  // two
+	51:  500,  // three
+	52:  500,  // four
+	53:  500,  // five
+	54:  500,  // six
+	55:  500,  // seven
+	56:  500,  // eight
+	57:  500,  // nine
+	58:  333,  // colon
+	59:  333,  // semicolon
+	60:  570,  // less
+	61:  570,  // equal
+	62:  570,  // greater
+	63:  500,  // question
+	64:  832,  // at
+	65:  667,  // A
+	66:  667,  // B
+	67:  667,  // C
+	68:  722,  // D
+	69:  667,  // E
+	70:  667,  // F
+	71:  722,  // G
+	72:  778,  // H
+	73:  389,  // I
+	74:  500,  // J
+	75:  667,  // K
+	76:  611,  // L
+	77:  889,  // M
+	78:  722,  // N
+	79:  722,  // O
+	80:  611,  // P
+	81:  722,  // Q
+	82:  667,  // R
+	83:  556,  // S
+	84:  611,  // T
+	85:  722,  // U
+	86:  667,  // V
+	87:  889,  // W
+	88:  667,  // X
+	89:  611,  // Y
+	90:  611,  // Z
+	91:  333,  // bracketleft
+	92:  278,  // backslash
+	93:  333,  // bracketright
+	94:  570,  // asciicircum
+	95:  500,  // underscore
+	96:  333,  // grave
+	97:  500,  // a
+	98:  500,  // b
+	99:  444,  // c
+	100: 500,  // d
+	101: 444,  // e
+	102: 333,  // f
+	103: 500,  // g
+	104: 556,  // h
+	105: 278,  // i
+	106: 278,  // j
+	107: 500,  // k
+	108: 278,  // l
+	109: 778,  // m
+	110: 556,  // n
+	111: 500,  // o
+	112: 500,  // p
+	113: 500,  // q
+	114: 389,  // r
+	115: 389,  // s
+	116: 278,  // t
+	117: 556,  // u
+	118: 444,  // v
+	119: 667,  // w
+	120: 500,  // x
+	121: 444,  // y
+	122: 389,  // z
+	123: 348,  // braceleft
+	124: 220,  // bar
+	125: 348,  // braceright
+	126: 570,  // asciitilde
+	128: 500,  // Euro
+	130: 333,  // quotesinglbase
+	131: 500,  // florin
+	132: 500,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 500,  // dagger
+	135: 500,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 556,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 944,  // OE
+	142: 611,  // Zcaron
+	145: 333,  // quoteleft
+	146: 333,  // quoteright
+	147: 500,  // quotedblleft
+	148: 500,  // quotedblright
+	149: 350,  // bullet
+	150: 500,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 1000, // trademark
+	154: 389,  // scaron
+	155: 333,  // guilsinglright
+	156: 722,  // oe
+	158: 389,  // zcaron
+	159: 611,  // Ydieresis
+	160: 250,  // space
+	161: 389,  // exclamdown
+	162: 500,  // cent
+	163: 500,  // sterling
+	164: 500,  // currency
+	165: 500,  // yen
+	166: 220,  // brokenbar
+	167: 500,  // section
+	168: 333,  // dieresis
+	169: 747,  // copyright
+	170: 266,  // ordfeminine
+	171: 500,  // guillemotleft
+	172: 606,  // logicalnot
+	173: 333,  // hyphen
+	174: 747,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 570,  // plusminus
+	178: 300,  // twosuperior
+	179: 300,  // threesuperior
+	180: 333,  // acute
+	181: 576,  // mu
+	182: 500,  // paragraph
+	183: 250,  // periodcentered
+	184: 333,  // cedilla
+	185: 300,  // onesuperior
+	186: 300,  // ordmasculine
+	187: 500,  // guillemotright
+	188: 750,  // onequarter
+	189: 750,  // onehalf
+	190: 750,  // threequarters
+	191: 500,  // questiondown
+	192: 667,  // Agrave
+	193: 667,  // Aacute
+	194: 667,  // Acircumflex
+	195: 667,  // Atilde
+	196: 667,  // Adieresis
+	197: 667,  // Aring
+	198: 944,  // AE
+	199: 667,  // Ccedilla
+	200: 667,  // Egrave
+	201: 667,  // Eacute
+	202: 667,  // Ecircumflex
+	203: 667,  // Edieresis
+	204: 389,  // Igrave
+	205: 389,  // Iacute
+	206: 389,  // Icircumflex
+	207: 389,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 722,  // Ograve
+	211: 722,  // Oacute
+	212: 722,  // Ocircumflex
+	213: 722,  // Otilde
+	214: 722,  // Odieresis
+	215: 570,  // multiply
+	216: 722,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 611,  // Yacute
+	222: 611,  // Thorn
+	223: 500,  // germandbls
+	224: 500,  // agrave
+	225: 500,  // aacute
+	226: 500,  // acircumflex
+	227: 500,  // atilde
+	228: 500,  // adieresis
+	229: 500,  // aring
+	230: 722,  // ae
+	231: 444,  // ccedilla
+	232: 444,  // egrave
+	233: 444,  // eacute
+	234: 444,  // ecircumflex
+	235: 444,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 500,  // eth
+	241: 556,  // ntilde
+	242: 500,  // ograve
+	243: 500,  // oacute
+	244: 500,  // ocircumflex
+	245: 500,  // otilde
+	246: 500,  // odieresis
+	247: 570,  // divide
+	248: 500,  // oslash
+	249: 556,  // ugrave
+	250: 556,  // uacute
+	251: 556,  // ucircumflex
+	252: 556,  // udieresis
+	253: 444,  // yacute
+	254: 500,  // thorn
+	255: 444,  // ydieresis
+}
+
+var timesBoldItalicKerning = map[kernPair]int16{
+	{32, 65}:   -37,  // space A
+	{32, 86}:   -70,  // space V
+	{32, 87}:   -70,  // space W
+	{32, 89}:   -70,  // space Y
+	{32, 159}:  -70,  // space Ydieresis
+	{32, 192}:  -37,  // space Agrave
+	{32, 193}:  -37,  // space Aacute
+	{32, 194}:  -37,  // space Acircumflex
+	{32, 195}:  -37,  // space Atilde
+	{32, 196}:  -37,  // space Adieresis
+	{32, 197}:  -37,  // space Aring
+	{32, 221}:  -70,  // space Yacute
+	{44, 146}:  -95,  // comma quoteright
+	{44, 148}:  -95,  // comma quotedblright
+	{46, 146}:  -95,  // period quoteright
+	{46, 148}:  -95,  // period quotedblright
+	{65, 67}:   -65,  // A C
+	{65, 71}:   -60,  // A G
+	{65, 79}:   -50,  // A O
+	{65, 81}:   -55,  // A Q
+	{65, 84}:   -55,  // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -95,  // A V
+	{65, 87}:   -100, // A W
+	{65, 89}:   -70,  // A Y
+	{65, 117}:  -30,  // A u
+	{65, 118}:  -74,  // A v
+	{65, 119}:  -74,  // A w
+	{65, 121}:  -74,  // A y
+	{65, 146}:  -74,  // A quoteright
+	{65, 159}:  -70,  // A Ydieresis
+	{65, 199}:  -65,  // A Ccedilla
+	{65, 210}:  -50,  // A Ograve
+	{65, 211}:  -50,  // A Oacute
+	{65, 212}:  -50,  // A Ocircumflex
+	{65, 213}:  -50,  // A Otilde
+	{65, 214}:  -50,  // A Odieresis
+	{65, 216}:  -50,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -70,  // A Yacute
+	{65, 249}:  -30,  // A ugrave
+	{65, 250}:  -30,  // A uacute
+	{65, 251}:  -30,  // A ucircumflex
+	{65, 252}:  -30,  // A udieresis
+	{65, 253}:  -74,  // A yacute
+	{65, 255}:  -74,  // A ydieresis
+	{66, 65}:   -25,  // B A
+	{66, 85}:   -10,  // B U
+	{66, 192}:  -25,  // B Agrave
+	{66, 193}:  -25,  // B Aacute
+	{66, 194}:  -25,  // B Acircumflex
+	{66, 195}:  -25,  // B Atilde
+	{66, 196}:  -25,  // B Adieresis
+	{66, 197}:  -25,  // B Aring
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{68, 65}:   -25,  // D A
+	{68, 86}:   -50,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -50,  // D Y
+	{68, 159}:  -50,  // D Ydieresis
+	{68, 192}:  -25,  // D Agrave
+	{68, 193}:  -25,  // D Aacute
+	{68, 194}:  -25,  // D Acircumflex
+	{68, 195}:  -25,  // D Atilde
+	{68, 196}:  -25,  // D Adieresis
+	{68, 197}:  -25,  // D Aring
+	{68, 221}:  -50,  // D Yacute
+	{70, 44}:   -129, // F comma
+	{70, 46}:   -129, // F period
+	{70, 65}:   -100, // F A
+	{70, 97}:   -95,  // F a
+	{70, 101}:  -100, // F e
+	{70, 105}:  -40,  // F i
+	{70, 111}:  -70,  // F o
+	{70, 114}:  -50,  // F r
+	{70, 192}:  -100, // F Agrave
+	{70, 193}:  -100, // F Aacute
+	{70, 194}:  -100, // F Acircumflex
+	{70, 195}:  -100, // F Atilde
+	{70, 196}:  -100, // F Adieresis
+	{70, 197}:  -100, // F Aring
+	{70, 224}:  -95,  // F agrave
+	{70, 225}:  -95,  // F aacute
+	{70, 226}:  -95,  // F acircumflex
+	{70, 227}:  -95,  // F atilde
+	{70, 228}:  -95,  // F adieresis
+	{70, 229}:  -95,  // F aring
+	{70, 232}:  -100, // F egrave
+	{70, 233}:  -100, // F eacute
+	{70, 234}:  -100, // F ecircumflex
+	{70, 235}:  -100, // F edieresis
+	{70, 236}:  -40,  // F igrave
+	{70, 237}:  -40,  // F iacute
+	{70, 238}:  -40,  // F icircumflex
+	{70, 239}:  -40,  // F idieresis
+	{70, 242}:  -70,  // F ograve
+	{70, 243}:  -70,  // F oacute
+	{70, 244}:  -70,  // F ocircumflex
+	{70, 245}:  -70,  // F otilde
+	{70, 246}:  -70,  // F odieresis
+	{70, 248}:  -70,  // F oslash
+	{74, 44}:   -10,  // J comma
+	{74, 46}:   -10,  // J period
+	{74, 65}:   -25,  // J A
+	{74, 97}:   -40,  // J a
+	{74, 101}:  -40,  // J e
+	{74, 111}:  -40,  // J o
+	{74, 117}:  -40,  // J u
+	{74, 192}:  -25,  // J Agrave
+	{74, 193}:  -25,  // J Aacute
+	{74, 194}:  -25,  // J Acircumflex
+	{74, 195}:  -25,  // J Atilde
+	{74, 196}:  -25,  // J Adieresis
+	{74, 197}:  -25,  // J Aring
+	{74, 224}:  -40,  // J agrave
+	{74, 225}:  -40,  // J aacute
+	{74, 226}:  -40,  // J acircumflex
+	{74, 227}:  -40,  // J atilde
+	{74, 228}:  -40,  // J adieresis
+	{74, 229}:  -40,  // J aring
+	{74, 232}:  -40,  // J egrave
+	{74, 233}:  -40,  // J eacute
+	{74, 234}:  -40,  // J ecircumflex
+	{74, 235}:  -40,  // J edieresis
+	{74, 242}:  -40,  // J ograve
+	{74, 243}:  -40,  // J oacute
+	{74, 244}:  -40,  // J ocircumflex
+	{74, 245}:  -40,  // J otilde
+	{74, 246}:  -40,  // J odieresis
+	{74, 248}:  -40,  // J oslash
+	{74, 249}:  -40,  // J ugrave
+	{74, 250}:  -40,  // J uacute
+	{74, 251}:  -40,  // J ucircumflex
+	{74, 252}:  -40,  // J udieresis
+	{75, 79}:   -30,  // K O
+	{75, 101}:  -25,  // K e
+	{75, 111}:  -25,  // K o
+	{75, 117}:  -20,  // K u
+	{75, 121}:  -20,  // K y
+	{75, 210}:  -30,  // K Ograve
+	{75, 211}:  -30,  // K Oacute
+	{75, 212}:  -30,  // K Ocircumflex
+	{75, 213}:  -30,  // K Otilde
+	{75, 214}:  -30,  // K Odieresis
+	{75, 216}:  -30,  // K Oslash
+	{75, 232}:  -25,  // K egrave
+	{75, 233}:  -25,  // K eacute
+	{75, 234}:  -25,  // K ecircumflex
+	{75, 235}:  -25,  // K edieresis
+	{75, 242}:  -25,  // K ograve
+	{75, 243}:  -25,  // K oacute
+	{75, 244}:  -25,  // K ocircumflex
+	{75, 245}:  -25,  // K otilde
+	{75, 246}:  -25,  // K odieresis
+	{75, 248}:  -25,  // K oslash
+	{75, 249}:  -20,  // K ugrave
+	{75, 250}:  -20,  // K uacute
+	{75, 251}:  -20,  // K ucircumflex
+	{75, 252}:  -20,  // K udieresis
+	{75, 253}:  -20,  // K yacute
+	{75, 255}:  -20,  // K ydieresis
+	{76, 84}:   -18,  // L T
+	{76, 86}:   -37,  // L V
+	{76, 87}:   -37,  // L W
+	{76, 89}:   -37,  // L Y
+	{76, 121}:  -37,  // L y
+	{76, 146}:  -55,  // L quoteright
+	{76, 159}:  -37,  // L Ydieresis
+	{76, 221}:  -37,  // L Yacute
+	{76, 253}:  -37,  // L yacute
+	{76, 255}:  -37,  // L ydieresis
+	{78, 65}:   -30,  // N A
+	{78, 192}:  -30,  // N Agrave
+	{78, 193}:  -30,  // N Aacute
+	{78, 194}:  -30,  // N Acircumflex
+	{78, 195}:  -30,  // N Atilde
+	{78, 196}:  -30,  // N Adieresis
+	{78, 197}:  -30,  // N Aring
+	{79, 65}:   -40,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -50,  // O W
+	{79, 88}:   -40,  // O X
+	{79, 89}:   -50,  // O Y
+	{79, 159}:  -50,  // O Ydieresis
+	{79, 192}:  -40,  // O Agrave
+	{79, 193}:  -40,  // O Aacute
+	{79, 194}:  -40,  // O Acircumflex
+	{79, 195}:  -40,  // O Atilde
+	{79, 196}:  -40,  // O Adieresis
+	{79, 197}:  -40,  // O Aring
+	{79, 221}:  -50,  // O Yacute
+	{80, 44}:   -129, // P comma
+	{80, 46}:   -129, // P period
+	{80, 65}:   -85,  // P A
+	{80, 97}:   -40,  // P a
+	{80, 101}:  -50,  // P e
+	{80, 111}:  -55,  // P o
+	{80, 192}:  -85,  // P Agrave
+	{80, 193}:  -85,  // P Aacute
+	{80, 194}:  -85,  // P Acircumflex
+	{80, 195}:  -85,  // P Atilde
+	{80, 196}:  -85,  // P Adieresis
+	{80, 197}:  -85,  // P Aring
+	{80, 224}:  -40,  // P agrave
+	{80, 225}:  -40,  // P aacute
+	{80, 226}:  -40,  // P acircumflex
+	{80, 227}:  -40,  // P atilde
+	{80, 228}:  -40,  // P adieresis
+	{80, 229}:  -40,  // P aring
+	{80, 232}:  -50,  // P egrave
+	{80, 233}:  -50,  // P eacute
+	{80, 234}:  -50,  // P ecircumflex
+	{80, 235}:  -50,  // P edieresis
+	{80, 242}:  -55,  // P ograve
+	{80, 243}:  -55,  // P oacute
+	{80, 244}:  -55,  // P ocircumflex
+	{80, 245}:  -55,  // P otilde
+	{80, 246}:  -55,  // P odieresis
+	{80, 248}:  -55,  // P oslash
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -40,  // R O
+	{82, 84}:   -30,  // R T
+	{82, 85}:   -40,  // R U
+	{82, 86}:   -18,  // R V
+	{82, 87}:   -18,  // R W
+	{82, 89}:   -18,  // R Y
+	{82, 159}:  -18,  // R Ydieresis
+	{82, 210}:  -40,  // R Ograve
+	{82, 211}:  -40,  // R Oacute
+	{82, 212}:  -40,  // R Ocircumflex
+	{82, 213}:  -40,  // R Otilde
+	{82, 214}:  -40,  // R Odieresis
+	{82, 216}:  -40,  // R Oslash
+	{82, 217}:  -40,  // R Ugrave
+	{82, 218}:  -40,  // R Uacute
+	{82, 219}:  -40,  // R Ucircumflex
+	{82, 220}:  -40,  // R Udieresis
+	{82, 221}:  -18,  // R Yacute
+	{84, 44}:   -92,  // T comma
+	{84, 45}:   -92,  // T hyphen
+	{84, 46}:   -92,  // T period
+	{84, 58}:   -74,  // T colon
+	{84, 59}:   -74,  // T semicolon
+	{84, 65}:   -55,  // T A
+	{84, 79}:   -18,  // T O
+	{84, 97}:   -92,  // T a
+	{84, 101}:  -92,  // T e
+	{84, 105}:  -37,  // T i
+	{84, 111}:  -95,  // T o
+	{84, 114}:  -37,  // T r
+	{84, 117}:  -37,  // T u
+	{84, 119}:  -37,  // T w
+	{84, 121}:  -37,  // T y
+	{84, 173}:  -92,  // T hyphen
+	{84, 192}:  -55,  // T Agrave
+	{84, 193}:  -55,  // T Aacute
+	{84, 194}:  -55,  // T Acircumflex
+	{84, 195}:  -55,  // T Atilde
+	{84, 196}:  -55,  // T Adieresis
+	{84, 197}:  -55,  // T Aring
+	{84, 210}:  -18,  // T Ograve
+	{84, 211}:  -18,  // T Oacute
+	{84, 212}:  -18,  // T Ocircumflex
+	{84, 213}:  -18,  // T Otilde
+	{84, 214}:  -18,  // T Odieresis
+	{84, 216}:  -18,  // T Oslash
+	{84, 224}:  -92,  // T agrave
+	{84, 225}:  -92,  // T aacute
+	{84, 226}:  -92,  // T acircumflex
+	{84, 227}:  -92,  // T atilde
+	{84, 228}:  -92,  // T adieresis
+	{84, 229}:  -92,  // T aring
+	{84, 232}:  -52,  // T egrave
+	{84, 233}:  -92,  // T eacute
+	{84, 234}:  -92,  // T ecircumflex
+	{84, 235}:  -52,  // T edieresis
+	{84, 237}:  -37,  // T iacute
+	{84, 242}:  -95,  // T ograve
+	{84, 243}:  -95,  // T oacute
+	{84, 244}:  -95,  // T ocircumflex
+	{84, 245}:  -95,  // T otilde
+	{84, 246}:  -95,  // T odieresis
+	{84, 248}:  -95,  // T oslash
+	{84, 249}:  -37,  // T ugrave
+	{84, 250}:  -37,  // T uacute
+	{84, 251}:  -37,  // T ucircumflex
+	{84, 252}:  -37,  // T udieresis
+	{84, 253}:  -37,  // T yacute
+	{84, 255}:  -37,  // T ydieresis
+	{85, 65}:   -45,  // U A
+	{85, 192}:  -45,  // U Agrave
+	{85, 193}:  -45,  // U Aacute
+	{85, 194}:  -45,  // U Acircumflex
+	{85, 195}:  -45,  // U Atilde
+	{85, 196}:  -45,  // U Adieresis
+	{85, 197}:  -45,  // U Aring
+	{86, 44}:   -129, // V comma
+	{86, 45}:   -70,  // V hyphen
+	{86, 46}:   -129, // V period
+	{86, 58}:   -74,  // V colon
+	{86, 59}:   -74,  // V semicolon
+	{86, 65}:   -85,  // V A
+	{86, 71}:   -10,  // V G
+	{86, 79}:   -30,  // V O
+	{86, 97}:   -111, // V a
+	{86, 101}:  -111, // V e
+	{86, 105}:  -55,  // V i
+	{86, 111}:  -111, // V o
+	{86, 117}:  -55,  // V u
+	{86, 173}:  -70,  // V hyphen
+	{86, 192}:  -85,  // V Agrave
+	{86, 193}:  -85,  // V Aacute
+	{86, 194}:  -85,  // V Acircumflex
+	{86, 195}:  -85,  // V Atilde
+	{86, 196}:  -85,  // V Adieresis
+	{86, 197}:  -85,  // V Aring
+	{86, 210}:  -30,  // V Ograve
+	{86, 211}:  -30,  // V Oacute
+	{86, 212}:  -30,  // V Ocircumflex
+	{86, 213}:  -30,  // V Otilde
+	{86, 214}:  -30,  // V Odieresis
+	{86, 216}:  -30,  // V Oslash
+	{86, 224}:  -111, // V agrave
+	{86, 225}:  -111, // V aacute
+	{86, 226}:  -111, // V acircumflex
+	{86, 227}:  -111, // V atilde
+	{86, 228}:  -111, // V adieresis
+	{86, 229}:  -111, // V aring
+	{86, 232}:  -71,  // V egrave
+	{86, 233}:  -111, // V eacute
+	{86, 234}:  -111, // V ecircumflex
+	{86, 235}:  -71,  // V edieresis
+	{86, 237}:  -55,  // V iacute
+	{86, 242}:  -111, // V ograve
+	{86, 243}:  -111, // V oacute
+	{86, 244}:  -111, // V ocircumflex
+	{86, 245}:  -111, // V otilde
+	{86, 246}:  -111, // V odieresis
+	{86, 248}:  -111, // V oslash
+	{86, 249}:  -55,  // V ugrave
+	{86, 250}:  -55,  // V uacute
+	{86, 251}:  -55,  // V ucircumflex
+	{86, 252}:  -55,  // V udieresis
+	{87, 44}:   -74,  // W comma
+	{87, 45}:   -50,  // W hyphen
+	{87, 46}:   -74,  // W period
+	{87, 58}:   -55,  // W colon
+	{87, 59}:   -55,  // W semicolon
+	{87, 65}:   -74,  // W A
+	{87, 79}:   -15,  // W O
+	{87, 97}:   -85,  // W a
+	{87, 101}:  -90,  // W e
+	{87, 105}:  -37,  // W i
+	{87, 111}:  -80,  // W o
+	{87, 117}:  -55,  // W u
+	{87, 121}:  -55,  // W y
+	{87, 173}:  -50,  // W hyphen
+	{87, 192}:  -74,  // W Agrave
+	{87, 193}:  -74,  // W Aacute
+	{87, 194}:  -74,  // W Acircumflex
+	{87, 195}:  -74,  // W Atilde
+	{87, 196}:  -74,  // W Adieresis
+	{87, 197}:  -74,  // W Aring
+	{87, 210}:  -15,  // W Ograve
+	{87, 211}:  -15,  // W Oacute
+	{87, 212}:  -15,  // W Ocircumflex
+	{87, 213}:  -15,  // W Otilde
+	{87, 214}:  -15,  // W Odieresis
+	{87, 216}:  -15,  // W Oslash
+	{87, 224}:  -85,  // W agrave
+	{87, 225}:  -85,  // W aacute
+	{87, 226}:  -85,  // W acircumflex
+	{87, 227}:  -85,  // W atilde
+	{87, 228}:  -85,  // W adieresis
+	{87, 229}:  -85,  // W aring
+	{87, 232}:  -50,  // W egrave
+	{87, 233}:  -90,  // W eacute
+	{87, 234}:  -90,  // W ecircumflex
+	{87, 235}:  -50,  // W edieresis
+	{87, 237}:  -37,  // W iacute
+	{87, 242}:  -80,  // W ograve
+	{87, 243}:  -80,  // W oacute
+	{87, 244}:  -80,  // W ocircumflex
+	{87, 245}:  -80,  // W otilde
+	{87, 246}:  -80,  // W odieresis
+	{87, 248}:  -80,  // W oslash
+	{87, 249}:  -55,  // W ugrave
+	{87, 250}:  -55,  // W uacute
+	{87, 251}:  -55,  // W ucircumflex
+	{87, 252}:  -55,  // W udieresis
+	{87, 253}:  -55,  // W yacute
+	{87, 255}:  -55,  // W ydieresis
+	{89, 44}:   -92,  // Y comma
+	{89, 45}:   -92,  // Y hyphen
+	{89, 46}:   -74,  // Y period
+	{89, 58}:   -92,  // Y colon
+	{89, 59}:   -92,  // Y semicolon
+	{89, 65}:   -74,  // Y A
+	{89, 79}:   -25,  // Y O
+	{89, 97}:   -92,  // Y a
+	{89, 101}:  -111, // Y e
+	{89, 105}:  -55,  // Y i
+	{89, 111}:  -111, // Y o
+	{89, 117}:  -92,  // Y u
+	{89, 173}:  -92,  // Y hyphen
+	{89, 192}:  -74,  // Y Agrave
+	{89, 193}:  -74,  // Y Aacute
+	{89, 194}:  -74,  // Y Acircumflex
+	{89, 195}:  -74,  // Y Atilde
+	{89, 196}:  -74,  // Y Adieresis
+	{89, 197}:  -74,  // Y Aring
+	{89, 210}:  -25,  // Y Ograve
+	{89, 211}:  -25,  // Y Oacute
+	{89, 212}:  -25,  // Y Ocircumflex
+	{89, 213}:  -25,  // Y Otilde
+	{89, 214}:  -25,  // Y Odieresis
+	{89, 216}:  -25,  // Y Oslash
+	{89, 224}:  -92,  // Y agrave
+	{89, 225}:  -92,  // Y aacute
+	{89, 226}:  -92,  // Y acircumflex
+	{89, 227}:  -92,  // Y atilde
+	{89, 228}:  -92,  // Y adieresis
+	{89, 229}:  -92,  // Y aring
+	{89, 232}:  -71,  // Y egrave
+	{89, 233}:  -111, // Y eacute
+	{89, 234}:  -71,  // Y ecircumflex
+	{89, 235}:  -71,  // Y edieresis
+	{89, 237}:  -55,  // Y iacute
+	{89, 242}:  -111, // Y ograve
+	{89, 243}:  -111, // Y oacute
+	{89, 244}:  -111, // Y ocircumflex
+	{89, 245}:  -111, // Y otilde
+	{89, 246}:  -111, // Y odieresis
+	{89, 248}:  -111, // Y oslash
+	{89, 249}:  -92,  // Y ugrave
+	{89, 250}:  -92,  // Y uacute
+	{89, 251}:  -92,  // Y ucircumflex
+	{89, 252}:  -92,  // Y udieresis
+	{98, 46}:   -40,  // b period
+	{98, 98}:   -10,  // b b
+	{98, 117}:  -20,  // b u
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{99, 104}:  -10,  // c h
+	{99, 107}:  -10,  // c k
+	{101, 98}:  -10,  // e b
+	{102, 44}:  -10,  // f comma
+	{102, 46}:  -10,  // f period
+	{102, 101}: -10,  // f e
+	{102, 102}: -18,  // f f
+	{102, 111}: -10,  // f o
+	{102, 146}: 55,   // f quoteright
+	{102, 233}: -10,  // f eacute
+	{102, 242}: -10,  // f ograve
+	{102, 243}: -10,  // f oacute
+	{102, 244}: -10,  // f ocircumflex
+	{102, 245}: -10,  // f otilde
+	{102, 248}: -10,  // f oslash
+	{107, 101}: -30,  // k e
+	{107, 111}: -10,  // k o
+	{107, 232}: -30,  // k egrave
+	{107, 233}: -30,  // k eacute
+	{107, 234}: -30,  // k ecircumflex
+	{107, 235}: -30,  // k edieresis
+	{107, 242}: -10,  // k ograve
+	{107, 243}: -10,  // k oacute
+	{107, 244}: -10,  // k ocircumflex
+	{107, 245}: -10,  // k otilde
+	{107, 246}: -10,  // k odieresis
+	{107, 248}: -10,  // k oslash
+	{110, 118}: -40,  // n v
+	{111, 118}: -15,  // o v
+	{111, 119}: -25,  // o w
+	{111, 120}: -10,  // o x
+	{111, 121}: -10,  // o y
+	{111, 253}: -10,  // o yacute
+	{111, 255}: -10,  // o ydieresis
+	{114, 44}:  -65,  // r comma
+	{114, 46}:  -65,  // r period
+	{118, 44}:  -37,  // v comma
+	{118, 46}:  -37,  // v period
+	{118, 101}: -15,  // v e
+	{118, 111}: -15,  // v o
+	{118, 232}: -15,  // v egrave
+	{118, 233}: -15,  // v eacute
+	{118, 234}: -15,  // v ecircumflex
+	{118, 235}: -15,  // v edieresis
+	{118, 242}: -15,  // v ograve
+	{118, 243}: -15,  // v oacute
+	{118, 244}: -15,  // v ocircumflex
+	{118, 245}: -15,  // v otilde
+	{118, 246}: -15,  // v odieresis
+	{118, 248}: -15,  // v oslash
+	{119, 44}:  -37,  // w comma
+	{119, 46}:  -37,  // w period
+	{119, 97}:  -10,  // w a
+	{119, 101}: -10,  // w e
+	{119, 111}: -15,  // w o
+	{119, 224}: -10,  // w agrave
+	{119, 225}: -10,  // w aacute
+	{119, 226}: -10,  // w acircumflex
+	{119, 227}: -10,  // w atilde
+	{119, 228}: -10,  // w adieresis
+	{119, 229}: -10,  // w aring
+	{119, 232}: -10,  // w egrave
+	{119, 233}: -10,  // w eacute
+	{119, 234}: -10,  // w ecircumflex
+	{119, 235}: -10,  // w edieresis
+	{119, 242}: -15,  // w ograve
+	{119, 243}: -15,  // w oacute
+	{119, 244}: -15,  // w ocircumflex
+	{119, 245}: -15,  // w otilde
+	{119, 246}: -15,  // w odieresis
+	{119, 248}: -15,  // w oslash
+	{120, 101}: -10,  // x e
+	{120, 232}: -10,  // x egrave
+	{120, 233}: -10,  // x eacute
+	{120, 234}: -10,  // x ecircumflex
+	{120, 235}: -10,  // x edieresis
+	{121, 44}:  -37,  // y comma
+	{121, 46}:  -37,  // y period
+	{145, 145}: -74,  // quoteleft quoteleft
+	{146, 32}:  -74,  // quoteright space
+	{146, 100}: -15,  // quoteright d
+	{146, 114}: -15,  // quoteright r
+	{146, 115}: -74,  // quoteright s
+	{146, 116}: -37,  // quoteright t
+	{146, 118}: -15,  // quoteright v
+	{146, 146}: -74,  // quoteright quoteright
+	{146, 154}: -74,  // quoteright scaron
+	{146, 160}: -74,  // quoteright space
+	{159, 44}:  -92,  // Ydieresis comma
+	{159, 45}:  -92,  // Ydieresis hyphen
+	{159, 46}:  -74,  // Ydieresis period
+	{159, 58}:  -92,  // Ydieresis colon
+	{159, 59}:  -92,  // Ydieresis semicolon
+	{159, 65}:  -74,  // Ydieresis A
+	{159, 79}:  -25,  // Ydieresis O
+	{159, 97}:  -92,  // Ydieresis a
+	{159, 101}: -111, // Ydieresis e
+	{159, 105}: -55,  // Ydieresis i
+	{159, 111}: -111, // Ydieresis o
+	{159, 117}: -92,  // Ydieresis u
+	{159, 173}: -92,  // Ydieresis hyphen
+	{159, 192}: -74,  // Ydieresis Agrave
+	{159, 193}: -74,  // Ydieresis Aacute
+	{159, 194}: -74,  // Ydieresis Acircumflex
+	{159, 195}: -74,  // Ydieresis Atilde
+	{159, 196}: -74,  // Ydieresis Adieresis
+	{159, 197}: -74,  // Ydieresis Aring
+	{159, 210}: -25,  // Ydieresis Ograve
+	{159, 211}: -25,  // Ydieresis Oacute
+	{159, 212}: -25,  // Ydieresis Ocircumflex
+	{159, 213}: -25,  // Ydieresis Otilde
+	{159, 214}: -25,  // Ydieresis Odieresis
+	{159, 216}: -25,  // Ydieresis Oslash
+	{159, 224}: -92,  // Ydieresis agrave
+	{159, 225}: -92,  // Ydieresis aacute
+	{159, 226}: -92,  // Ydieresis acircumflex
+	{159, 227}: -92,  // Ydieresis atilde
+	{159, 228}: -92,  // Ydieresis adieresis
+	{159, 229}: -92,  // Ydieresis aring
+	{159, 232}: -71,  // Ydieresis egrave
+	{159, 233}: -111, // Ydieresis eacute
+	{159, 234}: -71,  // Ydieresis ecircumflex
+	{159, 235}: -71,  // Ydieresis edieresis
+	{159, 237}: -55,  // Ydieresis iacute
+	{159, 242}: -111, // Ydieresis ograve
+	{159, 243}: -111, // Ydieresis oacute
+	{159, 244}: -111, // Ydieresis ocircumflex
+	{159, 245}: -111, // Ydieresis otilde
+	{159, 246}: -111, // Ydieresis odieresis
+	{159, 248}: -111, // Ydieresis oslash
+	{159, 249}: -92,  // Ydieresis ugrave
+	{159, 250}: -92,  // Ydieresis uacute
+	{159, 251}: -92,  // Ydieresis ucircumflex
+	{159, 252}: -92,  // Ydieresis udieresis
+	{160, 65}:  -37,  // space A
+	{160, 86}:  -70,  // space V
+	{160, 87}:  -70,  // space W
+	{160, 89}:  -70,  // space Y
+	{160, 159}: -70,  // space Ydieresis
+	{160, 192}: -37,  // space Agrave
+	{160, 193}: -37,  // space Aacute
+	{160, 194}: -37,  // space Acircumflex
+	{160, 195}: -37,  // space Atilde
+	{160, 196}: -37,  // space Adieresis
+	{160, 197}: -37,  // space Aring
+	{160, 221}: -70,  // space Yacute
+	{192, 67}:  -65,  // Agrave C
+	{192, 71}:  -60,  // Agrave G
+	{192, 79}:  -50,  // Agrave O
+	{192, 81}:  -55,  // Agrave Q
+	{192, 84}:  -55,  // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -95,  // Agrave V
+	{192, 87}:  -100, // Agrave W
+	{192, 89}:  -70,  // Agrave Y
+	{192, 117}: -30,  // Agrave u
+	{192, 118}: -74,  // Agrave v
+	{192, 119}: -74,  // Agrave w
+	{192, 121}: -74,  // Agrave y
+	{192, 146}: -74,  // Agrave quoteright
+	{192, 159}: -70,  // Agrave Ydieresis
+	{192, 199}: -65,  // Agrave Ccedilla
+	{192, 210}: -50,  // Agrave Ograve
+	{192, 211}: -50,  // Agrave Oacute
+	{192, 212}: -50,  // Agrave Ocircumflex
+	{192, 213}: -50,  // Agrave Otilde
+	{192, 214}: -50,  // Agrave Odieresis
+	{192, 216}: -50,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -70,  // Agrave Yacute
+	{192, 249}: -30,  // Agrave ugrave
+	{192, 250}: -30,  // Agrave uacute
+	{192, 251}: -30,  // Agrave ucircumflex
+	{192, 252}: -30,  // Agrave udieresis
+	{192, 253}: -74,  // Agrave yacute
+	{192, 255}: -74,  // Agrave ydieresis
+	{193, 67}:  -65,  // Aacute C
+	{193, 71}:  -60,  // Aacute G
+	{193, 79}:  -50,  // Aacute O
+	{193, 81}:  -55,  // Aacute Q
+	{193, 84}:  -55,  // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -95,  // Aacute V
+	{193, 87}:  -100, // Aacute W
+	{193, 89}:  -70,  // Aacute Y
+	{193, 117}: -30,  // Aacute u
+	{193, 118}: -74,  // Aacute v
+	{193, 119}: -74,  // Aacute w
+	{193, 121}: -74,  // Aacute y
+	{193, 146}: -74,  // Aacute quoteright
+	{193, 159}: -70,  // Aacute Ydieresis
+	{193, 199}: -65,  // Aacute Ccedilla
+	{193, 210}: -50,  // Aacute Ograve
+	{193, 211}: -50,  // Aacute Oacute
+	{193, 212}: -50,  // Aacute Ocircumflex
+	{193, 213}: -50,  // Aacute Otilde
+	{193, 214}: -50,  // Aacute Odieresis
+	{193, 216}: -50,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -70,  // Aacute Yacute
+	{193, 249}: -30,  // Aacute ugrave
+	{193, 250}: -30,  // Aacute uacute
+	{193, 251}: -30,  // Aacute ucircumflex
+	{193, 252}: -30,  // Aacute udieresis
+	{193, 253}: -74,  // Aacute yacute
+	{193, 255}: -74,  // Aacute ydieresis
+	{194, 67}:  -65,  // Acircumflex C
+	{194, 71}:  -60,  // Acircumflex G
+	{194, 79}:  -50,  // Acircumflex O
+	{194, 81}:  -55,  // Acircumflex Q
+	{194, 84}:  -55,  // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -95,  // Acircumflex V
+	{194, 87}:  -100, // Acircumflex W
+	{194, 89}:  -70,  // Acircumflex Y
+	{194, 117}: -30,  // Acircumflex u
+	{194, 118}: -74,  // Acircumflex v
+	{194, 119}: -74,  // Acircumflex w
+	{194, 121}: -74,  // Acircumflex y
+	{194, 146}: -74,  // Acircumflex quoteright
+	{194, 159}: -70,  // Acircumflex Ydieresis
+	{194, 199}: -65,  // Acircumflex Ccedilla
+	{194, 210}: -50,  // Acircumflex Ograve
+	{194, 211}: -50,  // Acircumflex Oacute
+	{194, 212}: -50,  // Acircumflex Ocircumflex
+	{194, 213}: -50,  // Acircumflex Otilde
+	{194, 214}: -50,  // Acircumflex Odieresis
+	{194, 216}: -50,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -70,  // Acircumflex Yacute
+	{194, 249}: -30,  // Acircumflex ugrave
+	{194, 250}: -30,  // Acircumflex uacute
+	{194, 251}: -30,  // Acircumflex ucircumflex
+	{194, 252}: -30,  // Acircumflex udieresis
+	{194, 253}: -74,  // Acircumflex yacute
+	{194, 255}: -74,  // Acircumflex ydieresis
+	{195, 67}:  -65,  // Atilde C
+	{195, 71}:  -60,  // Atilde G
+	{195, 79}:  -50,  // Atilde O
+	{195, 81}:  -55,  // Atilde Q
+	{195, 84}:  -55,  // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -95,  // Atilde V
+	{195, 87}:  -100, // Atilde W
+	{195, 89}:  -70,  // Atilde Y
+	{195, 117}: -30,  // Atilde u
+	{195, 118}: -74,  // Atilde v
+	{195, 119}: -74,  // Atilde w
+	{195, 121}: -74,  // Atilde y
+	{195, 146}: -74,  // Atilde quoteright
+	{195, 159}: -70,  // Atilde Ydieresis
+	{195, 199}: -65,  // Atilde Ccedilla
+	{195, 210}: -50,  // Atilde Ograve
+	{195, 211}: -50,  // Atilde Oacute
+	{195, 212}: -50,  // Atilde Ocircumflex
+	{195, 213}: -50,  // Atilde Otilde
+	{195, 214}: -50,  // Atilde Odieresis
+	{195, 216}: -50,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -70,  // Atilde Yacute
+	{195, 249}: -30,  // Atilde ugrave
+	{195, 250}: -30,  // Atilde uacute
+	{195, 251}: -30,  // Atilde ucircumflex
+	{195, 252}: -30,  // Atilde udieresis
+	{195, 253}: -74,  // Atilde yacute
+	{195, 255}: -74,  // Atilde ydieresis
+	{196, 67}:  -65,  // Adieresis C
+	{196, 71}:  -60,  // Adieresis G
+	{196, 79}:  -50,  // Adieresis O
+	{196, 81}:  -55,  // Adieresis Q
+	{196, 84}:  -55,  // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -95,  // Adieresis V
+	{196, 87}:  -100, // Adieresis W
+	{196, 89}:  -70,  // Adieresis Y
+	{196, 117}: -30,  // Adieresis u
+	{196, 118}: -74,  // Adieresis v
+	{196, 119}: -74,  // Adieresis w
+	{196, 121}: -74,  // Adieresis y
+	{196, 146}: -74,  // Adieresis quoteright
+	{196, 159}: -70,  // Adieresis Ydieresis
+	{196, 199}: -65,  // Adieresis Ccedilla
+	{196, 210}: -50,  // Adieresis Ograve
+	{196, 211}: -50,  // Adieresis Oacute
+	{196, 212}: -50,  // Adieresis Ocircumflex
+	{196, 213}: -50,  // Adieresis Otilde
+	{196, 214}: -50,  // Adieresis Odieresis
+	{196, 216}: -50,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -70,  // Adieresis Yacute
+	{196, 249}: -30,  // Adieresis ugrave
+	{196, 250}: -30,  // Adieresis uacute
+	{196, 251}: -30,  // Adieresis ucircumflex
+	{196, 252}: -30,  // Adieresis udieresis
+	{196, 253}: -74,  // Adieresis yacute
+	{196, 255}: -74,  // Adieresis ydieresis
+	{197, 67}:  -65,  // Aring C
+	{197, 71}:  -60,  // Aring G
+	{197, 79}:  -50,  // Aring O
+	{197, 81}:  -55,  // Aring Q
+	{197, 84}:  -55,  // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -95,  // Aring V
+	{197, 87}:  -100, // Aring W
+	{197, 89}:  -70,  // Aring Y
+	{197, 117}: -30,  // Aring u
+	{197, 118}: -74,  // Aring v
+	{197, 119}: -74,  // Aring w
+	{197, 121}: -74,  // Aring y
+	{197, 146}: -74,  // Aring quoteright
+	{197, 159}: -70,  // Aring Ydieresis
+	{197, 199}: -65,  // Aring Ccedilla
+	{197, 210}: -50,  // Aring Ograve
+	{197, 211}: -50,  // Aring Oacute
+	{197, 212}: -50,  // Aring Ocircumflex
+	{197, 213}: -50,  // Aring Otilde
+	{197, 214}: -50,  // Aring Odieresis
+	{197, 216}: -50,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -70,  // Aring Yacute
+	{197, 249}: -30,  // Aring ugrave
+	{197, 250}: -30,  // Aring uacute
+	{197, 251}: -30,  // Aring ucircumflex
+	{197, 252}: -30,  // Aring udieresis
+	{197, 253}: -74,  // Aring yacute
+	{197, 255}: -74,  // Aring ydieresis
+	{209, 65}:  -30,  // Ntilde A
+	{209, 192}: -30,  // Ntilde Agrave
+	{209, 193}: -30,  // Ntilde Aacute
+	{209, 194}: -30,  // Ntilde Acircumflex
+	{209, 195}: -30,  // Ntilde Atilde
+	{209, 196}: -30,  // Ntilde Adieresis
+	{209, 197}: -30,  // Ntilde Aring
+	{210, 65}:  -40,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -50,  // Ograve W
+	{210, 88}:  -40,  // Ograve X
+	{210, 89}:  -50,  // Ograve Y
+	{210, 159}: -50,  // Ograve Ydieresis
+	{210, 192}: -40,  // Ograve Agrave
+	{210, 193}: -40,  // Ograve Aacute
+	{210, 194}: -40,  // Ograve Acircumflex
+	{210, 195}: -40,  // Ograve Atilde
+	{210, 196}: -40,  // Ograve Adieresis
+	{210, 197}: -40,  // Ograve Aring
+	{210, 221}: -50,  // Ograve Yacute
+	{211, 65}:  -40,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -50,  // Oacute W
+	{211, 88}:  -40,  // Oacute X
+	{211, 89}:  -50,  // Oacute Y
+	{211, 159}: -50,  // Oacute Ydieresis
+	{211, 192}: -40,  // Oacute Agrave
+	{211, 193}: -40,  // Oacute Aacute
+	{211, 194}: -40,  // Oacute Acircumflex
+	{211, 195}: -40,  // Oacute Atilde
+	{211, 196}: -40,  // Oacute Adieresis
+	{211, 197}: -40,  // Oacute Aring
+	{211, 221}: -50,  // Oacute Yacute
+	{212, 65}:  -40,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -50,  // Ocircumflex W
+	{212, 88}:  -40,  // Ocircumflex X
+	{212, 89}:  -50,  // Ocircumflex Y
+	{212, 159}: -50,  // Ocircumflex Ydieresis
+	{212, 192}: -40,  // Ocircumflex Agrave
+	{212, 193}: -40,  // Ocircumflex Aacute
+	{212, 194}: -40,  // Ocircumflex Acircumflex
+	{212, 195}: -40,  // Ocircumflex Atilde
+	{212, 196}: -40,  // Ocircumflex Adieresis
+	{212, 197}: -40,  // Ocircumflex Aring
+	{212, 221}: -50,  // Ocircumflex Yacute
+	{213, 65}:  -40,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -50,  // Otilde W
+	{213, 88}:  -40,  // Otilde X
+	{213, 89}:  -50,  // Otilde Y
+	{213, 159}: -50,  // Otilde Ydieresis
+	{213, 192}: -40,  // Otilde Agrave
+	{213, 193}: -40,  // Otilde Aacute
+	{213, 194}: -40,  // Otilde Acircumflex
+	{213, 195}: -40,  // Otilde Atilde
+	{213, 196}: -40,  // Otilde Adieresis
+	{213, 197}: -40,  // Otilde Aring
+	{213, 221}: -50,  // Otilde Yacute
+	{214, 65}:  -40,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -50,  // Odieresis W
+	{214, 88}:  -40,  // Odieresis X
+	{214, 89}:  -50,  // Odieresis Y
+	{214, 159}: -50,  // Odieresis Ydieresis
+	{214, 192}: -40,  // Odieresis Agrave
+	{214, 193}: -40,  // Odieresis Aacute
+	{214, 194}: -40,  // Odieresis Acircumflex
+	{214, 195}: -40,  // Odieresis Atilde
+	{214, 196}: -40,  // Odieresis Adieresis
+	{214, 197}: -40,  // Odieresis Aring
+	{214, 221}: -50,  // Odieresis Yacute
+	{216, 65}:  -40,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -50,  // Oslash W
+	{216, 88}:  -40,  // Oslash X
+	{216, 89}:  -50,  // Oslash Y
+	{216, 159}: -50,  // Oslash Ydieresis
+	{216, 192}: -40,  // Oslash Agrave
+	{216, 193}: -40,  // Oslash Aacute
+	{216, 194}: -40,  // Oslash Acircumflex
+	{216, 195}: -40,  // Oslash Atilde
+	{216, 196}: -40,  // Oslash Adieresis
+	{216, 197}: -40,  // Oslash Aring
+	{216, 221}: -50,  // Oslash Yacute
+	{217, 65}:  -45,  // Ugrave A
+	{217, 192}: -45,  // Ugrave Agrave
+	{217, 193}: -45,  // Ugrave Aacute
+	{217, 194}: -45,  // Ugrave Acircumflex
+	{217, 195}: -45,  // Ugrave Atilde
+	{217, 196}: -45,  // Ugrave Adieresis
+	{217, 197}: -45,  // Ugrave Aring
+	{218, 65}:  -45,  // Uacute A
+	{218, 192}: -45,  // Uacute Agrave
+	{218, 193}: -45,  // Uacute Aacute
+	{218, 194}: -45,  // Uacute Acircumflex
+	{218, 195}: -45,  // Uacute Atilde
+	{218, 196}: -45,  // Uacute Adieresis
+	{218, 197}: -45,  // Uacute Aring
+	{219, 65}:  -45,  // Ucircumflex A
+	{219, 192}: -45,  // Ucircumflex Agrave
+	{219, 193}: -45,  // Ucircumflex Aacute
+	{219, 194}: -45,  // Ucircumflex Acircumflex
+	{219, 195}: -45,  // Ucircumflex Atilde
+	{219, 196}: -45,  // Ucircumflex Adieresis
+	{219, 197}: -45,  // Ucircumflex Aring
+	{220, 65}:  -45,  // Udieresis A
+	{220, 192}: -45,  // Udieresis Agrave
+	{220, 193}: -45,  // Udieresis Aacute
+	{220, 194}: -45,  // Udieresis Acircumflex
+	{220, 195}: -45,  // Udieresis Atilde
+	{220, 196}: -45,  // Udieresis Adieresis
+	{220, 197}: -45,  // Udieresis Aring
+	{221, 44}:  -92,  // Yacute comma
+	{221, 45}:  -92,  // Yacute hyphen
+	{221, 46}:  -74,  // Yacute period
+	{221, 58}:  -92,  // Yacute colon
+	{221, 59}:  -92,  // Yacute semicolon
+	{221, 65}:  -74,  // Yacute A
+	{221, 79}:  -25,  // Yacute O
+	{221, 97}:  -92,  // Yacute a
+	{221, 101}: -111, // Yacute e
+	{221, 105}: -55,  // Yacute i
+	{221, 111}: -111, // Yacute o
+	{221, 117}: -92,  // Yacute u
+	{221, 173}: -92,  // Yacute hyphen
+	{221, 192}: -74,  // Yacute Agrave
+	{221, 193}: -74,  // Yacute Aacute
+	{221, 194}: -74,  // Yacute Acircumflex
+	{221, 195}: -74,  // Yacute Atilde
+	{221, 196}: -74,  // Yacute Adieresis
+	{221, 197}: -74,  // Yacute Aring
+	{221, 210}: -25,  // Yacute Ograve
+	{221, 211}: -25,  // Yacute Oacute
+	{221, 212}: -25,  // Yacute Ocircumflex
+	{221, 213}: -25,  // Yacute Otilde
+	{221, 214}: -25,  // Yacute Odieresis
+	{221, 216}: -25,  // Yacute Oslash
+	{221, 224}: -92,  // Yacute agrave
+	{221, 225}: -92,  // Yacute aacute
+	{221, 226}: -92,  // Yacute acircumflex
+	{221, 227}: -92,  // Yacute atilde
+	{221, 228}: -92,  // Yacute adieresis
+	{221, 229}: -92,  // Yacute aring
+	{221, 232}: -71,  // Yacute egrave
+	{221, 233}: -111, // Yacute eacute
+	{221, 234}: -71,  // Yacute ecircumflex
+	{221, 235}: -71,  // Yacute edieresis
+	{221, 237}: -55,  // Yacute iacute
+	{221, 242}: -111, // Yacute ograve
+	{221, 243}: -111, // Yacute oacute
+	{221, 244}: -111, // Yacute ocircumflex
+	{221, 245}: -111, // Yacute otilde
+	{221, 246}: -111, // Yacute odieresis
+	{221, 248}: -111, // Yacute oslash
+	{221, 249}: -92,  // Yacute ugrave
+	{221, 250}: -92,  // Yacute uacute
+	{221, 251}: -92,  // Yacute ucircumflex
+	{221, 252}: -92,  // Yacute udieresis
+	{231, 104}: -10,  // ccedilla h
+	{231, 107}: -10,  // ccedilla k
+	{232, 98}:  -10,  // egrave b
+	{233, 98}:  -10,  // eacute b
+	{234, 98}:  -10,  // ecircumflex b
+	{235, 98}:  -10,  // edieresis b
+	{241, 118}: -40,  // ntilde v
+	{242, 118}: -15,  // ograve v
+	{242, 119}: -25,  // ograve w
+	{242, 120}: -10,  // ograve x
+	{242, 121}: -10,  // ograve y
+	{242, 253}: -10,  // ograve yacute
+	{242, 255}: -10,  // ograve ydieresis
+	{243, 118}: -15,  // oacute v
+	{243, 119}: -25,  // oacute w
+	{243, 120}: -10,  // oacute x
+	{243, 121}: -10,  // oacute y
+	{243, 253}: -10,  // oacute yacute
+	{243, 255}: -10,  // oacute ydieresis
+	{244, 118}: -15,  // ocircumflex v
+	{244, 119}: -25,  // ocircumflex w
+	{244, 120}: -10,  // ocircumflex x
+	{244, 121}: -10,  // ocircumflex y
+	{244, 253}: -10,  // ocircumflex yacute
+	{244, 255}: -10,  // ocircumflex ydieresis
+	{245, 118}: -15,  // otilde v
+	{245, 119}: -25,  // otilde w
+	{245, 120}: -10,  // otilde x
+	{245, 121}: -10,  // otilde y
+	{245, 253}: -10,  // otilde yacute
+	{245, 255}: -10,  // otilde ydieresis
+	{246, 118}: -15,  // odieresis v
+	{246, 119}: -25,  // odieresis w
+	{246, 120}: -10,  // odieresis x
+	{246, 121}: -10,  // odieresis y
+	{246, 253}: -10,  // odieresis yacute
+	{246, 255}: -10,  // odieresis ydieresis
+	{248, 118}: -15,  // oslash v
+	{248, 119}: -25,  // oslash w
+	{248, 120}: -10,  // oslash x
+	{248, 121}: -10,  // oslash y
+	{248, 253}: -10,  // oslash yacute
+	{248, 255}: -10,  // oslash ydieresis
+	{253, 44}:  -37,  // yacute comma
+	{253, 46}:  -37,  // yacute period
+	{255, 44}:  -37,  // ydieresis comma
+	{255, 46}:  -37,  // ydieresis period
 }
 
 // Times-Italic
+var timesItalicMetrics = FontMetrics{
+	Ascender:    683,
+	Descender:   -217,
+	CapHeight:   653,
+	XHeight:     441,
+	ItalicAngle: -15.5,
+	FontBBox:    Rectangle{Point{-169, -217}, Point{1010, 883}},
+}
+
 var timesItalicWidths = []uint16{
-	32:  250, // space
-	33:  333, // exclam
-	34:  420, // quotedbl
-	35:  500, // numbersign
-	36:  500, // dollar
-	37:  833, // percent
-	38:  778, // ampersand
-	39:  333, // quoteright
-	40:  333, // parenleft
-	41:  333, // parenright
-	42:  500, // asterisk
-	43:  675, // plus
-	44:  250, // comma
-	45:  333, // hyphen
-	46:  250, // period
-	47:  278, // slash
-	48:  500, // zero
-	49:  500, // one
-	50:  500, // two
-	51:  500, // three
-	52:  500, // four
-	53:  500, // five
-	54:  500, // six
-	55:  500, // seven
-	56:  500, // eight
-	57:  500, // nine
-	58:  333, // colon
-	59:  333, // semicolon
-	60:  675, // less
-	61:  675, // equal
-	62:  675, // greater
-	63:  500, // question
-	64:  920, // at
-	65:  611, // A
-	66:  611, // B
-	67:  667, // C
-	68:  722, // D
-	69:  611, // E
-	70:  611, // F
-	71:  722, // G
-	72:  722, // H
-	73:  333, // I
-	74:  444, // J
-	75:  667, // K
-	76:  556, // L
-	77:  833, // M
-	78:  667, // N
-	79:  722, // O
-	80:  611, // P
-	81:  722, // Q
-	82:  611, // R
-	83:  500, // S
-	84:  556, // T
-	85:  722, // U
-	86:  611, // V
-	87:  833, // W
-	88:  611, // X
-	89:  556, // Y
-	90:  556, // Z
-	91:  389, // bracketleft
-	92:  278, // backslash
-	93:  389, // bracketright
-	94:  422, // asciicircum
-	95:  500, // underscore
-	96:  333, // quoteleft
-	97:  500, // a
-	98:  500, // b
-	99:  444, // c
-	100: 500, // d
-	101: 444, // e
-	102: 278, // f
-	103: 500, // g
-	104: 500, // h
-	105: 278, // i
-	106: 278, // j
-	107: 444, // k
-	108: 278, // l
-	109: 722, // m
-	110: 500, // n
-	111: 500, // o
-	112: 500, // p
-	113: 500, // q
-	114: 389, // r
-	115: 389, // s
-	116: 278, // t
-	117: 500, // u
-	118: 444, // v
-	119: 667, // w
-	120: 444, // x
-	121: 444, // y
-	122: 389, // z
-	123: 400, // braceleft
-	124: 275, // bar
-	125: 400, // braceright
-	126: 541, // asciitilde
+	32:  250,  // space
+	33:  333,  // exclam
+	34:  420,  // quotedbl
+	35:  500,  // numbersign
+	36:  500,  // dollar
+	37:  833,  // percent
+	38:  778,  // ampersand
+	39:  214,  // quotesingle
+	40:  333,  // parenleft
+	41:  333,  // parenright
+	42:  500,  // asterisk
+	43:  675,  // plus
+	44:  250,  // comma
+	45:  333,  // hyphen
+	46:  250,  // period
+	47:  278,  // slash
+	48:  500,  // zero
+	49:  500,  // one
+	50:  500,  // two
+	51:  500,  // three
+	52:  500,  // four
+	53:  500,  // five
+	54:  500,  // six
+	55:  500,  // seven
+	56:  500,  // eight
+	57:  500,  // nine
+	58:  333,  // colon
+	59:  333,  // semicolon
+	60:  675,  // less
+	61:  675,  // equal
+	62:  675,  // greater
+	63:  500,  // question
+	64:  920,  // at
+	65:  611,  // A
+	66:  611,  // B
+	67:  667,  // C
+	68:  722,  // D
+	69:  611,  // E
+	70:  611,  // F
+	71:  722,  // G
+	72:  722,  // H
+	73:  333,  // I
+	74:  444,  // J
+	75:  667,  // K
+	76:  556,  // L
+	77:  833,  // M
+	78:  667,  // N
+	79:  722,  // O
+	80:  611,  // P
+	81:  722,  // Q
+	82:  611,  // R
+	83:  500,  // S
+	84:  556,  // T
+	85:  722,  // U
+	86:  611,  // V
+	87:  833,  // W
+	88:  611,  // X
+	89:  556,  // Y
+	90:  556,  // Z
+	91:  389,  // bracketleft
+	92:  278,  // backslash
+	93:  389,  // bracketright
+	94:  422,  // asciicircum
+	95:  500,  // underscore
+	96:  333,  // grave
+	97:  500,  // a
+	98:  500,  // b
+	99:  444,  // c
+	100: 500,  // d
+	101: 444,  // e
+	102: 278,  // f
+	103: 500,  // g
+	104: 500,  // h
+	105: 278,  // i
+	106: 278,  // j
+	107: 444,  // k
+	108: 278,  // l
+	109: 722,  // m
+	110: 500,  // n
+	111: 500,  // o
+	112: 500,  // p
+	113: 500,  // q
+	114: 389,  // r
+	115: 389,  // s
+	116: 278,  // t
+	117: 500,  // u
+	118: 444,  // v
+	119: 667,  // w
+	120: 444,  // x
+	121: 444,  // y
+	122: 389,  // z
+	123: 400,  // braceleft
+	124: 275,  // bar
+	125: 400,  // braceright
+	126: 541,  // asciitilde
+	128: 500,  // Euro
+	130: 333,  // quotesinglbase
+	131: 500,  // florin
+	132: 556,  // quotedblbase
+	133: 889,  // ellipsis
+	134: 500,  // dagger
+	135: 500,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 500,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 944,  // OE
+	142: 556,  // Zcaron
+	145: 333,  // quoteleft
+	146: 333,  // quoteright
+	147: 556,  // quotedblleft
+	148: 556,  // quotedblright
+	149: 350,  // bullet
+	150: 500,  // endash
+	151: 889,  // emdash
+	152: 333,  // tilde
+	153: 980,  // trademark
+	154: 389,  // scaron
+	155: 333,  // guilsinglright
+	156: 667,  // oe
+	158: 389,  // zcaron
+	159: 556,  // Ydieresis
+	160: 250,  // space
+	161: 389,  // exclamdown
+	162: 500,  // cent
+	163: 500,  // sterling
+	164: 500,  // currency
+	165: 500,  // yen
+	166: 275,  // brokenbar
+	167: 500,  // section
+	168: 333,  // dieresis
+	169: 760,  // copyright
+	170: 276,  // ordfeminine
+	171: 500,  // guillemotleft
+	172: 675,  // logicalnot
+	173: 333,  // hyphen
+	174: 760,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 675,  // plusminus
+	178: 300,  // twosuperior
+	179: 300,  // threesuperior
+	180: 333,  // acute
+	181: 500,  // mu
+	182: 523,  // paragraph
+	183: 250,  // periodcentered
+	184: 333,  // cedilla
+	185: 300,  // onesuperior
+	186: 310,  // ordmasculine
+	187: 500,  // guillemotright
+	188: 750,  // onequarter
+	189: 750,  // onehalf
+	190: 750,  // threequarters
+	191: 500,  // questiondown
+	192: 611,  // Agrave
+	193: 611,  // Aacute
+	194: 611,  // Acircumflex
+	195: 611,  // Atilde
+	196: 611,  // Adieresis
+	197: 611,  // Aring
+	198: 889,  // AE
+	199: 667,  // Ccedilla
+	200: 611,  // Egrave
+	201: 611,  // Eacute
+	202: 611,  // Ecircumflex
+	203: 611,  // Edieresis
+	204: 333,  // Igrave
+	205: 333,  // Iacute
+	206: 333,  // Icircumflex
+	207: 333,  // Idieresis
+	208: 722,  // Eth
+	209: 667,  // Ntilde
+	210: 722,  // Ograve
+	211: 722,  // Oacute
+	212: 722,  // Ocircumflex
+	213: 722,  // Otilde
+	214: 722,  // Odieresis
+	215: 675,  // multiply
+	216: 722,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 556,  // Yacute
+	222: 611,  // Thorn
+	223: 500,  // germandbls
+	224: 500,  // agrave
+	225: 500,  // aacute
+	226: 500,  // acircumflex
+	227: 500,  // atilde
+	228: 500,  // adieresis
+	229: 500,  // aring
+	230: 667,  // ae
+	231: 444,  // ccedilla
+	232: 444,  // egrave
+	233: 444,  // eacute
+	234: 444,  // ecircumflex
+	235: 444,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 500,  // eth
+	241: 500,  // ntilde
+	242: 500,  // ograve
+	243: 500,  // oacute
+	244: 500,  // ocircumflex
+	245: 500,  // otilde
+	246: 500,  // odieresis
+	247: 675,  // divide
+	248: 500,  // oslash
+	249: 500,  // ugrave
+	250: 500,  // uacute
+	251: 500,  // ucircumflex
+	252: 500,  // udieresis
+	253: 444,  // yacute
+	254: 500,  // thorn
+	255: 444,  // ydieresis
+}
+
+var timesItalicKerning = map[kernPair]int16{
+	{32, 65}:   -18,  // space A
+	{32, 84}:   -18,  // space T
+	{32, 86}:   -35,  // space V
+	{32, 87}:   -40,  // space W
+	{32, 89}:   -75,  // space Y
+	{32, 159}:  -75,  // space Ydieresis
+	{32, 192}:  -18,  // space Agrave
+	{32, 193}:  -18,  // space Aacute
+	{32, 194}:  -18,  // space Acircumflex
+	{32, 195}:  -18,  // space Atilde
+	{32, 196}:  -18,  // space Adieresis
+	{32, 197}:  -18,  // space Aring
+	{32, 221}:  -75,  // space Yacute
+	{44, 146}:  -140, // comma quoteright
+	{44, 148}:  -140, // comma quotedblright
+	{46, 146}:  -140, // period quoteright
+	{46, 148}:  -140, // period quotedblright
+	{65, 67}:   -30,  // A C
+	{65, 71}:   -35,  // A G
+	{65, 79}:   -40,  // A O
+	{65, 81}:   -40,  // A Q
+	{65, 84}:   -37,  // A T
+	{65, 85}:   -50,  // A U
+	{65, 86}:   -105, // A V
+	{65, 87}:   -95,  // A W
+	{65, 89}:   -55,  // A Y
+	{65, 117}:  -20,  // A u
+	{65, 118}:  -55,  // A v
+	{65, 119}:  -55,  // A w
+	{65, 121}:  -55,  // A y
+	{65, 146}:  -37,  // A quoteright
+	{65, 159}:  -55,  // A Ydieresis
+	{65, 199}:  -30,  // A Ccedilla
+	{65, 210}:  -40,  // A Ograve
+	{65, 211}:  -40,  // A Oacute
+	{65, 212}:  -40,  // A Ocircumflex
+	{65, 213}:  -40,  // A Otilde
+	{65, 214}:  -40,  // A Odieresis
+	{65, 216}:  -40,  // A Oslash
+	{65, 217}:  -50,  // A Ugrave
+	{65, 218}:  -50,  // A Uacute
+	{65, 219}:  -50,  // A Ucircumflex
+	{65, 220}:  -50,  // A Udieresis
+	{65, 221}:  -55,  // A Yacute
+	{65, 249}:  -20,  // A ugrave
+	{65, 250}:  -20,  // A uacute
+	{65, 251}:  -20,  // A ucircumflex
+	{65, 252}:  -20,  // A udieresis
+	{65, 253}:  -55,  // A yacute
+	{65, 255}:  -55,  // A ydieresis
+	{66, 65}:   -25,  // B A
+	{66, 85}:   -10,  // B U
+	{66, 192}:  -25,  // B Agrave
+	{66, 193}:  -25,  // B Aacute
+	{66, 194}:  -25,  // B Acircumflex
+	{66, 195}:  -25,  // B Atilde
+	{66, 196}:  -25,  // B Adieresis
+	{66, 197}:  -25,  // B Aring
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{68, 65}:   -35,  // D A
+	{68, 86}:   -40,  // D V
+	{68, 87}:   -40,  // D W
+	{68, 89}:   -40,  // D Y
+	{68, 159}:  -40,  // D Ydieresis
+	{68, 192}:  -35,  // D Agrave
+	{68, 193}:  -35,  // D Aacute
+	{68, 194}:  -35,  // D Acircumflex
+	{68, 195}:  -35,  // D Atilde
+	{68, 196}:  -35,  // D Adieresis
+	{68, 197}:  -35,  // D Aring
+	{68, 221}:  -40,  // D Yacute
+	{70, 44}:   -135, // F comma
+	{70, 46}:   -135, // F period
+	{70, 65}:   -115, // F A
+	{70, 97}:   -75,  // F a
+	{70, 101}:  -75,  // F e
+	{70, 105}:  -45,  // F i
+	{70, 111}:  -105, // F o
+	{70, 114}:  -55,  // F r
+	{70, 192}:  -115, // F Agrave
+	{70, 193}:  -115, // F Aacute
+	{70, 194}:  -115, // F Acircumflex
+	{70, 195}:  -115, // F Atilde
+	{70, 196}:  -115, // F Adieresis
+	{70, 197}:  -115, // F Aring
+	{70, 224}:  -75,  // F agrave
+	{70, 225}:  -75,  // F aacute
+	{70, 226}:  -75,  // F acircumflex
+	{70, 227}:  -75,  // F atilde
+	{70, 228}:  -75,  // F adieresis
+	{70, 229}:  -75,  // F aring
+	{70, 232}:  -75,  // F egrave
+	{70, 233}:  -75,  // F eacute
+	{70, 234}:  -75,  // F ecircumflex
+	{70, 235}:  -75,  // F edieresis
+	{70, 236}:  -45,  // F igrave
+	{70, 237}:  -45,  // F iacute
+	{70, 238}:  -45,  // F icircumflex
+	{70, 239}:  -45,  // F idieresis
+	{70, 242}:  -105, // F ograve
+	{70, 243}:  -105, // F oacute
+	{70, 244}:  -105, // F ocircumflex
+	{70, 245}:  -105, // F otilde
+	{70, 246}:  -105, // F odieresis
+	{70, 248}:  -105, // F oslash
+	{74, 44}:   -25,  // J comma
+	{74, 46}:   -25,  // J period
+	{74, 65}:   -40,  // J A
+	{74, 97}:   -35,  // J a
+	{74, 101}:  -25,  // J e
+	{74, 111}:  -25,  // J o
+	{74, 117}:  -35,  // J u
+	{74, 192}:  -40,  // J Agrave
+	{74, 193}:  -40,  // J Aacute
+	{74, 194}:  -40,  // J Acircumflex
+	{74, 195}:  -40,  // J Atilde
+	{74, 196}:  -40,  // J Adieresis
+	{74, 197}:  -40,  // J Aring
+	{74, 224}:  -35,  // J agrave
+	{74, 225}:  -35,  // J aacute
+	{74, 226}:  -35,  // J acircumflex
+	{74, 227}:  -35,  // J atilde
+	{74, 228}:  -35,  // J adieresis
+	{74, 229}:  -35,  // J aring
+	{74, 232}:  -25,  // J egrave
+	{74, 233}:  -25,  // J eacute
+	{74, 234}:  -25,  // J ecircumflex
+	{74, 235}:  -25,  // J edieresis
+	{74, 242}:  -25,  // J ograve
+	{74, 243}:  -25,  // J oacute
+	{74, 244}:  -25,  // J ocircumflex
+	{74, 245}:  -25,  // J otilde
+	{74, 246}:  -25,  // J odieresis
+	{74, 248}:  -25,  // J oslash
+	{74, 249}:  -35,  // J ugrave
+	{74, 250}:  -35,  // J uacute
+	{74, 251}:  -35,  // J ucircumflex
+	{74, 252}:  -35,  // J udieresis
+	{75, 79}:   -50,  // K O
+	{75, 101}:  -35,  // K e
+	{75, 111}:  -40,  // K o
+	{75, 117}:  -40,  // K u
+	{75, 121}:  -40,  // K y
+	{75, 210}:  -50,  // K Ograve
+	{75, 211}:  -50,  // K Oacute
+	{75, 212}:  -50,  // K Ocircumflex
+	{75, 213}:  -50,  // K Otilde
+	{75, 214}:  -50,  // K Odieresis
+	{75, 216}:  -50,  // K Oslash
+	{75, 232}:  -35,  // K egrave
+	{75, 233}:  -35,  // K eacute
+	{75, 234}:  -35,  // K ecircumflex
+	{75, 235}:  -35,  // K edieresis
+	{75, 242}:  -40,  // K ograve
+	{75, 243}:  -40,  // K oacute
+	{75, 244}:  -40,  // K ocircumflex
+	{75, 245}:  -40,  // K otilde
+	{75, 246}:  -40,  // K odieresis
+	{75, 248}:  -40,  // K oslash
+	{75, 249}:  -40,  // K ugrave
+	{75, 250}:  -40,  // K uacute
+	{75, 251}:  -40,  // K ucircumflex
+	{75, 252}:  -40,  // K udieresis
+	{75, 253}:  -40,  // K yacute
+	{75, 255}:  -40,  // K ydieresis
+	{76, 84}:   -20,  // L T
+	{76, 86}:   -55,  // L V
+	{76, 87}:   -55,  // L W
+	{76, 89}:   -20,  // L Y
+	{76, 121}:  -30,  // L y
+	{76, 146}:  -37,  // L quoteright
+	{76, 159}:  -20,  // L Ydieresis
+	{76, 221}:  -20,  // L Yacute
+	{76, 253}:  -30,  // L yacute
+	{76, 255}:  -30,  // L ydieresis
+	{78, 65}:   -27,  // N A
+	{78, 192}:  -27,  // N Agrave
+	{78, 193}:  -27,  // N Aacute
+	{78, 194}:  -27,  // N Acircumflex
+	{78, 195}:  -27,  // N Atilde
+	{78, 196}:  -27,  // N Adieresis
+	{78, 197}:  -27,  // N Aring
+	{79, 65}:   -55,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -50,  // O W
+	{79, 88}:   -40,  // O X
+	{79, 89}:   -50,  // O Y
+	{79, 159}:  -50,  // O Ydieresis
+	{79, 192}:  -55,  // O Agrave
+	{79, 193}:  -55,  // O Aacute
+	{79, 194}:  -55,  // O Acircumflex
+	{79, 195}:  -55,  // O Atilde
+	{79, 196}:  -55,  // O Adieresis
+	{79, 197}:  -55,  // O Aring
+	{79, 221}:  -50,  // O Yacute
+	{80, 44}:   -135, // P comma
+	{80, 46}:   -135, // P period
+	{80, 65}:   -90,  // P A
+	{80, 97}:   -80,  // P a
+	{80, 101}:  -80,  // P e
+	{80, 111}:  -80,  // P o
+	{80, 192}:  -90,  // P Agrave
+	{80, 193}:  -90,  // P Aacute
+	{80, 194}:  -90,  // P Acircumflex
+	{80, 195}:  -90,  // P Atilde
+	{80, 196}:  -90,  // P Adieresis
+	{80, 197}:  -90,  // P Aring
+	{80, 224}:  -80,  // P agrave
+	{80, 225}:  -80,  // P aacute
+	{80, 226}:  -80,  // P acircumflex
+	{80, 227}:  -80,  // P atilde
+	{80, 228}:  -80,  // P adieresis
+	{80, 229}:  -80,  // P aring
+	{80, 232}:  -80,  // P egrave
+	{80, 233}:  -80,  // P eacute
+	{80, 234}:  -80,  // P ecircumflex
+	{80, 235}:  -80,  // P edieresis
+	{80, 242}:  -80,  // P ograve
+	{80, 243}:  -80,  // P oacute
+	{80, 244}:  -80,  // P ocircumflex
+	{80, 245}:  -80,  // P otilde
+	{80, 246}:  -80,  // P odieresis
+	{80, 248}:  -80,  // P oslash
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -40,  // R O
+	{82, 85}:   -40,  // R U
+	{82, 86}:   -18,  // R V
+	{82, 87}:   -18,  // R W
+	{82, 89}:   -18,  // R Y
+	{82, 159}:  -18,  // R Ydieresis
+	{82, 210}:  -40,  // R Ograve
+	{82, 211}:  -40,  // R Oacute
+	{82, 212}:  -40,  // R Ocircumflex
+	{82, 213}:  -40,  // R Otilde
+	{82, 214}:  -40,  // R Odieresis
+	{82, 216}:  -40,  // R Oslash
+	{82, 217}:  -40,  // R Ugrave
+	{82, 218}:  -40,  // R Uacute
+	{82, 219}:  -40,  // R Ucircumflex
+	{82, 220}:  -40,  // R Udieresis
+	{82, 221}:  -18,  // R Yacute
+	{84, 44}:   -74,  // T comma
+	{84, 45}:   -74,  // T hyphen
+	{84, 46}:   -74,  // T period
+	{84, 58}:   -55,  // T colon
+	{84, 59}:   -65,  // T semicolon
+	{84, 65}:   -50,  // T A
+	{84, 79}:   -18,  // T O
+	{84, 97}:   -92,  // T a
+	{84, 101}:  -92,  // T e
+	{84, 105}:  -55,  // T i
+	{84, 111}:  -92,  // T o
+	{84, 114}:  -55,  // T r
+	{84, 117}:  -55,  // T u
+	{84, 119}:  -74,  // T w
+	{84, 121}:  -74,  // T y
+	{84, 173}:  -74,  // T hyphen
+	{84, 192}:  -50,  // T Agrave
+	{84, 193}:  -50,  // T Aacute
+	{84, 194}:  -50,  // T Acircumflex
+	{84, 195}:  -50,  // T Atilde
+	{84, 196}:  -50,  // T Adieresis
+	{84, 197}:  -50,  // T Aring
+	{84, 210}:  -18,  // T Ograve
+	{84, 211}:  -18,  // T Oacute
+	{84, 212}:  -18,  // T Ocircumflex
+	{84, 213}:  -18,  // T Otilde
+	{84, 214}:  -18,  // T Odieresis
+	{84, 216}:  -18,  // T Oslash
+	{84, 224}:  -92,  // T agrave
+	{84, 225}:  -92,  // T aacute
+	{84, 226}:  -92,  // T acircumflex
+	{84, 227}:  -92,  // T atilde
+	{84, 228}:  -92,  // T adieresis
+	{84, 229}:  -92,  // T aring
+	{84, 232}:  -52,  // T egrave
+	{84, 233}:  -92,  // T eacute
+	{84, 234}:  -52,  // T ecircumflex
+	{84, 235}:  -52,  // T edieresis
+	{84, 237}:  -55,  // T iacute
+	{84, 242}:  -92,  // T ograve
+	{84, 243}:  -92,  // T oacute
+	{84, 244}:  -92,  // T ocircumflex
+	{84, 245}:  -92,  // T otilde
+	{84, 246}:  -92,  // T odieresis
+	{84, 248}:  -92,  // T oslash
+	{84, 249}:  -55,  // T ugrave
+	{84, 250}:  -55,  // T uacute
+	{84, 251}:  -55,  // T ucircumflex
+	{84, 252}:  -55,  // T udieresis
+	{84, 253}:  -74,  // T yacute
+	{84, 255}:  -34,  // T ydieresis
+	{85, 44}:   -25,  // U comma
+	{85, 46}:   -25,  // U period
+	{85, 65}:   -40,  // U A
+	{85, 192}:  -40,  // U Agrave
+	{85, 193}:  -40,  // U Aacute
+	{85, 194}:  -40,  // U Acircumflex
+	{85, 195}:  -40,  // U Atilde
+	{85, 196}:  -40,  // U Adieresis
+	{85, 197}:  -40,  // U Aring
+	{86, 44}:   -129, // V comma
+	{86, 45}:   -55,  // V hyphen
+	{86, 46}:   -129, // V period
+	{86, 58}:   -65,  // V colon
+	{86, 59}:   -74,  // V semicolon
+	{86, 65}:   -60,  // V A
+	{86, 79}:   -30,  // V O
+	{86, 97}:   -111, // V a
+	{86, 101}:  -111, // V e
+	{86, 105}:  -74,  // V i
+	{86, 111}:  -111, // V o
+	{86, 117}:  -74,  // V u
+	{86, 173}:  -55,  // V hyphen
+	{86, 192}:  -60,  // V Agrave
+	{86, 193}:  -60,  // V Aacute
+	{86, 194}:  -60,  // V Acircumflex
+	{86, 195}:  -60,  // V Atilde
+	{86, 196}:  -60,  // V Adieresis
+	{86, 197}:  -60,  // V Aring
+	{86, 210}:  -30,  // V Ograve
+	{86, 211}:  -30,  // V Oacute
+	{86, 212}:  -30,  // V Ocircumflex
+	{86, 213}:  -30,  // V Otilde
+	{86, 214}:  -30,  // V Odieresis
+	{86, 216}:  -30,  // V Oslash
+	{86, 224}:  -111, // V agrave
+	{86, 225}:  -111, // V aacute
+	{86, 226}:  -111, // V acircumflex
+	{86, 227}:  -111, // V atilde
+	{86, 228}:  -111, // V adieresis
+	{86, 229}:  -111, // V aring
+	{86, 232}:  -71,  // V egrave
+	{86, 233}:  -111, // V eacute
+	{86, 234}:  -111, // V ecircumflex
+	{86, 235}:  -71,  // V edieresis
+	{86, 236}:  -34,  // V igrave
+	{86, 237}:  -74,  // V iacute
+	{86, 238}:  -34,  // V icircumflex
+	{86, 239}:  -34,  // V idieresis
+	{86, 242}:  -111, // V ograve
+	{86, 243}:  -111, // V oacute
+	{86, 244}:  -111, // V ocircumflex
+	{86, 245}:  -111, // V otilde
+	{86, 246}:  -111, // V odieresis
+	{86, 248}:  -111, // V oslash
+	{86, 249}:  -74,  // V ugrave
+	{86, 250}:  -74,  // V uacute
+	{86, 251}:  -74,  // V ucircumflex
+	{86, 252}:  -74,  // V udieresis
+	{87, 44}:   -92,  // W comma
+	{87, 45}:   -37,  // W hyphen
+	{87, 46}:   -92,  // W period
+	{87, 58}:   -65,  // W colon
+	{87, 59}:   -65,  // W semicolon
+	{87, 65}:   -60,  // W A
+	{87, 79}:   -25,  // W O
+	{87, 97}:   -92,  // W a
+	{87, 101}:  -92,  // W e
+	{87, 105}:  -55,  // W i
+	{87, 111}:  -92,  // W o
+	{87, 117}:  -55,  // W u
+	{87, 121}:  -70,  // W y
+	{87, 173}:  -37,  // W hyphen
+	{87, 192}:  -60,  // W Agrave
+	{87, 193}:  -60,  // W Aacute
+	{87, 194}:  -60,  // W Acircumflex
+	{87, 195}:  -60,  // W Atilde
+	{87, 196}:  -60,  // W Adieresis
+	{87, 197}:  -60,  // W Aring
+	{87, 210}:  -25,  // W Ograve
+	{87, 211}:  -25,  // W Oacute
+	{87, 212}:  -25,  // W Ocircumflex
+	{87, 213}:  -25,  // W Otilde
+	{87, 214}:  -25,  // W Odieresis
+	{87, 216}:  -25,  // W Oslash
+	{87, 224}:  -92,  // W agrave
+	{87, 225}:  -92,  // W aacute
+	{87, 226}:  -92,  // W acircumflex
+	{87, 227}:  -92,  // W atilde
+	{87, 228}:  -92,  // W adieresis
+	{87, 229}:  -92,  // W aring
+	{87, 232}:  -52,  // W egrave
+	{87, 233}:  -92,  // W eacute
+	{87, 234}:  -92,  // W ecircumflex
+	{87, 235}:  -52,  // W edieresis
+	{87, 237}:  -55,  // W iacute
+	{87, 242}:  -92,  // W ograve
+	{87, 243}:  -92,  // W oacute
+	{87, 244}:  -92,  // W ocircumflex
+	{87, 245}:  -92,  // W otilde
+	{87, 246}:  -92,  // W odieresis
+	{87, 248}:  -92,  // W oslash
+	{87, 249}:  -55,  // W ugrave
+	{87, 250}:  -55,  // W uacute
+	{87, 251}:  -55,  // W ucircumflex
+	{87, 252}:  -55,  // W udieresis
+	{87, 253}:  -70,  // W yacute
+	{87, 255}:  -70,  // W ydieresis
+	{89, 44}:   -92,  // Y comma
+	{89, 45}:   -74,  // Y hyphen
+	{89, 46}:   -92,  // Y period
+	{89, 58}:   -65,  // Y colon
+	{89, 59}:   -65,  // Y semicolon
+	{89, 65}:   -50,  // Y A
+	{89, 79}:   -15,  // Y O
+	{89, 97}:   -92,  // Y a
+	{89, 101}:  -92,  // Y e
+	{89, 105}:  -74,  // Y i
+	{89, 111}:  -92,  // Y o
+	{89, 117}:  -92,  // Y u
+	{89, 173}:  -74,  // Y hyphen
+	{89, 192}:  -50,  // Y Agrave
+	{89, 193}:  -50,  // Y Aacute
+	{89, 194}:  -50,  // Y Acircumflex
+	{89, 195}:  -50,  // Y Atilde
+	{89, 196}:  -50,  // Y Adieresis
+	{89, 197}:  -50,  // Y Aring
+	{89, 210}:  -15,  // Y Ograve
+	{89, 211}:  -15,  // Y Oacute
+	{89, 212}:  -15,  // Y Ocircumflex
+	{89, 213}:  -15,  // Y Otilde
+	{89, 214}:  -15,  // Y Odieresis
+	{89, 216}:  -15,  // Y Oslash
+	{89, 224}:  -92,  // Y agrave
+	{89, 225}:  -92,  // Y aacute
+	{89, 226}:  -92,  // Y acircumflex
+	{89, 227}:  -92,  // Y atilde
+	{89, 228}:  -92,  // Y adieresis
+	{89, 229}:  -92,  // Y aring
+	{89, 232}:  -52,  // Y egrave
+	{89, 233}:  -92,  // Y eacute
+	{89, 234}:  -92,  // Y ecircumflex
+	{89, 235}:  -52,  // Y edieresis
+	{89, 236}:  -34,  // Y igrave
+	{89, 237}:  -74,  // Y iacute
+	{89, 238}:  -34,  // Y icircumflex
+	{89, 239}:  -34,  // Y idieresis
+	{89, 242}:  -92,  // Y ograve
+	{89, 243}:  -92,  // Y oacute
+	{89, 244}:  -92,  // Y ocircumflex
+	{89, 245}:  -92,  // Y otilde
+	{89, 246}:  -92,  // Y odieresis
+	{89, 248}:  -92,  // Y oslash
+	{89, 249}:  -92,  // Y ugrave
+	{89, 250}:  -92,  // Y uacute
+	{89, 251}:  -92,  // Y ucircumflex
+	{89, 252}:  -92,  // Y udieresis
+	{97, 103}:  -10,  // a g
+	{98, 46}:   -40,  // b period
+	{98, 117}:  -20,  // b u
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{99, 104}:  -15,  // c h
+	{99, 107}:  -20,  // c k
+	{101, 44}:  -10,  // e comma
+	{101, 46}:  -15,  // e period
+	{101, 103}: -40,  // e g
+	{101, 118}: -15,  // e v
+	{101, 119}: -15,  // e w
+	{101, 120}: -20,  // e x
+	{101, 121}: -30,  // e y
+	{101, 253}: -30,  // e yacute
+	{101, 255}: -30,  // e ydieresis
+	{102, 44}:  -10,  // f comma
+	{102, 46}:  -15,  // f period
+	{102, 102}: -18,  // f f
+	{102, 105}: -20,  // f i
+	{102, 146}: 92,   // f quoteright
+	{103, 44}:  -10,  // g comma
+	{103, 46}:  -15,  // g period
+	{103, 101}: -10,  // g e
+	{103, 103}: -10,  // g g
+	{103, 232}: -10,  // g egrave
+	{103, 233}: -10,  // g eacute
+	{103, 234}: -10,  // g ecircumflex
+	{103, 235}: -10,  // g edieresis
+	{107, 101}: -10,  // k e
+	{107, 111}: -10,  // k o
+	{107, 121}: -10,  // k y
+	{107, 232}: -10,  // k egrave
+	{107, 233}: -10,  // k eacute
+	{107, 234}: -10,  // k ecircumflex
+	{107, 235}: -10,  // k edieresis
+	{107, 242}: -10,  // k ograve
+	{107, 243}: -10,  // k oacute
+	{107, 244}: -10,  // k ocircumflex
+	{107, 245}: -10,  // k otilde
+	{107, 246}: -10,  // k odieresis
+	{107, 248}: -10,  // k oslash
+	{107, 253}: -10,  // k yacute
+	{107, 255}: -10,  // k ydieresis
+	{110, 118}: -40,  // n v
+	{111, 103}: -10,  // o g
+	{111, 118}: -10,  // o v
+	{114, 44}:  -111, // r comma
+	{114, 45}:  -20,  // r hyphen
+	{114, 46}:  -111, // r period
+	{114, 97}:  -15,  // r a
+	{114, 99}:  -37,  // r c
+	{114, 100}: -37,  // r d
+	{114, 101}: -37,  // r e
+	{114, 103}: -37,  // r g
+	{114, 111}: -45,  // r o
+	{114, 113}: -37,  // r q
+	{114, 115}: -10,  // r s
+	{114, 154}: -10,  // r scaron
+	{114, 173}: -20,  // r hyphen
+	{114, 224}: -15,  // r agrave
+	{114, 225}: -15,  // r aacute
+	{114, 226}: -15,  // r acircumflex
+	{114, 227}: -15,  // r atilde
+	{114, 228}: -15,  // r adieresis
+	{114, 229}: -15,  // r aring
+	{114, 231}: -37,  // r ccedilla
+	{114, 232}: -37,  // r egrave
+	{114, 233}: -37,  // r eacute
+	{114, 234}: -37,  // r ecircumflex
+	{114, 235}: -37,  // r edieresis
+	{114, 242}: -45,  // r ograve
+	{114, 243}: -45,  // r oacute
+	{114, 244}: -45,  // r ocircumflex
+	{114, 245}: -45,  // r otilde
+	{114, 246}: -45,  // r odieresis
+	{114, 248}: -45,  // r oslash
+	{118, 44}:  -74,  // v comma
+	{118, 46}:  -74,  // v period
+	{119, 44}:  -74,  // w comma
+	{119, 46}:  -74,  // w period
+	{121, 44}:  -55,  // y comma
+	{121, 46}:  -55,  // y period
+	{145, 145}: -111, // quoteleft quoteleft
+	{146, 32}:  -111, // quoteright space
+	{146, 100}: -25,  // quoteright d
+	{146, 114}: -25,  // quoteright r
+	{146, 115}: -40,  // quoteright s
+	{146, 116}: -30,  // quoteright t
+	{146, 118}: -10,  // quoteright v
+	{146, 146}: -111, // quoteright quoteright
+	{146, 154}: -40,  // quoteright scaron
+	{146, 160}: -111, // quoteright space
+	{159, 44}:  -92,  // Ydieresis comma
+	{159, 45}:  -74,  // Ydieresis hyphen
+	{159, 46}:  -92,  // Ydieresis period
+	{159, 58}:  -65,  // Ydieresis colon
+	{159, 59}:  -65,  // Ydieresis semicolon
+	{159, 65}:  -50,  // Ydieresis A
+	{159, 79}:  -15,  // Ydieresis O
+	{159, 97}:  -92,  // Ydieresis a
+	{159, 101}: -92,  // Ydieresis e
+	{159, 105}: -74,  // Ydieresis i
+	{159, 111}: -92,  // Ydieresis o
+	{159, 117}: -92,  // Ydieresis u
+	{159, 173}: -74,  // Ydieresis hyphen
+	{159, 192}: -50,  // Ydieresis Agrave
+	{159, 193}: -50,  // Ydieresis Aacute
+	{159, 194}: -50,  // Ydieresis Acircumflex
+	{159, 195}: -50,  // Ydieresis Atilde
+	{159, 196}: -50,  // Ydieresis Adieresis
+	{159, 197}: -50,  // Ydieresis Aring
+	{159, 210}: -15,  // Ydieresis Ograve
+	{159, 211}: -15,  // Ydieresis Oacute
+	{159, 212}: -15,  // Ydieresis Ocircumflex
+	{159, 213}: -15,  // Ydieresis Otilde
+	{159, 214}: -15,  // Ydieresis Odieresis
+	{159, 216}: -15,  // Ydieresis Oslash
+	{159, 224}: -92,  // Ydieresis agrave
+	{159, 225}: -92,  // Ydieresis aacute
+	{159, 226}: -92,  // Ydieresis acircumflex
+	{159, 227}: -92,  // Ydieresis atilde
+	{159, 228}: -92,  // Ydieresis adieresis
+	{159, 229}: -92,  // Ydieresis aring
+	{159, 232}: -52,  // Ydieresis egrave
+	{159, 233}: -92,  // Ydieresis eacute
+	{159, 234}: -92,  // Ydieresis ecircumflex
+	{159, 235}: -52,  // Ydieresis edieresis
+	{159, 236}: -34,  // Ydieresis igrave
+	{159, 237}: -74,  // Ydieresis iacute
+	{159, 238}: -34,  // Ydieresis icircumflex
+	{159, 239}: -34,  // Ydieresis idieresis
+	{159, 242}: -92,  // Ydieresis ograve
+	{159, 243}: -92,  // Ydieresis oacute
+	{159, 244}: -92,  // Ydieresis ocircumflex
+	{159, 245}: -92,  // Ydieresis otilde
+	{159, 246}: -92,  // Ydieresis odieresis
+	{159, 248}: -92,  // Ydieresis oslash
+	{159, 249}: -92,  // Ydieresis ugrave
+	{159, 250}: -92,  // Ydieresis uacute
+	{159, 251}: -92,  // Ydieresis ucircumflex
+	{159, 252}: -92,  // Ydieresis udieresis
+	{160, 65}:  -18,  // space A
+	{160, 84}:  -18,  // space T
+	{160, 86}:  -35,  // space V
+	{160, 87}:  -40,  // space W
+	{160, 89}:  -75,  // space Y
+	{160, 159}: -75,  // space Ydieresis
+	{160, 192}: -18,  // space Agrave
+	{160, 193}: -18,  // space Aacute
+	{160, 194}: -18,  // space Acircumflex
+	{160, 195}: -18,  // space Atilde
+	{160, 196}: -18,  // space Adieresis
+	{160, 197}: -18,  // space Aring
+	{160, 221}: -75,  // space Yacute
+	{192, 67}:  -30,  // Agrave C
+	{192, 71}:  -35,  // Agrave G
+	{192, 79}:  -40,  // Agrave O
+	{192, 81}:  -40,  // Agrave Q
+	{192, 84}:  -37,  // Agrave T
+	{192, 85}:  -50,  // Agrave U
+	{192, 86}:  -105, // Agrave V
+	{192, 87}:  -95,  // Agrave W
+	{192, 89}:  -55,  // Agrave Y
+	{192, 117}: -20,  // Agrave u
+	{192, 118}: -55,  // Agrave v
+	{192, 119}: -55,  // Agrave w
+	{192, 121}: -55,  // Agrave y
+	{192, 146}: -37,  // Agrave quoteright
+	{192, 159}: -55,  // Agrave Ydieresis
+	{192, 199}: -30,  // Agrave Ccedilla
+	{192, 210}: -40,  // Agrave Ograve
+	{192, 211}: -40,  // Agrave Oacute
+	{192, 212}: -40,  // Agrave Ocircumflex
+	{192, 213}: -40,  // Agrave Otilde
+	{192, 214}: -40,  // Agrave Odieresis
+	{192, 216}: -40,  // Agrave Oslash
+	{192, 217}: -50,  // Agrave Ugrave
+	{192, 218}: -50,  // Agrave Uacute
+	{192, 219}: -50,  // Agrave Ucircumflex
+	{192, 220}: -50,  // Agrave Udieresis
+	{192, 221}: -55,  // Agrave Yacute
+	{192, 249}: -20,  // Agrave ugrave
+	{192, 250}: -20,  // Agrave uacute
+	{192, 251}: -20,  // Agrave ucircumflex
+	{192, 252}: -20,  // Agrave udieresis
+	{192, 253}: -55,  // Agrave yacute
+	{192, 255}: -55,  // Agrave ydieresis
+	{193, 67}:  -30,  // Aacute C
+	{193, 71}:  -35,  // Aacute G
+	{193, 79}:  -40,  // Aacute O
+	{193, 81}:  -40,  // Aacute Q
+	{193, 84}:  -37,  // Aacute T
+	{193, 85}:  -50,  // Aacute U
+	{193, 86}:  -105, // Aacute V
+	{193, 87}:  -95,  // Aacute W
+	{193, 89}:  -55,  // Aacute Y
+	{193, 117}: -20,  // Aacute u
+	{193, 118}: -55,  // Aacute v
+	{193, 119}: -55,  // Aacute w
+	{193, 121}: -55,  // Aacute y
+	{193, 146}: -37,  // Aacute quoteright
+	{193, 159}: -55,  // Aacute Ydieresis
+	{193, 199}: -30,  // Aacute Ccedilla
+	{193, 210}: -40,  // Aacute Ograve
+	{193, 211}: -40,  // Aacute Oacute
+	{193, 212}: -40,  // Aacute Ocircumflex
+	{193, 213}: -40,  // Aacute Otilde
+	{193, 214}: -40,  // Aacute Odieresis
+	{193, 216}: -40,  // Aacute Oslash
+	{193, 217}: -50,  // Aacute Ugrave
+	{193, 218}: -50,  // Aacute Uacute
+	{193, 219}: -50,  // Aacute Ucircumflex
+	{193, 220}: -50,  // Aacute Udieresis
+	{193, 221}: -55,  // Aacute Yacute
+	{193, 249}: -20,  // Aacute ugrave
+	{193, 250}: -20,  // Aacute uacute
+	{193, 251}: -20,  // Aacute ucircumflex
+	{193, 252}: -20,  // Aacute udieresis
+	{193, 253}: -55,  // Aacute yacute
+	{193, 255}: -55,  // Aacute ydieresis
+	{194, 67}:  -30,  // Acircumflex C
+	{194, 71}:  -35,  // Acircumflex G
+	{194, 79}:  -40,  // Acircumflex O
+	{194, 81}:  -40,  // Acircumflex Q
+	{194, 84}:  -37,  // Acircumflex T
+	{194, 85}:  -50,  // Acircumflex U
+	{194, 86}:  -105, // Acircumflex V
+	{194, 87}:  -95,  // Acircumflex W
+	{194, 89}:  -55,  // Acircumflex Y
+	{194, 117}: -20,  // Acircumflex u
+	{194, 118}: -55,  // Acircumflex v
+	{194, 119}: -55,  // Acircumflex w
+	{194, 121}: -55,  // Acircumflex y
+	{194, 146}: -37,  // Acircumflex quoteright
+	{194, 159}: -55,  // Acircumflex Ydieresis
+	{194, 199}: -30,  // Acircumflex Ccedilla
+	{194, 210}: -40,  // Acircumflex Ograve
+	{194, 211}: -40,  // Acircumflex Oacute
+	{194, 212}: -40,  // Acircumflex Ocircumflex
+	{194, 213}: -40,  // Acircumflex Otilde
+	{194, 214}: -40,  // Acircumflex Odieresis
+	{194, 216}: -40,  // Acircumflex Oslash
+	{194, 217}: -50,  // Acircumflex Ugrave
+	{194, 218}: -50,  // Acircumflex Uacute
+	{194, 219}: -50,  // Acircumflex Ucircumflex
+	{194, 220}: -50,  // Acircumflex Udieresis
+	{194, 221}: -55,  // Acircumflex Yacute
+	{194, 249}: -20,  // Acircumflex ugrave
+	{194, 250}: -20,  // Acircumflex uacute
+	{194, 251}: -20,  // Acircumflex ucircumflex
+	{194, 252}: -20,  // Acircumflex udieresis
+	{194, 253}: -55,  // Acircumflex yacute
+	{194, 255}: -55,  // Acircumflex ydieresis
+	{195, 67}:  -30,  // Atilde C
+	{195, 71}:  -35,  // Atilde G
+	{195, 79}:  -40,  // Atilde O
+	{195, 81}:  -40,  // Atilde Q
+	{195, 84}:  -37,  // Atilde T
+	{195, 85}:  -50,  // Atilde U
+	{195, 86}:  -105, // Atilde V
+	{195, 87}:  -95,  // Atilde W
+	{195, 89}:  -55,  // Atilde Y
+	{195, 117}: -20,  // Atilde u
+	{195, 118}: -55,  // Atilde v
+	{195, 119}: -55,  // Atilde w
+	{195, 121}: -55,  // Atilde y
+	{195, 146}: -37,  // Atilde quoteright
+	{195, 159}: -55,  // Atilde Ydieresis
+	{195, 199}: -30,  // Atilde Ccedilla
+	{195, 210}: -40,  // Atilde Ograve
+	{195, 211}: -40,  // Atilde Oacute
+	{195, 212}: -40,  // Atilde Ocircumflex
+	{195, 213}: -40,  // Atilde Otilde
+	{195, 214}: -40,  // Atilde Odieresis
+	{195, 216}: -40,  // Atilde Oslash
+	{195, 217}: -50,  // Atilde Ugrave
+	{195, 218}: -50,  // Atilde Uacute
+	{195, 219}: -50,  // Atilde Ucircumflex
+	{195, 220}: -50,  // Atilde Udieresis
+	{195, 221}: -55,  // Atilde Yacute
+	{195, 249}: -20,  // Atilde ugrave
+	{195, 250}: -20,  // Atilde uacute
+	{195, 251}: -20,  // Atilde ucircumflex
+	{195, 252}: -20,  // Atilde udieresis
+	{195, 253}: -55,  // Atilde yacute
+	{195, 255}: -55,  // Atilde ydieresis
+	{196, 67}:  -30,  // Adieresis C
+	{196, 71}:  -35,  // Adieresis G
+	{196, 79}:  -40,  // Adieresis O
+	{196, 81}:  -40,  // Adieresis Q
+	{196, 84}:  -37,  // Adieresis T
+	{196, 85}:  -50,  // Adieresis U
+	{196, 86}:  -105, // Adieresis V
+	{196, 87}:  -95,  // Adieresis W
+	{196, 89}:  -55,  // Adieresis Y
+	{196, 117}: -20,  // Adieresis u
+	{196, 118}: -55,  // Adieresis v
+	{196, 119}: -55,  // Adieresis w
+	{196, 121}: -55,  // Adieresis y
+	{196, 146}: -37,  // Adieresis quoteright
+	{196, 159}: -55,  // Adieresis Ydieresis
+	{196, 199}: -30,  // Adieresis Ccedilla
+	{196, 210}: -40,  // Adieresis Ograve
+	{196, 211}: -40,  // Adieresis Oacute
+	{196, 212}: -40,  // Adieresis Ocircumflex
+	{196, 213}: -40,  // Adieresis Otilde
+	{196, 214}: -40,  // Adieresis Odieresis
+	{196, 216}: -40,  // Adieresis Oslash
+	{196, 217}: -50,  // Adieresis Ugrave
+	{196, 218}: -50,  // Adieresis Uacute
+	{196, 219}: -50,  // Adieresis Ucircumflex
+	{196, 220}: -50,  // Adieresis Udieresis
+	{196, 221}: -55,  // Adieresis Yacute
+	{196, 249}: -20,  // Adieresis ugrave
+	{196, 250}: -20,  // Adieresis uacute
+	{196, 251}: -20,  // Adieresis ucircumflex
+	{196, 252}: -20,  // Adieresis udieresis
+	{196, 253}: -55,  // Adieresis yacute
+	{196, 255}: -55,  // Adieresis ydieresis
+	{197, 67}:  -30,  // Aring C
+	{197, 71}:  -35,  // Aring G
+	{197, 79}:  -40,  // Aring O
+	{197, 81}:  -40,  // Aring Q
+	{197, 84}:  -37,  // Aring T
+	{197, 85}:  -50,  // Aring U
+	{197, 86}:  -105, // Aring V
+	{197, 87}:  -95,  // Aring W
+	{197, 89}:  -55,  // Aring Y
+	{197, 117}: -20,  // Aring u
+	{197, 118}: -55,  // Aring v
+	{197, 119}: -55,  // Aring w
+	{197, 121}: -55,  // Aring y
+	{197, 146}: -37,  // Aring quoteright
+	{197, 159}: -55,  // Aring Ydieresis
+	{197, 199}: -30,  // Aring Ccedilla
+	{197, 210}: -40,  // Aring Ograve
+	{197, 211}: -40,  // Aring Oacute
+	{197, 212}: -40,  // Aring Ocircumflex
+	{197, 213}: -40,  // Aring Otilde
+	{197, 214}: -40,  // Aring Odieresis
+	{197, 216}: -40,  // Aring Oslash
+	{197, 217}: -50,  // Aring Ugrave
+	{197, 218}: -50,  // Aring Uacute
+	{197, 219}: -50,  // Aring Ucircumflex
+	{197, 220}: -50,  // Aring Udieresis
+	{197, 221}: -55,  // Aring Yacute
+	{197, 249}: -20,  // Aring ugrave
+	{197, 250}: -20,  // Aring uacute
+	{197, 251}: -20,  // Aring ucircumflex
+	{197, 252}: -20,  // Aring udieresis
+	{197, 253}: -55,  // Aring yacute
+	{197, 255}: -55,  // Aring ydieresis
+	{209, 65}:  -27,  // Ntilde A
+	{209, 192}: -27,  // Ntilde Agrave
+	{209, 193}: -27,  // Ntilde Aacute
+	{209, 194}: -27,  // Ntilde Acircumflex
+	{209, 195}: -27,  // Ntilde Atilde
+	{209, 196}: -27,  // Ntilde Adieresis
+	{209, 197}: -27,  // Ntilde Aring
+	{210, 65}:  -55,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -50,  // Ograve W
+	{210, 88}:  -40,  // Ograve X
+	{210, 89}:  -50,  // Ograve Y
+	{210, 159}: -50,  // Ograve Ydieresis
+	{210, 192}: -55,  // Ograve Agrave
+	{210, 193}: -55,  // Ograve Aacute
+	{210, 194}: -55,  // Ograve Acircumflex
+	{210, 195}: -55,  // Ograve Atilde
+	{210, 196}: -55,  // Ograve Adieresis
+	{210, 197}: -55,  // Ograve Aring
+	{210, 221}: -50,  // Ograve Yacute
+	{211, 65}:  -55,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -50,  // Oacute W
+	{211, 88}:  -40,  // Oacute X
+	{211, 89}:  -50,  // Oacute Y
+	{211, 159}: -50,  // Oacute Ydieresis
+	{211, 192}: -55,  // Oacute Agrave
+	{211, 193}: -55,  // Oacute Aacute
+	{211, 194}: -55,  // Oacute Acircumflex
+	{211, 195}: -55,  // Oacute Atilde
+	{211, 196}: -55,  // Oacute Adieresis
+	{211, 197}: -55,  // Oacute Aring
+	{211, 221}: -50,  // Oacute Yacute
+	{212, 65}:  -55,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -50,  // Ocircumflex W
+	{212, 88}:  -40,  // Ocircumflex X
+	{212, 89}:  -50,  // Ocircumflex Y
+	{212, 159}: -50,  // Ocircumflex Ydieresis
+	{212, 192}: -55,  // Ocircumflex Agrave
+	{212, 193}: -55,  // Ocircumflex Aacute
+	{212, 194}: -55,  // Ocircumflex Acircumflex
+	{212, 195}: -55,  // Ocircumflex Atilde
+	{212, 196}: -55,  // Ocircumflex Adieresis
+	{212, 197}: -55,  // Ocircumflex Aring
+	{212, 221}: -50,  // Ocircumflex Yacute
+	{213, 65}:  -55,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -50,  // Otilde W
+	{213, 88}:  -40,  // Otilde X
+	{213, 89}:  -50,  // Otilde Y
+	{213, 159}: -50,  // Otilde Ydieresis
+	{213, 192}: -55,  // Otilde Agrave
+	{213, 193}: -55,  // Otilde Aacute
+	{213, 194}: -55,  // Otilde Acircumflex
+	{213, 195}: -55,  // Otilde Atilde
+	{213, 196}: -55,  // Otilde Adieresis
+	{213, 197}: -55,  // Otilde Aring
+	{213, 221}: -50,  // Otilde Yacute
+	{214, 65}:  -55,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -50,  // Odieresis W
+	{214, 88}:  -40,  // Odieresis X
+	{214, 89}:  -50,  // Odieresis Y
+	{214, 159}: -50,  // Odieresis Ydieresis
+	{214, 192}: -55,  // Odieresis Agrave
+	{214, 193}: -55,  // Odieresis Aacute
+	{214, 194}: -55,  // Odieresis Acircumflex
+	{214, 195}: -55,  // Odieresis Atilde
+	{214, 196}: -55,  // Odieresis Adieresis
+	{214, 197}: -55,  // Odieresis Aring
+	{214, 221}: -50,  // Odieresis Yacute
+	{216, 65}:  -55,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -50,  // Oslash W
+	{216, 88}:  -40,  // Oslash X
+	{216, 89}:  -50,  // Oslash Y
+	{216, 159}: -50,  // Oslash Ydieresis
+	{216, 192}: -55,  // Oslash Agrave
+	{216, 193}: -55,  // Oslash Aacute
+	{216, 194}: -55,  // Oslash Acircumflex
+	{216, 195}: -55,  // Oslash Atilde
+	{216, 196}: -55,  // Oslash Adieresis
+	{216, 197}: -55,  // Oslash Aring
+	{216, 221}: -50,  // Oslash Yacute
+	{217, 44}:  -25,  // Ugrave comma
+	{217, 46}:  -25,  // Ugrave period
+	{217, 65}:  -40,  // Ugrave A
+	{217, 192}: -40,  // Ugrave Agrave
+	{217, 193}: -40,  // Ugrave Aacute
+	{217, 194}: -40,  // Ugrave Acircumflex
+	{217, 195}: -40,  // Ugrave Atilde
+	{217, 196}: -40,  // Ugrave Adieresis
+	{217, 197}: -40,  // Ugrave Aring
+	{218, 44}:  -25,  // Uacute comma
+	{218, 46}:  -25,  // Uacute period
+	{218, 65}:  -40,  // Uacute A
+	{218, 192}: -40,  // Uacute Agrave
+	{218, 193}: -40,  // Uacute Aacute
+	{218, 194}: -40,  // Uacute Acircumflex
+	{218, 195}: -40,  // Uacute Atilde
+	{218, 196}: -40,  // Uacute Adieresis
+	{218, 197}: -40,  // Uacute Aring
+	{219, 44}:  -25,  // Ucircumflex comma
+	{219, 46}:  -25,  // Ucircumflex period
+	{219, 65}:  -40,  // Ucircumflex A
+	{219, 192}: -40,  // Ucircumflex Agrave
+	{219, 193}: -40,  // Ucircumflex Aacute
+	{219, 194}: -40,  // Ucircumflex Acircumflex
+	{219, 195}: -40,  // Ucircumflex Atilde
+	{219, 196}: -40,  // Ucircumflex Adieresis
+	{219, 197}: -40,  // Ucircumflex Aring
+	{220, 44}:  -25,  // Udieresis comma
+	{220, 46}:  -25,  // Udieresis period
+	{220, 65}:  -40,  // Udieresis A
+	{220, 192}: -40,  // Udieresis Agrave
+	{220, 193}: -40,  // Udieresis Aacute
+	{220, 194}: -40,  // Udieresis Acircumflex
+	{220, 195}: -40,  // Udieresis Atilde
+	{220, 196}: -40,  // Udieresis Adieresis
+	{220, 197}: -40,  // Udieresis Aring
+	{221, 44}:  -92,  // Yacute comma
+	{221, 45}:  -74,  // Yacute hyphen
+	{221, 46}:  -92,  // Yacute period
+	{221, 58}:  -65,  // Yacute colon
+	{221, 59}:  -65,  // Yacute semicolon
+	{221, 65}:  -50,  // Yacute A
+	{221, 79}:  -15,  // Yacute O
+	{221, 97}:  -92,  // Yacute a
+	{221, 101}: -92,  // Yacute e
+	{221, 105}: -74,  // Yacute i
+	{221, 111}: -92,  // Yacute o
+	{221, 117}: -92,  // Yacute u
+	{221, 173}: -74,  // Yacute hyphen
+	{221, 192}: -50,  // Yacute Agrave
+	{221, 193}: -50,  // Yacute Aacute
+	{221, 194}: -50,  // Yacute Acircumflex
+	{221, 195}: -50,  // Yacute Atilde
+	{221, 196}: -50,  // Yacute Adieresis
+	{221, 197}: -50,  // Yacute Aring
+	{221, 210}: -15,  // Yacute Ograve
+	{221, 211}: -15,  // Yacute Oacute
+	{221, 212}: -15,  // Yacute Ocircumflex
+	{221, 213}: -15,  // Yacute Otilde
+	{221, 214}: -15,  // Yacute Odieresis
+	{221, 216}: -15,  // Yacute Oslash
+	{221, 224}: -92,  // Yacute agrave
+	{221, 225}: -92,  // Yacute aacute
+	{221, 226}: -92,  // Yacute acircumflex
+	{221, 227}: -92,  // Yacute atilde
+	{221, 228}: -92,  // Yacute adieresis
+	{221, 229}: -92,  // Yacute aring
+	{221, 232}: -52,  // Yacute egrave
+	{221, 233}: -92,  // Yacute eacute
+	{221, 234}: -92,  // Yacute ecircumflex
+	{221, 235}: -52,  // Yacute edieresis
+	{221, 236}: -34,  // Yacute igrave
+	{221, 237}: -74,  // Yacute iacute
+	{221, 238}: -34,  // Yacute icircumflex
+	{221, 239}: -34,  // Yacute idieresis
+	{221, 242}: -92,  // Yacute ograve
+	{221, 243}: -92,  // Yacute oacute
+	{221, 244}: -92,  // Yacute ocircumflex
+	{221, 245}: -92,  // Yacute otilde
+	{221, 246}: -92,  // Yacute odieresis
+	{221, 248}: -92,  // Yacute oslash
+	{221, 249}: -92,  // Yacute ugrave
+	{221, 250}: -92,  // Yacute uacute
+	{221, 251}: -92,  // Yacute ucircumflex
+	{221, 252}: -92,  // Yacute udieresis
+	{224, 103}: -10,  // agrave g
+	{225, 103}: -10,  // aacute g
+	{226, 103}: -10,  // acircumflex g
+	{227, 103}: -10,  // atilde g
+	{228, 103}: -10,  // adieresis g
+	{229, 103}: -10,  // aring g
+	{231, 104}: -15,  // ccedilla h
+	{231, 107}: -20,  // ccedilla k
+	{232, 44}:  -10,  // egrave comma
+	{232, 46}:  -15,  // egrave period
+	{232, 103}: -40,  // egrave g
+	{232, 118}: -15,  // egrave v
+	{232, 119}: -15,  // egrave w
+	{232, 120}: -20,  // egrave x
+	{232, 121}: -30,  // egrave y
+	{232, 253}: -30,  // egrave yacute
+	{232, 255}: -30,  // egrave ydieresis
+	{233, 44}:  -10,  // eacute comma
+	{233, 46}:  -15,  // eacute period
+	{233, 103}: -40,  // eacute g
+	{233, 118}: -15,  // eacute v
+	{233, 119}: -15,  // eacute w
+	{233, 120}: -20,  // eacute x
+	{233, 121}: -30,  // eacute y
+	{233, 253}: -30,  // eacute yacute
+	{233, 255}: -30,  // eacute ydieresis
+	{234, 44}:  -10,  // ecircumflex comma
+	{234, 46}:  -15,  // ecircumflex period
+	{234, 103}: -40,  // ecircumflex g
+	{234, 118}: -15,  // ecircumflex v
+	{234, 119}: -15,  // ecircumflex w
+	{234, 120}: -20,  // ecircumflex x
+	{234, 121}: -30,  // ecircumflex y
+	{234, 253}: -30,  // ecircumflex yacute
+	{234, 255}: -30,  // ecircumflex ydieresis
+	{235, 44}:  -10,  // edieresis comma
+	{235, 46}:  -15,  // edieresis period
+	{235, 103}: -40,  // edieresis g
+	{235, 118}: -15,  // edieresis v
+	{235, 119}: -15,  // edieresis w
+	{235, 120}: -20,  // edieresis x
+	{235, 121}: -30,  // edieresis y
+	{235, 253}: -30,  // edieresis yacute
+	{235, 255}: -30,  // edieresis ydieresis
+	{241, 118}: -40,  // ntilde v
+	{242, 103}: -10,  // ograve g
+	{242, 118}: -10,  // ograve v
+	{243, 103}: -10,  // oacute g
+	{243, 118}: -10,  // oacute v
+	{244, 103}: -10,  // ocircumflex g
+	{244, 118}: -10,  // ocircumflex v
+	{245, 103}: -10,  // otilde g
+	{245, 118}: -10,  // otilde v
+	{246, 103}: -10,  // odieresis g
+	{246, 118}: -10,  // odieresis v
+	{248, 103}: -10,  // oslash g
+	{248, 118}: -10,  // oslash v
+	{253, 44}:  -55,  // yacute comma
+	{253, 46}:  -55,  // yacute period
+	{255, 44}:  -55,  // ydieresis comma
+	{255, 46}:  -55,  // ydieresis period
 }
 
 // Times-Roman
+var timesRomanMetrics = FontMetrics{
+	Ascender:    683,
+	Descender:   -217,
+	CapHeight:   662,
+	XHeight:     450,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-168, -218}, Point{1000, 898}},
+}
+
 var timesRomanWidths = []uint16{
-	32:  250, // space
-	33:  333, // exclam
-	34:  408, // quotedbl
-	35:  500, // numbersign
-	36:  500, // dollar
-	37:  833, // percent
-	38:  778, // ampersand
-	39:  333, // quoteright
-	40:  333, // parenleft
-	41:  333, // parenright
-	42:  500, // asterisk
-	43:  564, // plus
-	44:  250, // comma
-	45:  333, // hyphen
-	46:  250, // period
-	47:  278, // slash
-	48:  500, // zero
-	49:  500, // one
-	50:  500, // two
-	51:  500, // three
-	52:  500, // four
-	53:  500, // five
-	54:  500, // six
-	55:  500, // seven
-	56:  500, // eight
-	57:  500, // nine
-	58:  278, // colon
-	59:  278, // semicolon
-	60:  564, // less
-	61:  564, // equal
-	62:  564, // greater
-	63:  444, // question
-	64:  921, // at
-	65:  722, // A
-	66:  667, // B
-	67:  667, // C
-	68:  722, // D
-	69:  611, // E
-	70:  556, // F
-	71:  722, // G
-	72:  722, // H
-	73:  333, // I
-	74:  389, // J
-	75:  722, // K
-	76:  611, // L
-	77:  889, // M
-	78:  722, // N
-	79:  722, // O
-	80:  556, // P
-	81:  722, // Q
-	82:  667, // R
-	83:  556, // S
-	84:  611, // T
-	85:  722, // U
-	86:  722, // V
-	87:  944, // W
-	88:  722, // X
-	89:  722, // Y
-	90:  611, // Z
-	91:  333, // bracketleft
-	92:  278, // backslash
-	93:  333, // bracketright
-	94:  469, // asciicircum
-	95:  500, // underscore
-	96:  333, // quoteleft
-	97:  444, // a
-	98:  500, // b
-	99:  444, // c
-	100: 500, // d
-	101: 444, // e
-	102: 333, // f
-	103: 500, // g
-	104: 500, // h
-	105: 278, // i
-	106: 278, // j
-	107: 500, // k
-	108: 278, // l
-	109: 778, // m
-	110: 500, // n
-	111: 500, // o
-	112: 500, // p
-	113: 500, // q
-	114: 333, // r
-	115: 389, // s
-	116: 278, // t
-	117: 500, // u
-	118: 500, // v
-	119: 722, // w
-	120: 500, // x
-	121: 500, // y
-	122: 444, // z
-	123: 480, // braceleft
-	124: 200, // bar
-	125: 480, // braceright
-	126: 541, // asciitilde
+	32:  250,  // space
+	33:  333,  // exclam
+	34:  408,  // quotedbl
+	35:  500,  // numbersign
+	36:  500,  // dollar
+	37:  833,  // percent
+	38:  778,  // ampersand
+	39:  180,  // quotesingle
+	40:  333,  // parenleft
+	41:  333,  // parenright
+	42:  500,  // asterisk
+	43:  564,  // plus
+	44:  250,  // comma
+	45:  333,  // hyphen
+	46:  250,  // period
+	47:  278,  // slash
+	48:  500,  // zero
+	49:  500,  // one
+	50:  500,  // two
+	51:  500,  // three
+	52:  500,  // four
+	53:  500,  // five
+	54:  500,  // six
+	55:  500,  // seven
+	56:  500,  // eight
+	57:  500,  // nine
+	58:  278,  // colon
+	59:  278,  // semicolon
+	60:  564,  // less
+	61:  564,  // equal
+	62:  564,  // greater
+	63:  444,  // question
+	64:  921,  // at
+	65:  722,  // A
+	66:  667,  // B
+	67:  667,  // C
+	68:  722,  // D
+	69:  611,  // E
+	70:  556,  // F
+	71:  722,  // G
+	72:  722,  // H
+	73:  333,  // I
+	74:  389,  // J
+	75:  722,  // K
+	76:  611,  // L
+	77:  889,  // M
+	78:  722,  // N
+	79:  722,  // O
+	80:  556,  // P
+	81:  722,  // Q
+	82:  667,  // R
+	83:  556,  // S
+	84:  611,  // T
+	85:  722,  // U
+	86:  722,  // V
+	87:  944,  // W
+	88:  722,  // X
+	89:  722,  // Y
+	90:  611,  // Z
+	91:  333,  // bracketleft
+	92:  278,  // backslash
+	93:  333,  // bracketright
+	94:  469,  // asciicircum
+	95:  500,  // underscore
+	96:  333,  // grave
+	97:  444,  // a
+	98:  500,  // b
+	99:  444,  // c
+	100: 500,  // d
+	101: 444,  // e
+	102: 333,  // f
+	103: 500,  // g
+	104: 500,  // h
+	105: 278,  // i
+	106: 278,  // j
+	107: 500,  // k
+	108: 278,  // l
+	109: 778,  // m
+	110: 500,  // n
+	111: 500,  // o
+	112: 500,  // p
+	113: 500,  // q
+	114: 333,  // r
+	115: 389,  // s
+	116: 278,  // t
+	117: 500,  // u
+	118: 500,  // v
+	119: 722,  // w
+	120: 500,  // x
+	121: 500,  // y
+	122: 444,  // z
+	123: 480,  // braceleft
+	124: 200,  // bar
+	125: 480,  // braceright
+	126: 541,  // asciitilde
+	128: 500,  // Euro
+	130: 333,  // quotesinglbase
+	131: 500,  // florin
+	132: 444,  // quotedblbase
+	133: 1000, // ellipsis
+	134: 500,  // dagger
+	135: 500,  // daggerdbl
+	136: 333,  // circumflex
+	137: 1000, // perthousand
+	138: 556,  // Scaron
+	139: 333,  // guilsinglleft
+	140: 889,  // OE
+	142: 611,  // Zcaron
+	145: 333,  // quoteleft
+	146: 333,  // quoteright
+	147: 444,  // quotedblleft
+	148: 444,  // quotedblright
+	149: 350,  // bullet
+	150: 500,  // endash
+	151: 1000, // emdash
+	152: 333,  // tilde
+	153: 980,  // trademark
+	154: 389,  // scaron
+	155: 333,  // guilsinglright
+	156: 722,  // oe
+	158: 444,  // zcaron
+	159: 722,  // Ydieresis
+	160: 250,  // space
+	161: 333,  // exclamdown
+	162: 500,  // cent
+	163: 500,  // sterling
+	164: 500,  // currency
+	165: 500,  // yen
+	166: 200,  // brokenbar
+	167: 500,  // section
+	168: 333,  // dieresis
+	169: 760,  // copyright
+	170: 276,  // ordfeminine
+	171: 500,  // guillemotleft
+	172: 564,  // logicalnot
+	173: 333,  // hyphen
+	174: 760,  // registered
+	175: 333,  // macron
+	176: 400,  // degree
+	177: 564,  // plusminus
+	178: 300,  // twosuperior
+	179: 300,  // threesuperior
+	180: 333,  // acute
+	181: 500,  // mu
+	182: 453,  // paragraph
+	183: 250,  // periodcentered
+	184: 333,  // cedilla
+	185: 300,  // onesuperior
+	186: 310,  // ordmasculine
+	187: 500,  // guillemotright
+	188: 750,  // onequarter
+	189: 750,  // onehalf
+	190: 750,  // threequarters
+	191: 444,  // questiondown
+	192: 722,  // Agrave
+	193: 722,  // Aacute
+	194: 722,  // Acircumflex
+	195: 722,  // Atilde
+	196: 722,  // Adieresis
+	197: 722,  // Aring
+	198: 889,  // AE
+	199: 667,  // Ccedilla
+	200: 611,  // Egrave
+	201: 611,  // Eacute
+	202: 611,  // Ecircumflex
+	203: 611,  // Edieresis
+	204: 333,  // Igrave
+	205: 333,  // Iacute
+	206: 333,  // Icircumflex
+	207: 333,  // Idieresis
+	208: 722,  // Eth
+	209: 722,  // Ntilde
+	210: 722,  // Ograve
+	211: 722,  // Oacute
+	212: 722,  // Ocircumflex
+	213: 722,  // Otilde
+	214: 722,  // Odieresis
+	215: 564,  // multiply
+	216: 722,  // Oslash
+	217: 722,  // Ugrave
+	218: 722,  // Uacute
+	219: 722,  // Ucircumflex
+	220: 722,  // Udieresis
+	221: 722,  // Yacute
+	222: 556,  // Thorn
+	223: 500,  // germandbls
+	224: 444,  // agrave
+	225: 444,  // aacute
+	226: 444,  // acircumflex
+	227: 444,  // atilde
+	228: 444,  // adieresis
+	229: 444,  // aring
+	230: 667,  // ae
+	231: 444,  // ccedilla
+	232: 444,  // egrave
+	233: 444,  // eacute
+	234: 444,  // ecircumflex
+	235: 444,  // edieresis
+	236: 278,  // igrave
+	237: 278,  // iacute
+	238: 278,  // icircumflex
+	239: 278,  // idieresis
+	240: 500,  // eth
+	241: 500,  // ntilde
+	242: 500,  // ograve
+	243: 500,  // oacute
+	244: 500,  // ocircumflex
+	245: 500,  // otilde
+	246: 500,  // odieresis
+	247: 564,  // divide
+	248: 500,  // oslash
+	249: 500,  // ugrave
+	250: 500,  // uacute
+	251: 500,  // ucircumflex
+	252: 500,  // udieresis
+	253: 500,  // yacute
+	254: 500,  // thorn
+	255: 500,  // ydieresis
+}
+
+var timesRomanKerning = map[kernPair]int16{
+	{32, 65}:   -55,  // space A
+	{32, 84}:   -18,  // space T
+	{32, 86}:   -50,  // space V
+	{32, 87}:   -30,  // space W
+	{32, 89}:   -90,  // space Y
+	{32, 159}:  -90,  // space Ydieresis
+	{32, 192}:  -55,  // space Agrave
+	{32, 193}:  -55,  // space Aacute
+	{32, 194}:  -55,  // space Acircumflex
+	{32, 195}:  -55,  // space Atilde
+	{32, 196}:  -55,  // space Adieresis
+	{32, 197}:  -55,  // space Aring
+	{32, 221}:  -90,  // space Yacute
+	{44, 146}:  -70,  // comma quoteright
+	{44, 148}:  -70,  // comma quotedblright
+	{46, 146}:  -70,  // period quoteright
+	{46, 148}:  -70,  // period quotedblright
+	{65, 67}:   -40,  // A C
+	{65, 71}:   -40,  // A G
+	{65, 79}:   -55,  // A O
+	{65, 81}:   -55,  // A Q
+	{65, 84}:   -111, // A T
+	{65, 85}:   -55,  // A U
+	{65, 86}:   -135, // A V
+	{65, 87}:   -90,  // A W
+	{65, 89}:   -105, // A Y
+	{65, 118}:  -74,  // A v
+	{65, 119}:  -92,  // A w
+	{65, 121}:  -92,  // A y
+	{65, 146}:  -111, // A quoteright
+	{65, 159}:  -105, // A Ydieresis
+	{65, 199}:  -40,  // A Ccedilla
+	{65, 210}:  -55,  // A Ograve
+	{65, 211}:  -55,  // A Oacute
+	{65, 212}:  -55,  // A Ocircumflex
+	{65, 213}:  -55,  // A Otilde
+	{65, 214}:  -55,  // A Odieresis
+	{65, 216}:  -55,  // A Oslash
+	{65, 217}:  -55,  // A Ugrave
+	{65, 218}:  -55,  // A Uacute
+	{65, 219}:  -55,  // A Ucircumflex
+	{65, 220}:  -55,  // A Udieresis
+	{65, 221}:  -105, // A Yacute
+	{65, 253}:  -92,  // A yacute
+	{65, 255}:  -92,  // A ydieresis
+	{66, 65}:   -35,  // B A
+	{66, 85}:   -10,  // B U
+	{66, 192}:  -35,  // B Agrave
+	{66, 193}:  -35,  // B Aacute
+	{66, 194}:  -35,  // B Acircumflex
+	{66, 195}:  -35,  // B Atilde
+	{66, 196}:  -35,  // B Adieresis
+	{66, 197}:  -35,  // B Aring
+	{66, 217}:  -10,  // B Ugrave
+	{66, 218}:  -10,  // B Uacute
+	{66, 219}:  -10,  // B Ucircumflex
+	{66, 220}:  -10,  // B Udieresis
+	{68, 65}:   -40,  // D A
+	{68, 86}:   -40,  // D V
+	{68, 87}:   -30,  // D W
+	{68, 89}:   -55,  // D Y
+	{68, 159}:  -55,  // D Ydieresis
+	{68, 192}:  -40,  // D Agrave
+	{68, 193}:  -40,  // D Aacute
+	{68, 194}:  -40,  // D Acircumflex
+	{68, 195}:  -40,  // D Atilde
+	{68, 196}:  -40,  // D Adieresis
+	{68, 197}:  -40,  // D Aring
+	{68, 221}:  -55,  // D Yacute
+	{70, 44}:   -80,  // F comma
+	{70, 46}:   -80,  // F period
+	{70, 65}:   -74,  // F A
+	{70, 97}:   -15,  // F a
+	{70, 111}:  -15,  // F o
+	{70, 192}:  -74,  // F Agrave
+	{70, 193}:  -74,  // F Aacute
+	{70, 194}:  -74,  // F Acircumflex
+	{70, 195}:  -74,  // F Atilde
+	{70, 196}:  -74,  // F Adieresis
+	{70, 197}:  -74,  // F Aring
+	{70, 224}:  -15,  // F agrave
+	{70, 225}:  -15,  // F aacute
+	{70, 226}:  -15,  // F acircumflex
+	{70, 227}:  -15,  // F atilde
+	{70, 228}:  -15,  // F adieresis
+	{70, 229}:  -15,  // F aring
+	{70, 242}:  -15,  // F ograve
+	{70, 243}:  -15,  // F oacute
+	{70, 244}:  -15,  // F ocircumflex
+	{70, 245}:  -15,  // F otilde
+	{70, 246}:  -15,  // F odieresis
+	{70, 248}:  -15,  // F oslash
+	{74, 65}:   -60,  // J A
+	{74, 192}:  -60,  // J Agrave
+	{74, 193}:  -60,  // J Aacute
+	{74, 194}:  -60,  // J Acircumflex
+	{74, 195}:  -60,  // J Atilde
+	{74, 196}:  -60,  // J Adieresis
+	{74, 197}:  -60,  // J Aring
+	{75, 79}:   -30,  // K O
+	{75, 101}:  -25,  // K e
+	{75, 111}:  -35,  // K o
+	{75, 117}:  -15,  // K u
+	{75, 121}:  -25,  // K y
+	{75, 210}:  -30,  // K Ograve
+	{75, 211}:  -30,  // K Oacute
+	{75, 212}:  -30,  // K Ocircumflex
+	{75, 213}:  -30,  // K Otilde
+	{75, 214}:  -30,  // K Odieresis
+	{75, 216}:  -30,  // K Oslash
+	{75, 232}:  -25,  // K egrave
+	{75, 233}:  -25,  // K eacute
+	{75, 234}:  -25,  // K ecircumflex
+	{75, 235}:  -25,  // K edieresis
+	{75, 242}:  -35,  // K ograve
+	{75, 243}:  -35,  // K oacute
+	{75, 244}:  -35,  // K ocircumflex
+	{75, 245}:  -35,  // K otilde
+	{75, 246}:  -35,  // K odieresis
+	{75, 248}:  -35,  // K oslash
+	{75, 249}:  -15,  // K ugrave
+	{75, 250}:  -15,  // K uacute
+	{75, 251}:  -15,  // K ucircumflex
+	{75, 252}:  -15,  // K udieresis
+	{75, 253}:  -25,  // K yacute
+	{75, 255}:  -25,  // K ydieresis
+	{76, 84}:   -92,  // L T
+	{76, 86}:   -100, // L V
+	{76, 87}:   -74,  // L W
+	{76, 89}:   -100, // L Y
+	{76, 121}:  -55,  // L y
+	{76, 146}:  -92,  // L quoteright
+	{76, 159}:  -100, // L Ydieresis
+	{76, 221}:  -100, // L Yacute
+	{76, 253}:  -55,  // L yacute
+	{76, 255}:  -55,  // L ydieresis
+	{78, 65}:   -35,  // N A
+	{78, 192}:  -35,  // N Agrave
+	{78, 193}:  -35,  // N Aacute
+	{78, 194}:  -35,  // N Acircumflex
+	{78, 195}:  -35,  // N Atilde
+	{78, 196}:  -35,  // N Adieresis
+	{78, 197}:  -35,  // N Aring
+	{79, 65}:   -35,  // O A
+	{79, 84}:   -40,  // O T
+	{79, 86}:   -50,  // O V
+	{79, 87}:   -35,  // O W
+	{79, 88}:   -40,  // O X
+	{79, 89}:   -50,  // O Y
+	{79, 159}:  -50,  // O Ydieresis
+	{79, 192}:  -35,  // O Agrave
+	{79, 193}:  -35,  // O Aacute
+	{79, 194}:  -35,  // O Acircumflex
+	{79, 195}:  -35,  // O Atilde
+	{79, 196}:  -35,  // O Adieresis
+	{79, 197}:  -35,  // O Aring
+	{79, 221}:  -50,  // O Yacute
+	{80, 44}:   -111, // P comma
+	{80, 46}:   -111, // P period
+	{80, 65}:   -92,  // P A
+	{80, 97}:   -15,  // P a
+	{80, 192}:  -92,  // P Agrave
+	{80, 193}:  -92,  // P Aacute
+	{80, 194}:  -92,  // P Acircumflex
+	{80, 195}:  -92,  // P Atilde
+	{80, 196}:  -92,  // P Adieresis
+	{80, 197}:  -92,  // P Aring
+	{80, 224}:  -15,  // P agrave
+	{80, 225}:  -15,  // P aacute
+	{80, 226}:  -15,  // P acircumflex
+	{80, 227}:  -15,  // P atilde
+	{80, 228}:  -15,  // P adieresis
+	{80, 229}:  -15,  // P aring
+	{81, 85}:   -10,  // Q U
+	{81, 217}:  -10,  // Q Ugrave
+	{81, 218}:  -10,  // Q Uacute
+	{81, 219}:  -10,  // Q Ucircumflex
+	{81, 220}:  -10,  // Q Udieresis
+	{82, 79}:   -40,  // R O
+	{82, 84}:   -60,  // R T
+	{82, 85}:   -40,  // R U
+	{82, 86}:   -80,  // R V
+	{82, 87}:   -55,  // R W
+	{82, 89}:   -65,  // R Y
+	{82, 159}:  -65,  // R Ydieresis
+	{82, 210}:  -40,  // R Ograve
+	{82, 211}:  -40,  // R Oacute
+	{82, 212}:  -40,  // R Ocircumflex
+	{82, 213}:  -40,  // R Otilde
+	{82, 214}:  -40,  // R Odieresis
+	{82, 216}:  -40,  // R Oslash
+	{82, 217}:  -40,  // R Ugrave
+	{82, 218}:  -40,  // R Uacute
+	{82, 219}:  -40,  // R Ucircumflex
+	{82, 220}:  -40,  // R Udieresis
+	{82, 221}:  -65,  // R Yacute
+	{84, 44}:   -74,  // T comma
+	{84, 45}:   -92,  // T hyphen
+	{84, 46}:   -74,  // T period
+	{84, 58}:   -50,  // T colon
+	{84, 59}:   -55,  // T semicolon
+	{84, 65}:   -93,  // T A
+	{84, 79}:   -18,  // T O
+	{84, 97}:   -80,  // T a
+	{84, 101}:  -70,  // T e
+	{84, 105}:  -35,  // T i
+	{84, 111}:  -80,  // T o
+	{84, 114}:  -35,  // T r
+	{84, 117}:  -45,  // T u
+	{84, 119}:  -80,  // T w
+	{84, 121}:  -80,  // T y
+	{84, 173}:  -92,  // T hyphen
+	{84, 192}:  -93,  // T Agrave
+	{84, 193}:  -93,  // T Aacute
+	{84, 194}:  -93,  // T Acircumflex
+	{84, 195}:  -93,  // T Atilde
+	{84, 196}:  -93,  // T Adieresis
+	{84, 197}:  -93,  // T Aring
+	{84, 210}:  -18,  // T Ograve
+	{84, 211}:  -18,  // T Oacute
+	{84, 212}:  -18,  // T Ocircumflex
+	{84, 213}:  -18,  // T Otilde
+	{84, 214}:  -18,  // T Odieresis
+	{84, 216}:  -18,  // T Oslash
+	{84, 224}:  -40,  // T agrave
+	{84, 225}:  -80,  // T aacute
+	{84, 226}:  -80,  // T acircumflex
+	{84, 227}:  -40,  // T atilde
+	{84, 228}:  -40,  // T adieresis
+	{84, 229}:  -80,  // T aring
+	{84, 232}:  -70,  // T egrave
+	{84, 233}:  -70,  // T eacute
+	{84, 234}:  -70,  // T ecircumflex
+	{84, 235}:  -30,  // T edieresis
+	{84, 237}:  -35,  // T iacute
+	{84, 242}:  -80,  // T ograve
+	{84, 243}:  -80,  // T oacute
+	{84, 244}:  -80,  // T ocircumflex
+	{84, 245}:  -80,  // T otilde
+	{84, 246}:  -80,  // T odieresis
+	{84, 248}:  -80,  // T oslash
+	{84, 249}:  -45,  // T ugrave
+	{84, 250}:  -45,  // T uacute
+	{84, 251}:  -45,  // T ucircumflex
+	{84, 252}:  -45,  // T udieresis
+	{84, 253}:  -80,  // T yacute
+	{84, 255}:  -80,  // T ydieresis
+	{85, 65}:   -40,  // U A
+	{85, 192}:  -40,  // U Agrave
+	{85, 193}:  -40,  // U Aacute
+	{85, 194}:  -40,  // U Acircumflex
+	{85, 195}:  -40,  // U Atilde
+	{85, 196}:  -40,  // U Adieresis
+	{85, 197}:  -40,  // U Aring
+	{86, 44}:   -129, // V comma
+	{86, 45}:   -100, // V hyphen
+	{86, 46}:   -129, // V period
+	{86, 58}:   -74,  // V colon
+	{86, 59}:   -74,  // V semicolon
+	{86, 65}:   -135, // V A
+	{86, 71}:   -15,  // V G
+	{86, 79}:   -40,  // V O
+	{86, 97}:   -111, // V a
+	{86, 101}:  -111, // V e
+	{86, 105}:  -60,  // V i
+	{86, 111}:  -129, // V o
+	{86, 117}:  -75,  // V u
+	{86, 173}:  -100, // V hyphen
+	{86, 192}:  -135, // V Agrave
+	{86, 193}:  -135, // V Aacute
+	{86, 194}:  -135, // V Acircumflex
+	{86, 195}:  -135, // V Atilde
+	{86, 196}:  -135, // V Adieresis
+	{86, 197}:  -135, // V Aring
+	{86, 210}:  -40,  // V Ograve
+	{86, 211}:  -40,  // V Oacute
+	{86, 212}:  -40,  // V Ocircumflex
+	{86, 213}:  -40,  // V Otilde
+	{86, 214}:  -40,  // V Odieresis
+	{86, 216}:  -40,  // V Oslash
+	{86, 224}:  -71,  // V agrave
+	{86, 225}:  -111, // V aacute
+	{86, 226}:  -71,  // V acircumflex
+	{86, 227}:  -71,  // V atilde
+	{86, 228}:  -71,  // V adieresis
+	{86, 229}:  -111, // V aring
+	{86, 232}:  -71,  // V egrave
+	{86, 233}:  -111, // V eacute
+	{86, 234}:  -71,  // V ecircumflex
+	{86, 235}:  -71,  // V edieresis
+	{86, 236}:  -20,  // V igrave
+	{86, 237}:  -60,  // V iacute
+	{86, 238}:  -20,  // V icircumflex
+	{86, 239}:  -20,  // V idieresis
+	{86, 242}:  -89,  // V ograve
+	{86, 243}:  -129, // V oacute
+	{86, 244}:  -129, // V ocircumflex
+	{86, 245}:  -89,  // V otilde
+	{86, 246}:  -89,  // V odieresis
+	{86, 248}:  -129, // V oslash
+	{86, 249}:  -75,  // V ugrave
+	{86, 250}:  -75,  // V uacute
+	{86, 251}:  -75,  // V ucircumflex
+	{86, 252}:  -75,  // V udieresis
+	{87, 44}:   -92,  // W comma
+	{87, 45}:   -65,  // W hyphen
+	{87, 46}:   -92,  // W period
+	{87, 58}:   -37,  // W colon
+	{87, 59}:   -37,  // W semicolon
+	{87, 65}:   -120, // W A
+	{87, 79}:   -10,  // W O
+	{87, 97}:   -80,  // W a
+	{87, 101}:  -80,  // W e
+	{87, 105}:  -40,  // W i
+	{87, 111}:  -80,  // W o
+	{87, 117}:  -50,  // W u
+	{87, 121}:  -73,  // W y
+	{87, 173}:  -65,  // W hyphen
+	{87, 192}:  -120, // W Agrave
+	{87, 193}:  -120, // W Aacute
+	{87, 194}:  -120, // W Acircumflex
+	{87, 195}:  -120, // W Atilde
+	{87, 196}:  -120, // W Adieresis
+	{87, 197}:  -120, // W Aring
+	{87, 210}:  -10,  // W Ograve
+	{87, 211}:  -10,  // W Oacute
+	{87, 212}:  -10,  // W Ocircumflex
+	{87, 213}:  -10,  // W Otilde
+	{87, 214}:  -10,  // W Odieresis
+	{87, 216}:  -10,  // W Oslash
+	{87, 224}:  -80,  // W agrave
+	{87, 225}:  -80,  // W aacute
+	{87, 226}:  -80,  // W acircumflex
+	{87, 227}:  -80,  // W atilde
+	{87, 228}:  -80,  // W adieresis
+	{87, 229}:  -80,  // W aring
+	{87, 232}:  -40,  // W egrave
+	{87, 233}:  -80,  // W eacute
+	{87, 234}:  -80,  // W ecircumflex
+	{87, 235}:  -40,  // W edieresis
+	{87, 237}:  -40,  // W iacute
+	{87, 242}:  -80,  // W ograve
+	{87, 243}:  -80,  // W oacute
+	{87, 244}:  -80,  // W ocircumflex
+	{87, 245}:  -80,  // W otilde
+	{87, 246}:  -80,  // W odieresis
+	{87, 248}:  -80,  // W oslash
+	{87, 249}:  -50,  // W ugrave
+	{87, 250}:  -50,  // W uacute
+	{87, 251}:  -50,  // W ucircumflex
+	{87, 252}:  -50,  // W udieresis
+	{87, 253}:  -73,  // W yacute
+	{87, 255}:  -73,  // W ydieresis
+	{89, 44}:   -129, // Y comma
+	{89, 45}:   -111, // Y hyphen
+	{89, 46}:   -129, // Y period
+	{89, 58}:   -92,  // Y colon
+	{89, 59}:   -92,  // Y semicolon
+	{89, 65}:   -120, // Y A
+	{89, 79}:   -30,  // Y O
+	{89, 97}:   -100, // Y a
+	{89, 101}:  -100, // Y e
+	{89, 105}:  -55,  // Y i
+	{89, 111}:  -110, // Y o
+	{89, 117}:  -111, // Y u
+	{89, 173}:  -111, // Y hyphen
+	{89, 192}:  -120, // Y Agrave
+	{89, 193}:  -120, // Y Aacute
+	{89, 194}:  -120, // Y Acircumflex
+	{89, 195}:  -120, // Y Atilde
+	{89, 196}:  -120, // Y Adieresis
+	{89, 197}:  -120, // Y Aring
+	{89, 210}:  -30,  // Y Ograve
+	{89, 211}:  -30,  // Y Oacute
+	{89, 212}:  -30,  // Y Ocircumflex
+	{89, 213}:  -30,  // Y Otilde
+	{89, 214}:  -30,  // Y Odieresis
+	{89, 216}:  -30,  // Y Oslash
+	{89, 224}:  -60,  // Y agrave
+	{89, 225}:  -100, // Y aacute
+	{89, 226}:  -100, // Y acircumflex
+	{89, 227}:  -60,  // Y atilde
+	{89, 228}:  -60,  // Y adieresis
+	{89, 229}:  -100, // Y aring
+	{89, 232}:  -60,  // Y egrave
+	{89, 233}:  -100, // Y eacute
+	{89, 234}:  -100, // Y ecircumflex
+	{89, 235}:  -60,  // Y edieresis
+	{89, 237}:  -55,  // Y iacute
+	{89, 242}:  -70,  // Y ograve
+	{89, 243}:  -110, // Y oacute
+	{89, 244}:  -110, // Y ocircumflex
+	{89, 245}:  -70,  // Y otilde
+	{89, 246}:  -70,  // Y odieresis
+	{89, 248}:  -110, // Y oslash
+	{89, 249}:  -71,  // Y ugrave
+	{89, 250}:  -111, // Y uacute
+	{89, 251}:  -111, // Y ucircumflex
+	{89, 252}:  -71,  // Y udieresis
+	{97, 118}:  -20,  // a v
+	{97, 119}:  -15,  // a w
+	{98, 46}:   -40,  // b period
+	{98, 117}:  -20,  // b u
+	{98, 118}:  -15,  // b v
+	{98, 249}:  -20,  // b ugrave
+	{98, 250}:  -20,  // b uacute
+	{98, 251}:  -20,  // b ucircumflex
+	{98, 252}:  -20,  // b udieresis
+	{99, 121}:  -15,  // c y
+	{99, 253}:  -15,  // c yacute
+	{99, 255}:  -15,  // c ydieresis
+	{101, 103}: -15,  // e g
+	{101, 118}: -25,  // e v
+	{101, 119}: -25,  // e w
+	{101, 120}: -15,  // e x
+	{101, 121}: -15,  // e y
+	{101, 253}: -15,  // e yacute
+	{101, 255}: -15,  // e ydieresis
+	{102, 97}:  -10,  // f a
+	{102, 102}: -25,  // f f
+	{102, 105}: -20,  // f i
+	{102, 146}: 55,   // f quoteright
+	{102, 224}: -10,  // f agrave
+	{102, 225}: -10,  // f aacute
+	{102, 226}: -10,  // f acircumflex
+	{102, 227}: -10,  // f atilde
+	{102, 228}: -10,  // f adieresis
+	{102, 229}: -10,  // f aring
+	{102, 237}: -20,  // f iacute
+	{103, 97}:  -5,   // g a
+	{103, 224}: -5,   // g agrave
+	{103, 225}: -5,   // g aacute
+	{103, 226}: -5,   // g acircumflex
+	{103, 227}: -5,   // g atilde
+	{103, 228}: -5,   // g adieresis
+	{103, 229}: -5,   // g aring
+	{104, 121}: -5,   // h y
+	{104, 253}: -5,   // h yacute
+	{104, 255}: -5,   // h ydieresis
+	{105, 118}: -25,  // i v
+	{107, 101}: -10,  // k e
+	{107, 111}: -10,  // k o
+	{107, 121}: -15,  // k y
+	{107, 232}: -10,  // k egrave
+	{107, 233}: -10,  // k eacute
+	{107, 234}: -10,  // k ecircumflex
+	{107, 235}: -10,  // k edieresis
+	{107, 242}: -10,  // k ograve
+	{107, 243}: -10,  // k oacute
+	{107, 244}: -10,  // k ocircumflex
+	{107, 245}: -10,  // k otilde
+	{107, 246}: -10,  // k odieresis
+	{107, 248}: -10,  // k oslash
+	{107, 253}: -15,  // k yacute
+	{107, 255}: -15,  // k ydieresis
+	{108, 119}: -10,  // l w
+	{110, 118}: -40,  // n v
+	{110, 121}: -15,  // n y
+	{110, 253}: -15,  // n yacute
+	{110, 255}: -15,  // n ydieresis
+	{111, 118}: -15,  // o v
+	{111, 119}: -25,  // o w
+	{111, 121}: -10,  // o y
+	{111, 253}: -10,  // o yacute
+	{111, 255}: -10,  // o ydieresis
+	{112, 121}: -10,  // p y
+	{112, 253}: -10,  // p yacute
+	{112, 255}: -10,  // p ydieresis
+	{114, 44}:  -40,  // r comma
+	{114, 45}:  -20,  // r hyphen
+	{114, 46}:  -55,  // r period
+	{114, 103}: -18,  // r g
+	{114, 173}: -20,  // r hyphen
+	{118, 44}:  -65,  // v comma
+	{118, 46}:  -65,  // v period
+	{118, 97}:  -25,  // v a
+	{118, 101}: -15,  // v e
+	{118, 111}: -20,  // v o
+	{118, 224}: -25,  // v agrave
+	{118, 225}: -25,  // v aacute
+	{118, 226}: -25,  // v acircumflex
+	{118, 227}: -25,  // v atilde
+	{118, 228}: -25,  // v adieresis
+	{118, 229}: -25,  // v aring
+	{118, 232}: -15,  // v egrave
+	{118, 233}: -15,  // v eacute
+	{118, 234}: -15,  // v ecircumflex
+	{118, 235}: -15,  // v edieresis
+	{118, 242}: -20,  // v ograve
+	{118, 243}: -20,  // v oacute
+	{118, 244}: -20,  // v ocircumflex
+	{118, 245}: -20,  // v otilde
+	{118, 246}: -20,  // v odieresis
+	{118, 248}: -20,  // v oslash
+	{119, 44}:  -65,  // w comma
+	{119, 46}:  -65,  // w period
+	{119, 97}:  -10,  // w a
+	{119, 111}: -10,  // w o
+	{119, 224}: -10,  // w agrave
+	{119, 225}: -10,  // w aacute
+	{119, 226}: -10,  // w acircumflex
+	{119, 227}: -10,  // w atilde
+	{119, 228}: -10,  // w adieresis
+	{119, 229}: -10,  // w aring
+	{119, 242}: -10,  // w ograve
+	{119, 243}: -10,  // w oacute
+	{119, 244}: -10,  // w ocircumflex
+	{119, 245}: -10,  // w otilde
+	{119, 246}: -10,  // w odieresis
+	{119, 248}: -10,  // w oslash
+	{120, 101}: -15,  // x e
+	{120, 232}: -15,  // x egrave
+	{120, 233}: -15,  // x eacute
+	{120, 234}: -15,  // x ecircumflex
+	{120, 235}: -15,  // x edieresis
+	{121, 44}:  -65,  // y comma
+	{121, 46}:  -65,  // y period
+	{145, 65}:  -80,  // quoteleft A
+	{145, 145}: -74,  // quoteleft quoteleft
+	{145, 192}: -80,  // quoteleft Agrave
+	{145, 193}: -80,  // quoteleft Aacute
+	{145, 194}: -80,  // quoteleft Acircumflex
+	{145, 195}: -80,  // quoteleft Atilde
+	{145, 196}: -80,  // quoteleft Adieresis
+	{145, 197}: -80,  // quoteleft Aring
+	{146, 32}:  -74,  // quoteright space
+	{146, 100}: -50,  // quoteright d
+	{146, 108}: -10,  // quoteright l
+	{146, 114}: -50,  // quoteright r
+	{146, 115}: -55,  // quoteright s
+	{146, 116}: -18,  // quoteright t
+	{146, 118}: -50,  // quoteright v
+	{146, 146}: -74,  // quoteright quoteright
+	{146, 154}: -55,  // quoteright scaron
+	{146, 160}: -74,  // quoteright space
+	{147, 65}:  -80,  // quotedblleft A
+	{147, 192}: -80,  // quotedblleft Agrave
+	{147, 193}: -80,  // quotedblleft Aacute
+	{147, 194}: -80,  // quotedblleft Acircumflex
+	{147, 195}: -80,  // quotedblleft Atilde
+	{147, 196}: -80,  // quotedblleft Adieresis
+	{147, 197}: -80,  // quotedblleft Aring
+	{159, 44}:  -129, // Ydieresis comma
+	{159, 45}:  -111, // Ydieresis hyphen
+	{159, 46}:  -129, // Ydieresis period
+	{159, 58}:  -92,  // Ydieresis colon
+	{159, 59}:  -92,  // Ydieresis semicolon
+	{159, 65}:  -120, // Ydieresis A
+	{159, 79}:  -30,  // Ydieresis O
+	{159, 97}:  -100, // Ydieresis a
+	{159, 101}: -100, // Ydieresis e
+	{159, 105}: -55,  // Ydieresis i
+	{159, 111}: -110, // Ydieresis o
+	{159, 117}: -111, // Ydieresis u
+	{159, 173}: -111, // Ydieresis hyphen
+	{159, 192}: -120, // Ydieresis Agrave
+	{159, 193}: -120, // Ydieresis Aacute
+	{159, 194}: -120, // Ydieresis Acircumflex
+	{159, 195}: -120, // Ydieresis Atilde
+	{159, 196}: -120, // Ydieresis Adieresis
+	{159, 197}: -120, // Ydieresis Aring
+	{159, 210}: -30,  // Ydieresis Ograve
+	{159, 211}: -30,  // Ydieresis Oacute
+	{159, 212}: -30,  // Ydieresis Ocircumflex
+	{159, 213}: -30,  // Ydieresis Otilde
+	{159, 214}: -30,  // Ydieresis Odieresis
+	{159, 216}: -30,  // Ydieresis Oslash
+	{159, 224}: -60,  // Ydieresis agrave
+	{159, 225}: -100, // Ydieresis aacute
+	{159, 226}: -100, // Ydieresis acircumflex
+	{159, 227}: -100, // Ydieresis atilde
+	{159, 228}: -60,  // Ydieresis adieresis
+	{159, 229}: -100, // Ydieresis aring
+	{159, 232}: -60,  // Ydieresis egrave
+	{159, 233}: -100, // Ydieresis eacute
+	{159, 234}: -100, // Ydieresis ecircumflex
+	{159, 235}: -60,  // Ydieresis edieresis
+	{159, 237}: -55,  // Ydieresis iacute
+	{159, 242}: -70,  // Ydieresis ograve
+	{159, 243}: -110, // Ydieresis oacute
+	{159, 244}: -110, // Ydieresis ocircumflex
+	{159, 245}: -70,  // Ydieresis otilde
+	{159, 246}: -70,  // Ydieresis odieresis
+	{159, 248}: -110, // Ydieresis oslash
+	{159, 249}: -71,  // Ydieresis ugrave
+	{159, 250}: -111, // Ydieresis uacute
+	{159, 251}: -111, // Ydieresis ucircumflex
+	{159, 252}: -71,  // Ydieresis udieresis
+	{160, 65}:  -55,  // space A
+	{160, 84}:  -18,  // space T
+	{160, 86}:  -50,  // space V
+	{160, 87}:  -30,  // space W
+	{160, 89}:  -90,  // space Y
+	{160, 159}: -90,  // space Ydieresis
+	{160, 192}: -55,  // space Agrave
+	{160, 193}: -55,  // space Aacute
+	{160, 194}: -55,  // space Acircumflex
+	{160, 195}: -55,  // space Atilde
+	{160, 196}: -55,  // space Adieresis
+	{160, 197}: -55,  // space Aring
+	{160, 221}: -90,  // space Yacute
+	{192, 67}:  -40,  // Agrave C
+	{192, 71}:  -40,  // Agrave G
+	{192, 79}:  -55,  // Agrave O
+	{192, 81}:  -55,  // Agrave Q
+	{192, 84}:  -111, // Agrave T
+	{192, 85}:  -55,  // Agrave U
+	{192, 86}:  -135, // Agrave V
+	{192, 87}:  -90,  // Agrave W
+	{192, 89}:  -105, // Agrave Y
+	{192, 118}: -74,  // Agrave v
+	{192, 119}: -92,  // Agrave w
+	{192, 121}: -92,  // Agrave y
+	{192, 146}: -111, // Agrave quoteright
+	{192, 159}: -105, // Agrave Ydieresis
+	{192, 199}: -40,  // Agrave Ccedilla
+	{192, 210}: -55,  // Agrave Ograve
+	{192, 211}: -55,  // Agrave Oacute
+	{192, 212}: -55,  // Agrave Ocircumflex
+	{192, 213}: -55,  // Agrave Otilde
+	{192, 214}: -55,  // Agrave Odieresis
+	{192, 216}: -55,  // Agrave Oslash
+	{192, 217}: -55,  // Agrave Ugrave
+	{192, 218}: -55,  // Agrave Uacute
+	{192, 219}: -55,  // Agrave Ucircumflex
+	{192, 220}: -55,  // Agrave Udieresis
+	{192, 221}: -105, // Agrave Yacute
+	{192, 253}: -92,  // Agrave yacute
+	{192, 255}: -92,  // Agrave ydieresis
+	{193, 67}:  -40,  // Aacute C
+	{193, 71}:  -40,  // Aacute G
+	{193, 79}:  -55,  // Aacute O
+	{193, 81}:  -55,  // Aacute Q
+	{193, 84}:  -111, // Aacute T
+	{193, 85}:  -55,  // Aacute U
+	{193, 86}:  -135, // Aacute V
+	{193, 87}:  -90,  // Aacute W
+	{193, 89}:  -105, // Aacute Y
+	{193, 118}: -74,  // Aacute v
+	{193, 119}: -92,  // Aacute w
+	{193, 121}: -92,  // Aacute y
+	{193, 146}: -111, // Aacute quoteright
+	{193, 159}: -105, // Aacute Ydieresis
+	{193, 199}: -40,  // Aacute Ccedilla
+	{193, 210}: -55,  // Aacute Ograve
+	{193, 211}: -55,  // Aacute Oacute
+	{193, 212}: -55,  // Aacute Ocircumflex
+	{193, 213}: -55,  // Aacute Otilde
+	{193, 214}: -55,  // Aacute Odieresis
+	{193, 216}: -55,  // Aacute Oslash
+	{193, 217}: -55,  // Aacute Ugrave
+	{193, 218}: -55,  // Aacute Uacute
+	{193, 219}: -55,  // Aacute Ucircumflex
+	{193, 220}: -55,  // Aacute Udieresis
+	{193, 221}: -105, // Aacute Yacute
+	{193, 253}: -92,  // Aacute yacute
+	{193, 255}: -92,  // Aacute ydieresis
+	{194, 67}:  -40,  // Acircumflex C
+	{194, 71}:  -40,  // Acircumflex G
+	{194, 79}:  -55,  // Acircumflex O
+	{194, 81}:  -55,  // Acircumflex Q
+	{194, 84}:  -111, // Acircumflex T
+	{194, 85}:  -55,  // Acircumflex U
+	{194, 86}:  -135, // Acircumflex V
+	{194, 87}:  -90,  // Acircumflex W
+	{194, 89}:  -105, // Acircumflex Y
+	{194, 118}: -74,  // Acircumflex v
+	{194, 119}: -92,  // Acircumflex w
+	{194, 121}: -92,  // Acircumflex y
+	{194, 146}: -111, // Acircumflex quoteright
+	{194, 159}: -105, // Acircumflex Ydieresis
+	{194, 199}: -40,  // Acircumflex Ccedilla
+	{194, 210}: -55,  // Acircumflex Ograve
+	{194, 211}: -55,  // Acircumflex Oacute
+	{194, 212}: -55,  // Acircumflex Ocircumflex
+	{194, 213}: -55,  // Acircumflex Otilde
+	{194, 214}: -55,  // Acircumflex Odieresis
+	{194, 216}: -55,  // Acircumflex Oslash
+	{194, 217}: -55,  // Acircumflex Ugrave
+	{194, 218}: -55,  // Acircumflex Uacute
+	{194, 219}: -55,  // Acircumflex Ucircumflex
+	{194, 220}: -55,  // Acircumflex Udieresis
+	{194, 221}: -105, // Acircumflex Yacute
+	{194, 253}: -92,  // Acircumflex yacute
+	{194, 255}: -92,  // Acircumflex ydieresis
+	{195, 67}:  -40,  // Atilde C
+	{195, 71}:  -40,  // Atilde G
+	{195, 79}:  -55,  // Atilde O
+	{195, 81}:  -55,  // Atilde Q
+	{195, 84}:  -111, // Atilde T
+	{195, 85}:  -55,  // Atilde U
+	{195, 86}:  -135, // Atilde V
+	{195, 87}:  -90,  // Atilde W
+	{195, 89}:  -105, // Atilde Y
+	{195, 118}: -74,  // Atilde v
+	{195, 119}: -92,  // Atilde w
+	{195, 121}: -92,  // Atilde y
+	{195, 146}: -111, // Atilde quoteright
+	{195, 159}: -105, // Atilde Ydieresis
+	{195, 199}: -40,  // Atilde Ccedilla
+	{195, 210}: -55,  // Atilde Ograve
+	{195, 211}: -55,  // Atilde Oacute
+	{195, 212}: -55,  // Atilde Ocircumflex
+	{195, 213}: -55,  // Atilde Otilde
+	{195, 214}: -55,  // Atilde Odieresis
+	{195, 216}: -55,  // Atilde Oslash
+	{195, 217}: -55,  // Atilde Ugrave
+	{195, 218}: -55,  // Atilde Uacute
+	{195, 219}: -55,  // Atilde Ucircumflex
+	{195, 220}: -55,  // Atilde Udieresis
+	{195, 221}: -105, // Atilde Yacute
+	{195, 253}: -92,  // Atilde yacute
+	{195, 255}: -92,  // Atilde ydieresis
+	{196, 67}:  -40,  // Adieresis C
+	{196, 71}:  -40,  // Adieresis G
+	{196, 79}:  -55,  // Adieresis O
+	{196, 81}:  -55,  // Adieresis Q
+	{196, 84}:  -111, // Adieresis T
+	{196, 85}:  -55,  // Adieresis U
+	{196, 86}:  -135, // Adieresis V
+	{196, 87}:  -90,  // Adieresis W
+	{196, 89}:  -105, // Adieresis Y
+	{196, 118}: -74,  // Adieresis v
+	{196, 119}: -92,  // Adieresis w
+	{196, 121}: -92,  // Adieresis y
+	{196, 146}: -111, // Adieresis quoteright
+	{196, 159}: -105, // Adieresis Ydieresis
+	{196, 199}: -40,  // Adieresis Ccedilla
+	{196, 210}: -55,  // Adieresis Ograve
+	{196, 211}: -55,  // Adieresis Oacute
+	{196, 212}: -55,  // Adieresis Ocircumflex
+	{196, 213}: -55,  // Adieresis Otilde
+	{196, 214}: -55,  // Adieresis Odieresis
+	{196, 216}: -55,  // Adieresis Oslash
+	{196, 217}: -55,  // Adieresis Ugrave
+	{196, 218}: -55,  // Adieresis Uacute
+	{196, 219}: -55,  // Adieresis Ucircumflex
+	{196, 220}: -55,  // Adieresis Udieresis
+	{196, 221}: -105, // Adieresis Yacute
+	{196, 253}: -92,  // Adieresis yacute
+	{196, 255}: -92,  // Adieresis ydieresis
+	{197, 67}:  -40,  // Aring C
+	{197, 71}:  -40,  // Aring G
+	{197, 79}:  -55,  // Aring O
+	{197, 81}:  -55,  // Aring Q
+	{197, 84}:  -111, // Aring T
+	{197, 85}:  -55,  // Aring U
+	{197, 86}:  -135, // Aring V
+	{197, 87}:  -90,  // Aring W
+	{197, 89}:  -105, // Aring Y
+	{197, 118}: -74,  // Aring v
+	{197, 119}: -92,  // Aring w
+	{197, 121}: -92,  // Aring y
+	{197, 146}: -111, // Aring quoteright
+	{197, 159}: -105, // Aring Ydieresis
+	{197, 199}: -40,  // Aring Ccedilla
+	{197, 210}: -55,  // Aring Ograve
+	{197, 211}: -55,  // Aring Oacute
+	{197, 212}: -55,  // Aring Ocircumflex
+	{197, 213}: -55,  // Aring Otilde
+	{197, 214}: -55,  // Aring Odieresis
+	{197, 216}: -55,  // Aring Oslash
+	{197, 217}: -55,  // Aring Ugrave
+	{197, 218}: -55,  // Aring Uacute
+	{197, 219}: -55,  // Aring Ucircumflex
+	{197, 220}: -55,  // Aring Udieresis
+	{197, 221}: -105, // Aring Yacute
+	{197, 253}: -92,  // Aring yacute
+	{197, 255}: -92,  // Aring ydieresis
+	{209, 65}:  -35,  // Ntilde A
+	{209, 192}: -35,  // Ntilde Agrave
+	{209, 193}: -35,  // Ntilde Aacute
+	{209, 194}: -35,  // Ntilde Acircumflex
+	{209, 195}: -35,  // Ntilde Atilde
+	{209, 196}: -35,  // Ntilde Adieresis
+	{209, 197}: -35,  // Ntilde Aring
+	{210, 65}:  -35,  // Ograve A
+	{210, 84}:  -40,  // Ograve T
+	{210, 86}:  -50,  // Ograve V
+	{210, 87}:  -35,  // Ograve W
+	{210, 88}:  -40,  // Ograve X
+	{210, 89}:  -50,  // Ograve Y
+	{210, 159}: -50,  // Ograve Ydieresis
+	{210, 192}: -35,  // Ograve Agrave
+	{210, 193}: -35,  // Ograve Aacute
+	{210, 194}: -35,  // Ograve Acircumflex
+	{210, 195}: -35,  // Ograve Atilde
+	{210, 196}: -35,  // Ograve Adieresis
+	{210, 197}: -35,  // Ograve Aring
+	{210, 221}: -50,  // Ograve Yacute
+	{211, 65}:  -35,  // Oacute A
+	{211, 84}:  -40,  // Oacute T
+	{211, 86}:  -50,  // Oacute V
+	{211, 87}:  -35,  // Oacute W
+	{211, 88}:  -40,  // Oacute X
+	{211, 89}:  -50,  // Oacute Y
+	{211, 159}: -50,  // Oacute Ydieresis
+	{211, 192}: -35,  // Oacute Agrave
+	{211, 193}: -35,  // Oacute Aacute
+	{211, 194}: -35,  // Oacute Acircumflex
+	{211, 195}: -35,  // Oacute Atilde
+	{211, 196}: -35,  // Oacute Adieresis
+	{211, 197}: -35,  // Oacute Aring
+	{211, 221}: -50,  // Oacute Yacute
+	{212, 65}:  -35,  // Ocircumflex A
+	{212, 84}:  -40,  // Ocircumflex T
+	{212, 86}:  -50,  // Ocircumflex V
+	{212, 87}:  -35,  // Ocircumflex W
+	{212, 88}:  -40,  // Ocircumflex X
+	{212, 89}:  -50,  // Ocircumflex Y
+	{212, 159}: -50,  // Ocircumflex Ydieresis
+	{212, 192}: -35,  // Ocircumflex Agrave
+	{212, 193}: -35,  // Ocircumflex Aacute
+	{212, 194}: -35,  // Ocircumflex Acircumflex
+	{212, 195}: -35,  // Ocircumflex Atilde
+	{212, 196}: -35,  // Ocircumflex Adieresis
+	{212, 197}: -35,  // Ocircumflex Aring
+	{212, 221}: -50,  // Ocircumflex Yacute
+	{213, 65}:  -35,  // Otilde A
+	{213, 84}:  -40,  // Otilde T
+	{213, 86}:  -50,  // Otilde V
+	{213, 87}:  -35,  // Otilde W
+	{213, 88}:  -40,  // Otilde X
+	{213, 89}:  -50,  // Otilde Y
+	{213, 159}: -50,  // Otilde Ydieresis
+	{213, 192}: -35,  // Otilde Agrave
+	{213, 193}: -35,  // Otilde Aacute
+	{213, 194}: -35,  // Otilde Acircumflex
+	{213, 195}: -35,  // Otilde Atilde
+	{213, 196}: -35,  // Otilde Adieresis
+	{213, 197}: -35,  // Otilde Aring
+	{213, 221}: -50,  // Otilde Yacute
+	{214, 65}:  -35,  // Odieresis A
+	{214, 84}:  -40,  // Odieresis T
+	{214, 86}:  -50,  // Odieresis V
+	{214, 87}:  -35,  // Odieresis W
+	{214, 88}:  -40,  // Odieresis X
+	{214, 89}:  -50,  // Odieresis Y
+	{214, 159}: -50,  // Odieresis Ydieresis
+	{214, 192}: -35,  // Odieresis Agrave
+	{214, 193}: -35,  // Odieresis Aacute
+	{214, 194}: -35,  // Odieresis Acircumflex
+	{214, 195}: -35,  // Odieresis Atilde
+	{214, 196}: -35,  // Odieresis Adieresis
+	{214, 197}: -35,  // Odieresis Aring
+	{214, 221}: -50,  // Odieresis Yacute
+	{216, 65}:  -35,  // Oslash A
+	{216, 84}:  -40,  // Oslash T
+	{216, 86}:  -50,  // Oslash V
+	{216, 87}:  -35,  // Oslash W
+	{216, 88}:  -40,  // Oslash X
+	{216, 89}:  -50,  // Oslash Y
+	{216, 159}: -50,  // Oslash Ydieresis
+	{216, 192}: -35,  // Oslash Agrave
+	{216, 193}: -35,  // Oslash Aacute
+	{216, 194}: -35,  // Oslash Acircumflex
+	{216, 195}: -35,  // Oslash Atilde
+	{216, 196}: -35,  // Oslash Adieresis
+	{216, 197}: -35,  // Oslash Aring
+	{216, 221}: -50,  // Oslash Yacute
+	{217, 65}:  -40,  // Ugrave A
+	{217, 192}: -40,  // Ugrave Agrave
+	{217, 193}: -40,  // Ugrave Aacute
+	{217, 194}: -40,  // Ugrave Acircumflex
+	{217, 195}: -40,  // Ugrave Atilde
+	{217, 196}: -40,  // Ugrave Adieresis
+	{217, 197}: -40,  // Ugrave Aring
+	{218, 65}:  -40,  // Uacute A
+	{218, 192}: -40,  // Uacute Agrave
+	{218, 193}: -40,  // Uacute Aacute
+	{218, 194}: -40,  // Uacute Acircumflex
+	{218, 195}: -40,  // Uacute Atilde
+	{218, 196}: -40,  // Uacute Adieresis
+	{218, 197}: -40,  // Uacute Aring
+	{219, 65}:  -40,  // Ucircumflex A
+	{219, 192}: -40,  // Ucircumflex Agrave
+	{219, 193}: -40,  // Ucircumflex Aacute
+	{219, 194}: -40,  // Ucircumflex Acircumflex
+	{219, 195}: -40,  // Ucircumflex Atilde
+	{219, 196}: -40,  // Ucircumflex Adieresis
+	{219, 197}: -40,  // Ucircumflex Aring
+	{220, 65}:  -40,  // Udieresis A
+	{220, 192}: -40,  // Udieresis Agrave
+	{220, 193}: -40,  // Udieresis Aacute
+	{220, 194}: -40,  // Udieresis Acircumflex
+	{220, 195}: -40,  // Udieresis Atilde
+	{220, 196}: -40,  // Udieresis Adieresis
+	{220, 197}: -40,  // Udieresis Aring
+	{221, 44}:  -129, // Yacute comma
+	{221, 45}:  -111, // Yacute hyphen
+	{221, 46}:  -129, // Yacute period
+	{221, 58}:  -92,  // Yacute colon
+	{221, 59}:  -92,  // Yacute semicolon
+	{221, 65}:  -120, // Yacute A
+	{221, 79}:  -30,  // Yacute O
+	{221, 97}:  -100, // Yacute a
+	{221, 101}: -100, // Yacute e
+	{221, 105}: -55,  // Yacute i
+	{221, 111}: -110, // Yacute o
+	{221, 117}: -111, // Yacute u
+	{221, 173}: -111, // Yacute hyphen
+	{221, 192}: -120, // Yacute Agrave
+	{221, 193}: -120, // Yacute Aacute
+	{221, 194}: -120, // Yacute Acircumflex
+	{221, 195}: -120, // Yacute Atilde
+	{221, 196}: -120, // Yacute Adieresis
+	{221, 197}: -120, // Yacute Aring
+	{221, 210}: -30,  // Yacute Ograve
+	{221, 211}: -30,  // Yacute Oacute
+	{221, 212}: -30,  // Yacute Ocircumflex
+	{221, 213}: -30,  // Yacute Otilde
+	{221, 214}: -30,  // Yacute Odieresis
+	{221, 216}: -30,  // Yacute Oslash
+	{221, 224}: -60,  // Yacute agrave
+	{221, 225}: -100, // Yacute aacute
+	{221, 226}: -100, // Yacute acircumflex
+	{221, 227}: -60,  // Yacute atilde
+	{221, 228}: -60,  // Yacute adieresis
+	{221, 229}: -100, // Yacute aring
+	{221, 232}: -60,  // Yacute egrave
+	{221, 233}: -100, // Yacute eacute
+	{221, 234}: -100, // Yacute ecircumflex
+	{221, 235}: -60,  // Yacute edieresis
+	{221, 237}: -55,  // Yacute iacute
+	{221, 242}: -70,  // Yacute ograve
+	{221, 243}: -110, // Yacute oacute
+	{221, 244}: -110, // Yacute ocircumflex
+	{221, 245}: -70,  // Yacute otilde
+	{221, 246}: -70,  // Yacute odieresis
+	{221, 248}: -110, // Yacute oslash
+	{221, 249}: -71,  // Yacute ugrave
+	{221, 250}: -111, // Yacute uacute
+	{221, 251}: -111, // Yacute ucircumflex
+	{221, 252}: -71,  // Yacute udieresis
+	{224, 118}: -20,  // agrave v
+	{224, 119}: -15,  // agrave w
+	{225, 118}: -20,  // aacute v
+	{225, 119}: -15,  // aacute w
+	{226, 118}: -20,  // acircumflex v
+	{226, 119}: -15,  // acircumflex w
+	{227, 118}: -20,  // atilde v
+	{227, 119}: -15,  // atilde w
+	{228, 118}: -20,  // adieresis v
+	{228, 119}: -15,  // adieresis w
+	{229, 118}: -20,  // aring v
+	{229, 119}: -15,  // aring w
+	{231, 121}: -15,  // ccedilla y
+	{231, 253}: -15,  // ccedilla yacute
+	{231, 255}: -15,  // ccedilla ydieresis
+	{232, 103}: -15,  // egrave g
+	{232, 118}: -25,  // egrave v
+	{232, 119}: -25,  // egrave w
+	{232, 120}: -15,  // egrave x
+	{232, 121}: -15,  // egrave y
+	{232, 253}: -15,  // egrave yacute
+	{232, 255}: -15,  // egrave ydieresis
+	{233, 103}: -15,  // eacute g
+	{233, 118}: -25,  // eacute v
+	{233, 119}: -25,  // eacute w
+	{233, 120}: -15,  // eacute x
+	{233, 121}: -15,  // eacute y
+	{233, 253}: -15,  // eacute yacute
+	{233, 255}: -15,  // eacute ydieresis
+	{234, 103}: -15,  // ecircumflex g
+	{234, 118}: -25,  // ecircumflex v
+	{234, 119}: -25,  // ecircumflex w
+	{234, 120}: -15,  // ecircumflex x
+	{234, 121}: -15,  // ecircumflex y
+	{234, 253}: -15,  // ecircumflex yacute
+	{234, 255}: -15,  // ecircumflex ydieresis
+	{235, 103}: -15,  // edieresis g
+	{235, 118}: -25,  // edieresis v
+	{235, 119}: -25,  // edieresis w
+	{235, 120}: -15,  // edieresis x
+	{235, 121}: -15,  // edieresis y
+	{235, 253}: -15,  // edieresis yacute
+	{235, 255}: -15,  // edieresis ydieresis
+	{236, 118}: -25,  // igrave v
+	{237, 118}: -25,  // iacute v
+	{238, 118}: -25,  // icircumflex v
+	{239, 118}: -25,  // idieresis v
+	{241, 118}: -40,  // ntilde v
+	{241, 121}: -15,  // ntilde y
+	{241, 253}: -15,  // ntilde yacute
+	{241, 255}: -15,  // ntilde ydieresis
+	{242, 118}: -15,  // ograve v
+	{242, 119}: -25,  // ograve w
+	{242, 121}: -10,  // ograve y
+	{242, 253}: -10,  // ograve yacute
+	{242, 255}: -10,  // ograve ydieresis
+	{243, 118}: -15,  // oacute v
+	{243, 119}: -25,  // oacute w
+	{243, 121}: -10,  // oacute y
+	{243, 253}: -10,  // oacute yacute
+	{243, 255}: -10,  // oacute ydieresis
+	{244, 118}: -15,  // ocircumflex v
+	{244, 119}: -25,  // ocircumflex w
+	{244, 121}: -10,  // ocircumflex y
+	{244, 253}: -10,  // ocircumflex yacute
+	{244, 255}: -10,  // ocircumflex ydieresis
+	{245, 118}: -15,  // otilde v
+	{245, 119}: -25,  // otilde w
+	{245, 121}: -10,  // otilde y
+	{245, 253}: -10,  // otilde yacute
+	{245, 255}: -10,  // otilde ydieresis
+	{246, 118}: -15,  // odieresis v
+	{246, 119}: -25,  // odieresis w
+	{246, 121}: -10,  // odieresis y
+	{246, 253}: -10,  // odieresis yacute
+	{246, 255}: -10,  // odieresis ydieresis
+	{248, 118}: -15,  // oslash v
+	{248, 119}: -25,  // oslash w
+	{248, 121}: -10,  // oslash y
+	{248, 253}: -10,  // oslash yacute
+	{248, 255}: -10,  // oslash ydieresis
+	{253, 44}:  -65,  // yacute comma
+	{253, 46}:  -65,  // yacute period
+	{255, 44}:  -65,  // ydieresis comma
+	{255, 46}:  -65,  // ydieresis period
 }
 
 // ZapfDingbats
+var zapfDingbatsMetrics = FontMetrics{
+	Ascender:    820,
+	Descender:   -143,
+	CapHeight:   0,
+	XHeight:     0,
+	ItalicAngle: 0,
+	FontBBox:    Rectangle{Point{-1, -143}, Point{981, 820}},
+}
+
 var zapfDingbatsWidths = []uint16{
-	32:  278, // space
-	33:  974, // a
-	34:  961, // a
-	35:  974, // a
-	36:  980, // a
-	37:  719, // a
-	38:  789, // a
-	39:  790, // a
-	40:  791, // a
-	41:  690, // a
-	42:  960, // a
-	43:  939, // a
-	44:  549, // a
-	45:  855, // a
-	46:  911, // a
-	47:  933, // a
-	48:  911, // a
-	49:  945, // a
-	50:  974, // a
-	51:  755, // a
-	52:  846, // a
-	53:  762, // a
-	54:  761, // a
-	55:  571, // a
-	56:  677, // a
-	57:  763, // a
-	58:  760, // a
-	59:  759, // a
-	60:  754, // a
-	61:  494, // a
-	62:  552, // a
-	63:  537, // a
-	64:  577, // a
-	65:  692, // a
-	66:  786, // a
-	67:  788, // a
-	68:  788, // a
-	69:  790, // a
-	70:  793, // a
-	71:  794, // a
-	72:  816, // a
-	73:  823, // a
-	74:  789, // a
-	75:  841, // a
-	76:  823, // a
-	77:  833, // a
-	78:  816, // a
-	79:  831, // a
-	80:  923, // a
-	81:  744, // a
-	82:  723, // a
-	83:  749, // a
-	84:  790, // a
-	85:  792, // a
-	86:  695, // a
-	87:  776, // a
-	88:  768, // a
-	89:  792, // a
-	90:  759, // a
-	91:  707, // a
-	92:  708, // a
-	93:  682, // a
-	94:  701, // a
-	95:  826, // a
-	96:  815, // a
-	97:  789, // a
-	98:  789, // a
-	99:  707, // a
-	100: 687, // a
-	101: 696, // a
-	102: 689, // a
-	103: 786, // a
-	104: 787, // a
-	105: 713, // a
-	106: 791, // a
-	107: 785, // a
-	108: 791, // a
-	109: 873, // a
-	110: 761, // a
-	111: 762, // a
-	112: 762, // a
-	113: 759, // a
-	114: 759, // a
-	115: 892, // a
-	116: 892, // a
-	117: 788, // a
-	118: 784, // a
-	119: 438, // a
-	120: 138, // a
-	121: 277, // a
-	122: 415, // a
-	123: 392, // a
-	124: 392, // a
-	125: 668, // a
-	126: 668, // a
-	128: 390, // a
-	129: 390, // a
+	32:  278,  // space
+	33:  974,  // a1
+	34:  961,  // a2
+	35:  974,  // a202
+	36:  980,  // a3
+	37:  719,  // a4
+	38:  789,  // a5
+	39:  790,  // a119
+	40:  791,  // a118
+	41:  690,  // a117
+	42:  960,  // a11
+	43:  939,  // a12
+	44:  549,  // a13
+	45:  855,  // a14
+	46:  911,  // a15
+	47:  933,  // a16
+	48:  911,  // a105
+	49:  945,  // a17
+	50:  974,  // a18
+	51:  755,  // a19
+	52:  846,  // a20
+	53:  762,  // a21
+	54:  761,  // a22
+	55:  571,  // a23
+	56:  677,  // a24
+	57:  763,  // a25
+	58:  760,  // a26
+	59:  759,  // a27
+	60:  754,  // a28
+	61:  494,  // a6
+	62:  552,  // a7
+	63:  537,  // a8
+	64:  577,  // a9
+	65:  692,  // a10
+	66:  786,  // a29
+	67:  788,  // a30
+	68:  788,  // a31
+	69:  790,  // a32
+	70:  793,  // a33
+	71:  794,  // a34
+	72:  816,  // a35
+	73:  823,  // a36
+	74:  789,  // a37
+	75:  841,  // a38
+	76:  823,  // a39
+	77:  833,  // a40
+	78:  816,  // a41
+	79:  831,  // a42
+	80:  923,  // a43
+	81:  744,  // a44
+	82:  723,  // a45
+	83:  749,  // a46
+	84:  790,  // a47
+	85:  792,  // a48
+	86:  695,  // a49
+	87:  776,  // a50
+	88:  768,  // a51
+	89:  792,  // a52
+	90:  759,  // a53
+	91:  707,  // a54
+	92:  708,  // a55
+	93:  682,  // a56
+	94:  701,  // a57
+	95:  826,  // a58
+	96:  815,  // a59
+	97:  789,  // a60
+	98:  789,  // a61
+	99:  707,  // a62
+	100: 687,  // a63
+	101: 696,  // a64
+	102: 689,  // a65
+	103: 786,  // a66
+	104: 787,  // a67
+	105: 713,  // a68
+	106: 791,  // a69
+	107: 785,  // a70
+	108: 791,  // a71
+	109: 873,  // a72
+	110: 761,  // a73
+	111: 762,  // a74
+	112: 762,  // a203
+	113: 759,  // a75
+	114: 759,  // a204
+	115: 892,  // a76
+	116: 892,  // a77
+	117: 788,  // a78
+	118: 784,  // a79
+	119: 438,  // a81
+	120: 138,  // a82
+	121: 277,  // a83
+	122: 415,  // a84
+	123: 392,  // a97
+	124: 392,  // a98
+	125: 668,  // a99
+	126: 668,  // a100
+	128: 390,  // a89
+	129: 390,  // a90
+	130: 317,  // a93
+	131: 317,  // a94
+	132: 276,  // a91
+	133: 276,  // a92
+	134: 509,  // a205
+	135: 509,  // a85
+	136: 410,  // a206
+	137: 410,  // a86
+	138: 234,  // a87
+	139: 234,  // a88
+	140: 334,  // a95
+	141: 334,  // a96
+	161: 732,  // a101
+	162: 544,  // a102
+	163: 544,  // a103
+	164: 910,  // a104
+	165: 667,  // a106
+	166: 760,  // a107
+	167: 760,  // a108
+	168: 776,  // a112
+	169: 595,  // a111
+	170: 694,  // a110
+	171: 626,  // a109
+	172: 788,  // a120
+	173: 788,  // a121
+	174: 788,  // a122
+	175: 788,  // a123
+	176: 788,  // a124
+	177: 788,  // a125
+	178: 788,  // a126
+	179: 788,  // a127
+	180: 788,  // a128
+	181: 788,  // a129
+	182: 788,  // a130
+	183: 788,  // a131
+	184: 788,  // a132
+	185: 788,  // a133
+	186: 788,  // a134
+	187: 788,  // a135
+	188: 788,  // a136
+	189: 788,  // a137
+	190: 788,  // a138
+	191: 788,  // a139
+	192: 788,  // a140
+	193: 788,  // a141
+	194: 788,  // a142
+	195: 788,  // a143
+	196: 788,  // a144
+	197: 788,  // a145
+	198: 788,  // a146
+	199: 788,  // a147
+	200: 788,  // a148
+	201: 788,  // a149
+	202: 788,  // a150
+	203: 788,  // a151
+	204: 788,  // a152
+	205: 788,  // a153
+	206: 788,  // a154
+	207: 788,  // a155
+	208: 788,  // a156
+	209: 788,  // a157
+	210: 788,  // a158
+	211: 788,  // a159
+	212: 894,  // a160
+	213: 838,  // a161
+	214: 1016, // a163
+	215: 458,  // a164
+	216: 748,  // a196
+	217: 924,  // a165
+	218: 748,  // a192
+	219: 918,  // a166
+	220: 927,  // a167
+	221: 928,  // a168
+	222: 928,  // a169
+	223: 834,  // a170
+	224: 873,  // a171
+	225: 828,  // a172
+	226: 924,  // a173
+	227: 924,  // a162
+	228: 917,  // a174
+	229: 930,  // a175
+	230: 931,  // a176
+	231: 463,  // a177
+	232: 883,  // a178
+	233: 836,  // a179
+	234: 836,  // a193
+	235: 867,  // a180
+	236: 867,  // a199
+	237: 696,  // a181
+	238: 696,  // a200
+	239: 874,  // a182
+	241: 874,  // a201
+	242: 760,  // a183
+	243: 946,  // a184
+	244: 771,  // a197
+	245: 865,  // a185
+	246: 771,  // a194
+	247: 888,  // a198
+	248: 967,  // a186
+	249: 888,  // a195
+	250: 831,  // a187
+	251: 873,  // a188
+	252: 927,  // a189
+	253: 970,  // a190
+	254: 918,  // a191
 }
@@ -15,9 +15,27 @@ func (n name) String() string {
 }
 
 func (n name) marshalPDF(dst []byte) ([]byte, error) {
-	// TODO: escape characters
+	const hexDigits = "0123456789ABCDEF"
 	dst = append(dst, '/')
-	return append(dst, []byte(n)...), nil
+	for i := 0; i < len(n); i++ {
+		c := n[i]
+		if c < '!' || c > '~' || isNameDelimiter(c) {
+			dst = append(dst, '#', hexDigits[c>>4], hexDigits[c&0xf])
+		} else {
+			dst = append(dst, c)
+		}
+	}
+	return dst, nil
+}
+
+// isNameDelimiter reports whether c must be written as a #xx escape inside a
+// name.
+func isNameDelimiter(c byte) bool {
+	switch c {
+	case '#', '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
 }
 
 type indirectObject struct {
@@ -53,3 +71,16 @@ type Reference struct {
 func (ref Reference) marshalPDF(dst []byte) ([]byte, error) {
 	return append(dst, fmt.Sprintf("%d %d R", ref.Number, ref.Generation)...), nil
 }
+
+// hexString is a PDF string object that is written in hexadecimal form.  It
+// is used for binary data like two-byte character codes.
+type hexString string
+
+func (s hexString) marshalPDF(dst []byte) ([]byte, error) {
+	const digits = "0123456789ABCDEF"
+	dst = append(dst, '<')
+	for i := 0; i < len(s); i++ {
+		dst = append(dst, digits[s[i]>>4], digits[s[i]&0xf])
+	}
+	return append(dst, '>'), nil
+}
@@ -0,0 +1,1007 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"encoding/binary"
+	"sort"
+)
+
+// otData is part of an OpenType layout table.  Reads outside of the data
+// return zero, so a malformed table behaves like an empty one instead of
+// causing a panic.
+type otData []byte
+
+func (d otData) u16(off int) uint16 {
+	if off < 0 || off+2 > len(d) {
+		return 0
+	}
+	return binary.BigEndian.Uint16(d[off:])
+}
+
+func (d otData) i16(off int) int {
+	return int(int16(d.u16(off)))
+}
+
+func (d otData) u32(off int) uint32 {
+	if off < 0 || off+4 > len(d) {
+		return 0
+	}
+	return binary.BigEndian.Uint32(d[off:])
+}
+
+// tag returns the four-byte tag at off.
+func (d otData) tag(off int) string {
+	if off < 0 || off+4 > len(d) {
+		return ""
+	}
+	return string(d[off : off+4])
+}
+
+// at returns the data that starts at off, or nil if off is a null offset.
+func (d otData) at(off int) otData {
+	if off <= 0 || off >= len(d) {
+		return nil
+	}
+	return d[off:]
+}
+
+// offset returns the data pointed to by the 16-bit offset at off.
+func (d otData) offset(off int) otData {
+	return d.at(int(d.u16(off)))
+}
+
+// coverage returns the index of g in a coverage table, or -1 if the table
+// does not cover g.
+func (d otData) coverage(g uint16) int {
+	n := int(d.u16(2))
+	switch d.u16(0) {
+	case 1:
+		i := sort.Search(n, func(i int) bool { return d.u16(4+2*i) >= g })
+		if i < n && d.u16(4+2*i) == g {
+			return i
+		}
+	case 2:
+		i := sort.Search(n, func(i int) bool { return d.u16(4+6*i+2) >= g })
+		if i < n && d.u16(4+6*i) <= g {
+			return int(d.u16(4+6*i+4)) + int(g-d.u16(4+6*i))
+		}
+	}
+	return -1
+}
+
+// class returns the class of g in a class definition table.
+func (d otData) class(g uint16) int {
+	switch d.u16(0) {
+	case 1:
+		start, n := d.u16(2), d.u16(4)
+		if g >= start && g-start < n {
+			return int(d.u16(6 + 2*int(g-start)))
+		}
+	case 2:
+		n := int(d.u16(2))
+		i := sort.Search(n, func(i int) bool { return d.u16(4+6*i+2) >= g })
+		if i < n && d.u16(4+6*i) <= g {
+			return int(d.u16(4 + 6*i + 4))
+		}
+	}
+	return 0
+}
+
+// anchor returns the coordinates of an anchor table.
+func (d otData) anchor() (x, y int) {
+	return d.i16(2), d.i16(4)
+}
+
+// GDEF glyph classes
+const (
+	glyphClassBase      = 1
+	glyphClassLigature  = 2
+	glyphClassMark      = 3
+	glyphClassComponent = 4
+)
+
+// Lookup flags
+const (
+	lookupRightToLeft         = 0x0001
+	lookupIgnoreBaseGlyphs    = 0x0002
+	lookupIgnoreLigatures     = 0x0004
+	lookupIgnoreMarks         = 0x0008
+	lookupUseMarkFilteringSet = 0x0010
+)
+
+// otLayout holds a font's OpenType layout tables.
+type otLayout struct {
+	gsub, gpos *otTable
+
+	glyphClasses otData // GDEF glyph class definitions
+	markClasses  otData // GDEF mark attachment classes
+	markSets     otData // GDEF mark glyph sets
+
+	plans    map[planKey]*shapePlan
+	vertical *shapeStage // vertical alternates, see verticalStage
+}
+
+// otTable is a GSUB or GPOS table.
+type otTable struct {
+	scripts  otData
+	features otData
+	lookups  []otLookup
+	gpos     bool
+}
+
+type otLookup struct {
+	kind      uint16
+	flag      uint16
+	markSet   int
+	subtables []otData
+}
+
+// Lookup types
+const (
+	gsubSingle           = 1
+	gsubMultiple         = 2
+	gsubAlternate        = 3
+	gsubLigature         = 4
+	gsubContext          = 5
+	gsubChainingContext  = 6
+	gsubExtension        = 7
+	gsubReverseChaining  = 8
+	gposSingle           = 1
+	gposPair             = 2
+	gposCursive          = 3
+	gposMarkToBase       = 4
+	gposMarkToLigature   = 5
+	gposMarkToMark       = 6
+	gposContext          = 7
+	gposChainingContext  = 8
+	gposExtension        = 9
+	otContextLookupDepth = 8
+)
+
+// parseOTLayout reads the layout tables of a font.  It returns nil if the
+// font has no GSUB or GPOS table.
+func parseOTLayout(tables map[string][]byte) *otLayout {
+	gsub, gpos := tables["GSUB"], tables["GPOS"]
+	if gsub == nil && gpos == nil {
+		return nil
+	}
+	l := new(otLayout)
+	if gdef := otData(tables["GDEF"]); gdef != nil {
+		l.glyphClasses = gdef.offset(4)
+		l.markClasses = gdef.offset(10)
+		if gdef.u16(2) >= 2 {
+			l.markSets = gdef.offset(12)
+		}
+	}
+	if gsub != nil {
+		l.gsub = parseOTTable(gsub, false)
+	}
+	if gpos != nil {
+		l.gpos = parseOTTable(gpos, true)
+	}
+	return l
+}
+
+func parseOTTable(data otData, gpos bool) *otTable {
+	t := &otTable{
+		scripts:  data.offset(4),
+		features: data.offset(6),
+		gpos:     gpos,
+	}
+	extension := uint16(gsubExtension)
+	if gpos {
+		extension = gposExtension
+	}
+	list := data.offset(8)
+	t.lookups = make([]otLookup, list.u16(0))
+	for i := range t.lookups {
+		d := list.offset(2 + 2*i)
+		lk := &t.lookups[i]
+		lk.kind, lk.flag = d.u16(0), d.u16(2)
+		n := int(d.u16(4))
+		for k := 0; k < n; k++ {
+			sub := d.offset(6 + 2*k)
+			if d.u16(0) == extension {
+				lk.kind = sub.u16(2)
+				sub = sub.at(int(sub.u32(4)))
+			}
+			lk.subtables = append(lk.subtables, sub)
+		}
+		if lk.flag&lookupUseMarkFilteringSet != 0 {
+			lk.markSet = int(d.u16(6 + 2*n))
+		}
+	}
+	return t
+}
+
+// langSys returns the default language system of the first of the scripts
+// that the table has.
+func (t *otTable) langSys(scripts []string) otData {
+	n := int(t.scripts.u16(0))
+	for _, tag := range scripts {
+		for i := 0; i < n; i++ {
+			if t.scripts.tag(2+6*i) == tag {
+				return t.scripts.offset(2 + 6*i + 4).offset(0)
+			}
+		}
+	}
+	return nil
+}
+
+// featureLookups returns the indices of the lookups used by a feature in a
+// language system.  An empty tag selects the required feature.
+func (t *otTable) featureLookups(ls otData, tag string) []int {
+	var indices []int
+	add := func(fi int) {
+		feature := t.features.offset(2 + 6*fi + 4)
+		for k := 0; k < int(feature.u16(2)); k++ {
+			indices = append(indices, int(feature.u16(4+2*k)))
+		}
+	}
+	if tag == "" {
+		if fi := ls.u16(2); ls != nil && fi != 0xffff {
+			add(int(fi))
+		}
+		return indices
+	}
+	for i := 0; i < int(ls.u16(4)); i++ {
+		fi := int(ls.u16(6 + 2*i))
+		if t.features.tag(2+6*fi) == tag {
+			add(fi)
+		}
+	}
+	return indices
+}
+
+// glyphClass returns the GDEF class of a glyph, or zero if the font does not
+// classify its glyphs.
+func (l *otLayout) glyphClass(g uint16) int {
+	return l.glyphClasses.class(g)
+}
+
+// inMarkSet reports whether a glyph is in a GDEF mark glyph set.
+func (l *otLayout) inMarkSet(set int, g uint16) bool {
+	return l.markSets.at(int(l.markSets.u32(4+4*set))).coverage(g) >= 0
+}
+
+// glyphInfo is a glyph in a string being shaped.
+type glyphInfo struct {
+	gid      uint16
+	cluster  int // index of the first character that the glyph represents
+	mask     uint32
+	class    int // GDEF glyph class
+	category byte
+	syllable int
+	ligID    int // identifies the ligature that the glyph is or is part of
+	ligComp  int // for marks in a ligature, the component they belong to
+
+	// Positions are in font design units.  attach is the offset to the
+	// glyph that this glyph is attached to, if it is not zero.
+	advance          int
+	xOffset, yOffset int
+	attach           int
+	attachMark       bool
+}
+
+// glyphBuffer is a string of glyphs that layout lookups are applied to.
+type glyphBuffer struct {
+	glyphs []glyphInfo
+	layout *otLayout
+	rtl    bool
+
+	mask      uint32 // mask of the lookup being applied
+	depth     int    // nesting of contextual lookups
+	nextLigID int
+}
+
+// skip reports whether a glyph is ignored by a lookup.
+func (b *glyphBuffer) skip(i int, lk *otLookup) bool {
+	g := &b.glyphs[i]
+	switch g.class {
+	case glyphClassBase:
+		return lk.flag&lookupIgnoreBaseGlyphs != 0
+	case glyphClassLigature:
+		return lk.flag&lookupIgnoreLigatures != 0
+	case glyphClassMark:
+		if lk.flag&lookupIgnoreMarks != 0 {
+			return true
+		}
+		if t := int(lk.flag >> 8); t != 0 && b.layout.markClasses.class(g.gid) != t {
+			return true
+		}
+		if lk.flag&lookupUseMarkFilteringSet != 0 && !b.layout.inMarkSet(lk.markSet, g.gid) {
+			return true
+		}
+	}
+	return false
+}
+
+// next returns the index of the first glyph after i that is not ignored by a
+// lookup, or -1 if there is none.
+func (b *glyphBuffer) next(i int, lk *otLookup) int {
+	for i++; i < len(b.glyphs); i++ {
+		if !b.skip(i, lk) {
+			return i
+		}
+	}
+	return -1
+}
+
+// prev returns the index of the last glyph before i that is not ignored by a
+// lookup, or -1 if there is none.
+func (b *glyphBuffer) prev(i int, lk *otLookup) int {
+	for i--; i >= 0; i-- {
+		if !b.skip(i, lk) {
+			return i
+		}
+	}
+	return -1
+}
+
+// setGlyph changes the glyph at i.
+func (b *glyphBuffer) setGlyph(i int, g uint16) {
+	b.glyphs[i].gid = g
+	if c := b.layout.glyphClass(g); c != 0 {
+		b.glyphs[i].class = c
+	}
+}
+
+// apply applies each lookup to the buffer.  masks gives the glyphs that each
+// lookup applies to.
+func (b *glyphBuffer) apply(t *otTable, lookups []int, masks []uint32) {
+	for n, index := range lookups {
+		if index >= len(t.lookups) {
+			continue
+		}
+		lk := &t.lookups[index]
+		b.mask = masks[n]
+		if !t.gpos && lk.kind == gsubReverseChaining {
+			for i := len(b.glyphs) - 1; i >= 0; i-- {
+				if b.glyphs[i].mask&b.mask != 0 && !b.skip(i, lk) {
+					b.applyLookup(t, lk, i)
+				}
+			}
+			continue
+		}
+		for i := 0; i < len(b.glyphs); {
+			if b.glyphs[i].mask&b.mask != 0 && !b.skip(i, lk) {
+				if next, ok := b.applyLookup(t, lk, i); ok && next > i {
+					i = next
+					continue
+				}
+			}
+			i++
+		}
+	}
+}
+
+// applyLookup applies the first subtable of a lookup that matches at i.  It
+// returns the index of the next glyph to process.
+func (b *glyphBuffer) applyLookup(t *otTable, lk *otLookup, i int) (int, bool) {
+	for _, sub := range lk.subtables {
+		var next int
+		var ok bool
+		if t.gpos {
+			next, ok = b.position(t, lk, sub, i)
+		} else {
+			next, ok = b.substitute(t, lk, sub, i)
+		}
+		if ok {
+			return next, true
+		}
+	}
+	return 0, false
+}
+
+// substitute applies a GSUB subtable at i.
+func (b *glyphBuffer) substitute(t *otTable, lk *otLookup, sub otData, i int) (int, bool) {
+	g := b.glyphs[i].gid
+	switch lk.kind {
+	case gsubContext:
+		return b.context(t, lk, sub, i)
+	case gsubChainingContext:
+		return b.chainingContext(t, lk, sub, i)
+	case gsubReverseChaining:
+		return b.reverseChaining(lk, sub, i)
+	}
+	cov := sub.offset(2).coverage(g)
+	if cov < 0 {
+		return 0, false
+	}
+	switch lk.kind {
+	case gsubSingle:
+		switch sub.u16(0) {
+		case 1:
+			b.setGlyph(i, g+sub.u16(4))
+		case 2:
+			if cov >= int(sub.u16(4)) {
+				return 0, false
+			}
+			b.setGlyph(i, sub.u16(6+2*cov))
+		default:
+			return 0, false
+		}
+		return i + 1, true
+	case gsubMultiple, gsubAlternate:
+		if cov >= int(sub.u16(4)) {
+			return 0, false
+		}
+		seq := sub.offset(6 + 2*cov)
+		n := int(seq.u16(0))
+		if lk.kind == gsubAlternate {
+			if n == 0 {
+				return 0, false
+			}
+			b.setGlyph(i, seq.u16(2))
+			return i + 1, true
+		}
+		glyphs := make([]uint16, n)
+		for k := range glyphs {
+			glyphs[k] = seq.u16(2 + 2*k)
+		}
+		b.replace(i, glyphs)
+		return i + n, true
+	case gsubLigature:
+		if cov >= int(sub.u16(4)) {
+			return 0, false
+		}
+		set := sub.offset(6 + 2*cov)
+		for k := 0; k < int(set.u16(0)); k++ {
+			lig := set.offset(2 + 2*k)
+			pos := b.matchInput(lk, i, int(lig.u16(2)), func(n int, g uint16) bool {
+				return g == lig.u16(4+2*(n-1))
+			})
+			if pos != nil {
+				b.ligate(pos, lig.u16(0))
+				return i + 1, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// replace replaces the glyph at i with a sequence of glyphs.
+func (b *glyphBuffer) replace(i int, glyphs []uint16) {
+	seq := make([]glyphInfo, len(glyphs))
+	for k, g := range glyphs {
+		seq[k] = b.glyphs[i]
+		seq[k].gid = g
+		if c := b.layout.glyphClass(g); c != 0 {
+			seq[k].class = c
+		}
+	}
+	b.glyphs = append(b.glyphs[:i], append(seq, b.glyphs[i+1:]...)...)
+}
+
+// ligate replaces the glyphs at pos with a ligature.  Glyphs between the
+// components that were skipped stay after the ligature and belong to its
+// cluster.
+func (b *glyphBuffer) ligate(pos []int, lig uint16) {
+	b.nextLigID++
+	first := &b.glyphs[pos[0]]
+	comp, k := 1, 1
+	for j := pos[0] + 1; j <= pos[len(pos)-1]; j++ {
+		if k < len(pos) && j == pos[k] {
+			comp++
+			k++
+			continue
+		}
+		b.glyphs[j].ligID, b.glyphs[j].ligComp = b.nextLigID, comp
+		b.glyphs[j].cluster = first.cluster
+	}
+	first.ligID, first.ligComp = b.nextLigID, 0
+	first.class = glyphClassLigature
+	b.setGlyph(pos[0], lig)
+	for k := len(pos) - 1; k >= 1; k-- {
+		b.glyphs = append(b.glyphs[:pos[k]], b.glyphs[pos[k]+1:]...)
+	}
+}
+
+// matchInput matches an input sequence of n glyphs that starts at i.  match
+// reports whether the glyph g matches the k-th element of the sequence, for k
+// from 1.  It returns the positions of the matched glyphs.
+func (b *glyphBuffer) matchInput(lk *otLookup, i, n int, match func(k int, g uint16) bool) []int {
+	pos := []int{i}
+	for k := 1; k < n; k++ {
+		i = b.next(i, lk)
+		if i < 0 || b.glyphs[i].mask&b.mask == 0 || !match(k, b.glyphs[i].gid) {
+			return nil
+		}
+		pos = append(pos, i)
+	}
+	return pos
+}
+
+// matchBacktrack matches a sequence of n glyphs before i, in reverse order.
+func (b *glyphBuffer) matchBacktrack(lk *otLookup, i, n int, match func(k int, g uint16) bool) bool {
+	for k := 0; k < n; k++ {
+		if i = b.prev(i, lk); i < 0 || !match(k, b.glyphs[i].gid) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchLookahead matches a sequence of n glyphs after i.
+func (b *glyphBuffer) matchLookahead(lk *otLookup, i, n int, match func(k int, g uint16) bool) bool {
+	for k := 0; k < n; k++ {
+		if i = b.next(i, lk); i < 0 || !match(k, b.glyphs[i].gid) {
+			return false
+		}
+	}
+	return true
+}
+
+// contextRule is a rule of a contextual lookup.  The sequences hold glyphs,
+// classes or coverage table offsets, depending on the subtable's format.  The
+// input sequence does not include the first glyph.
+type contextRule struct {
+	backtrack, input, lookahead []uint16
+	records                     otData
+	count                       int
+}
+
+func readSequence(d otData, off, n int) []uint16 {
+	seq := make([]uint16, 0, n)
+	for k := 0; k < n; k++ {
+		seq = append(seq, d.u16(off+2*k))
+	}
+	return seq
+}
+
+// readChainRule reads a chaining contextual rule.  If withFirst is true, the
+// input sequence includes the first glyph, which is dropped.
+func readChainRule(d otData, off int, withFirst bool) contextRule {
+	var r contextRule
+	n := int(d.u16(off))
+	r.backtrack = readSequence(d, off+2, n)
+	off += 2 + 2*n
+	n = int(d.u16(off))
+	if withFirst {
+		r.input = readSequence(d, off+2, n)
+		if len(r.input) > 0 {
+			r.input = r.input[1:]
+		}
+	} else if n > 0 {
+		r.input = readSequence(d, off+2, n-1)
+		n--
+	}
+	off += 2 + 2*n
+	n = int(d.u16(off))
+	r.lookahead = readSequence(d, off+2, n)
+	off += 2 + 2*n
+	r.count = int(d.u16(off))
+	r.records = d.at(off + 2)
+	return r
+}
+
+// applyRule matches a contextual rule at i and applies its nested lookups.
+// match reports whether a glyph matches an element of the backtrack (0),
+// input (1) or lookahead (2) sequence.
+func (b *glyphBuffer) applyRule(t *otTable, lk *otLookup, r contextRule, i int, match func(seq int, v, g uint16) bool) (int, bool) {
+	pos := b.matchInput(lk, i, len(r.input)+1, func(k int, g uint16) bool {
+		return match(1, r.input[k-1], g)
+	})
+	if pos == nil {
+		return 0, false
+	}
+	if !b.matchBacktrack(lk, i, len(r.backtrack), func(k int, g uint16) bool {
+		return match(0, r.backtrack[k], g)
+	}) {
+		return 0, false
+	}
+	if !b.matchLookahead(lk, pos[len(pos)-1], len(r.lookahead), func(k int, g uint16) bool {
+		return match(2, r.lookahead[k], g)
+	}) {
+		return 0, false
+	}
+	return b.applyNested(t, pos, r.records, r.count), true
+}
+
+// applyNested applies the lookups of a matched contextual rule.  It returns
+// the index after the matched input.
+func (b *glyphBuffer) applyNested(t *otTable, pos []int, records otData, n int) int {
+	if b.depth >= otContextLookupDepth {
+		return pos[len(pos)-1] + 1
+	}
+	b.depth++
+	mask := b.mask
+	for r := 0; r < n; r++ {
+		seq, index := int(records.u16(4*r)), int(records.u16(4*r+2))
+		if seq >= len(pos) || index >= len(t.lookups) || pos[seq] >= len(b.glyphs) {
+			continue
+		}
+		lk := &t.lookups[index]
+		if b.skip(pos[seq], lk) {
+			continue
+		}
+		before := len(b.glyphs)
+		b.applyLookup(t, lk, pos[seq])
+		b.mask = mask
+		if d := len(b.glyphs) - before; d != 0 {
+			for k := seq + 1; k < len(pos); k++ {
+				pos[k] += d
+				if pos[k] <= pos[seq] {
+					pos[k] = pos[seq]
+				}
+			}
+		}
+	}
+	b.depth--
+	next := pos[len(pos)-1] + 1
+	if next > len(b.glyphs) {
+		next = len(b.glyphs)
+	}
+	return next
+}
+
+// context applies a contextual subtable at i.
+func (b *glyphBuffer) context(t *otTable, lk *otLookup, sub otData, i int) (int, bool) {
+	g := b.glyphs[i].gid
+	switch sub.u16(0) {
+	case 1, 2:
+		var set otData
+		var classes otData
+		if sub.u16(0) == 1 {
+			cov := sub.offset(2).coverage(g)
+			if cov < 0 || cov >= int(sub.u16(4)) {
+				return 0, false
+			}
+			set = sub.offset(6 + 2*cov)
+		} else {
+			if sub.offset(2).coverage(g) < 0 {
+				return 0, false
+			}
+			classes = sub.offset(4)
+			c := classes.class(g)
+			if c >= int(sub.u16(6)) {
+				return 0, false
+			}
+			set = sub.offset(8 + 2*c)
+		}
+		match := func(seq int, v, g uint16) bool {
+			if classes != nil {
+				return classes.class(g) == int(v)
+			}
+			return g == v
+		}
+		for k := 0; k < int(set.u16(0)); k++ {
+			rule := set.offset(2 + 2*k)
+			n := int(rule.u16(0))
+			if n == 0 {
+				continue
+			}
+			r := contextRule{
+				input:   readSequence(rule, 4, n-1),
+				count:   int(rule.u16(2)),
+				records: rule.at(4 + 2*(n-1)),
+			}
+			if next, ok := b.applyRule(t, lk, r, i, match); ok {
+				return next, true
+			}
+		}
+	case 3:
+		n := int(sub.u16(2))
+		if n == 0 || sub.offset(6).coverage(g) < 0 {
+			return 0, false
+		}
+		r := contextRule{
+			input:   readSequence(sub, 8, n-1),
+			count:   int(sub.u16(4)),
+			records: sub.at(6 + 2*n),
+		}
+		return b.applyRule(t, lk, r, i, func(seq int, v, g uint16) bool {
+			return sub.at(int(v)).coverage(g) >= 0
+		})
+	}
+	return 0, false
+}
+
+// chainingContext applies a chaining contextual subtable at i.
+func (b *glyphBuffer) chainingContext(t *otTable, lk *otLookup, sub otData, i int) (int, bool) {
+	g := b.glyphs[i].gid
+	switch sub.u16(0) {
+	case 1, 2:
+		var set otData
+		var classes [3]otData
+		if sub.u16(0) == 1 {
+			cov := sub.offset(2).coverage(g)
+			if cov < 0 || cov >= int(sub.u16(4)) {
+				return 0, false
+			}
+			set = sub.offset(6 + 2*cov)
+		} else {
+			if sub.offset(2).coverage(g) < 0 {
+				return 0, false
+			}
+			classes = [3]otData{sub.offset(4), sub.offset(6), sub.offset(8)}
+			c := classes[1].class(g)
+			if c >= int(sub.u16(10)) {
+				return 0, false
+			}
+			set = sub.offset(12 + 2*c)
+		}
+		match := func(seq int, v, g uint16) bool {
+			if sub.u16(0) == 2 {
+				return classes[seq].class(g) == int(v)
+			}
+			return g == v
+		}
+		for k := 0; k < int(set.u16(0)); k++ {
+			r := readChainRule(set.offset(2+2*k), 0, false)
+			if next, ok := b.applyRule(t, lk, r, i, match); ok {
+				return next, true
+			}
+		}
+	case 3:
+		r := readChainRule(sub, 2, true)
+		first := sub.at(int(sub.u16(2 + 2*len(r.backtrack) + 2)))
+		if first.coverage(g) < 0 {
+			return 0, false
+		}
+		return b.applyRule(t, lk, r, i, func(seq int, v, g uint16) bool {
+			return sub.at(int(v)).coverage(g) >= 0
+		})
+	}
+	return 0, false
+}
+
+// reverseChaining applies a reverse chaining single substitution at i.
+func (b *glyphBuffer) reverseChaining(lk *otLookup, sub otData, i int) (int, bool) {
+	cov := sub.offset(2).coverage(b.glyphs[i].gid)
+	if cov < 0 {
+		return 0, false
+	}
+	backtrack := readSequence(sub, 6, int(sub.u16(4)))
+	off := 6 + 2*len(backtrack)
+	lookahead := readSequence(sub, off+2, int(sub.u16(off)))
+	off += 2 + 2*len(lookahead)
+	if cov >= int(sub.u16(off)) {
+		return 0, false
+	}
+	covers := func(seq []uint16) func(k int, g uint16) bool {
+		return func(k int, g uint16) bool { return sub.at(int(seq[k])).coverage(g) >= 0 }
+	}
+	if !b.matchBacktrack(lk, i, len(backtrack), covers(backtrack)) || !b.matchLookahead(lk, i, len(lookahead), covers(lookahead)) {
+		return 0, false
+	}
+	b.setGlyph(i, sub.u16(off+2+2*cov))
+	return i - 1, true
+}
+
+// valueSize returns the size of a GPOS value record.
+func valueSize(format uint16) int {
+	n := 0
+	for ; format != 0; format >>= 1 {
+		n += int(format & 1)
+	}
+	return 2 * n
+}
+
+// applyValue adds a GPOS value record to the position of the glyph at i.
+func (b *glyphBuffer) applyValue(i int, d otData, off int, format uint16) {
+	g := &b.glyphs[i]
+	if format&0x0001 != 0 {
+		g.xOffset += d.i16(off)
+		off += 2
+	}
+	if format&0x0002 != 0 {
+		g.yOffset += d.i16(off)
+		off += 2
+	}
+	if format&0x0004 != 0 {
+		g.advance += d.i16(off)
+	}
+}
+
+// position applies a GPOS subtable at i.
+func (b *glyphBuffer) position(t *otTable, lk *otLookup, sub otData, i int) (int, bool) {
+	g := b.glyphs[i].gid
+	switch lk.kind {
+	case gposContext:
+		return b.context(t, lk, sub, i)
+	case gposChainingContext:
+		return b.chainingContext(t, lk, sub, i)
+	}
+	cov := sub.offset(2).coverage(g)
+	if cov < 0 {
+		return 0, false
+	}
+	switch lk.kind {
+	case gposSingle:
+		format := sub.u16(4)
+		switch sub.u16(0) {
+		case 1:
+			b.applyValue(i, sub, 6, format)
+		case 2:
+			if cov >= int(sub.u16(6)) {
+				return 0, false
+			}
+			b.applyValue(i, sub, 8+cov*valueSize(format), format)
+		default:
+			return 0, false
+		}
+		return i + 1, true
+	case gposPair:
+		j := b.next(i, lk)
+		if j < 0 || b.glyphs[j].mask&b.mask == 0 {
+			return 0, false
+		}
+		f1, f2 := sub.u16(4), sub.u16(6)
+		s1, s2 := valueSize(f1), valueSize(f2)
+		var rec otData
+		var off int
+		switch sub.u16(0) {
+		case 1:
+			if cov >= int(sub.u16(8)) {
+				return 0, false
+			}
+			set := sub.offset(10 + 2*cov)
+			size := 2 + s1 + s2
+			n := int(set.u16(0))
+			second := b.glyphs[j].gid
+			k := sort.Search(n, func(k int) bool { return set.u16(2+k*size) >= second })
+			if k == n || set.u16(2+k*size) != second {
+				return 0, false
+			}
+			rec, off = set, 2+k*size+2
+		case 2:
+			c1 := sub.offset(8).class(g)
+			c2 := sub.offset(10).class(b.glyphs[j].gid)
+			n1, n2 := int(sub.u16(12)), int(sub.u16(14))
+			if c1 >= n1 || c2 >= n2 {
+				return 0, false
+			}
+			rec, off = sub, 16+(c1*n2+c2)*(s1+s2)
+		default:
+			return 0, false
+		}
+		b.applyValue(i, rec, off, f1)
+		b.applyValue(j, rec, off+s1, f2)
+		if f2 != 0 {
+			return j + 1, true
+		}
+		return j, true
+	case gposCursive:
+		entry := sub.at(int(sub.u16(6 + 4*cov)))
+		if entry == nil || cov >= int(sub.u16(4)) {
+			return 0, false
+		}
+		p := b.prev(i, lk)
+		if p < 0 {
+			return 0, false
+		}
+		pcov := sub.offset(2).coverage(b.glyphs[p].gid)
+		if pcov < 0 {
+			return 0, false
+		}
+		exit := sub.at(int(sub.u16(6 + 4*pcov + 2)))
+		if exit == nil {
+			return 0, false
+		}
+		b.attachCursive(p, i, exit, entry, lk.flag&lookupRightToLeft != 0)
+		return i + 1, true
+	case gposMarkToBase, gposMarkToLigature, gposMarkToMark:
+		marks := sub.offset(8)
+		if cov >= int(marks.u16(0)) {
+			return 0, false
+		}
+		class := int(marks.u16(2 + 4*cov))
+		markAnchor := marks.offset(2 + 4*cov + 2)
+		classes := int(sub.u16(6))
+		if class >= classes {
+			return 0, false
+		}
+
+		// Find the glyph that the mark attaches to.
+		j := i - 1
+		if lk.kind == gposMarkToMark {
+			j = b.prev(i, lk)
+			if j < 0 || b.glyphs[j].class != glyphClassMark {
+				return 0, false
+			}
+		} else {
+			for j >= 0 && b.glyphs[j].class == glyphClassMark {
+				j--
+			}
+		}
+		if j < 0 {
+			return 0, false
+		}
+		base := sub.offset(4).coverage(b.glyphs[j].gid)
+		if base < 0 {
+			return 0, false
+		}
+		array := sub.offset(10)
+		var anchor otData
+		switch lk.kind {
+		case gposMarkToLigature:
+			attach := array.offset(2 + 2*base)
+			n := int(attach.u16(0))
+			if n == 0 {
+				return 0, false
+			}
+			comp := n - 1
+			if m := b.glyphs[i]; m.ligID == b.glyphs[j].ligID && m.ligComp > 0 && m.ligComp <= n {
+				comp = m.ligComp - 1
+			}
+			anchor = attach.offset(2 + 2*(comp*classes+class))
+		default:
+			anchor = array.offset(2 + 2*(base*classes+class))
+		}
+		if anchor == nil || markAnchor == nil {
+			return 0, false
+		}
+		bx, by := anchor.anchor()
+		mx, my := markAnchor.anchor()
+		m := &b.glyphs[i]
+		m.xOffset, m.yOffset = bx-mx, by-my
+		m.attach, m.attachMark = j-i, true
+		return i + 1, true
+	}
+	return 0, false
+}
+
+// attachCursive connects the exit anchor of the glyph at i to the entry anchor
+// of the glyph at j.
+func (b *glyphBuffer) attachCursive(i, j int, exit, entry otData, rightToLeft bool) {
+	ex, ey := exit.anchor()
+	nx, ny := entry.anchor()
+	gi, gj := &b.glyphs[i], &b.glyphs[j]
+	if b.rtl {
+		d := ex + gi.xOffset
+		gi.advance -= d
+		gi.xOffset -= d
+		gj.advance = nx + gj.xOffset
+	} else {
+		gi.advance = ex + gi.xOffset
+		d := nx + gj.xOffset
+		gj.advance -= d
+		gj.xOffset -= d
+	}
+	if rightToLeft {
+		gi.attach, gi.attachMark = j-i, false
+		gi.yOffset = ny - ey
+	} else {
+		gj.attach, gj.attachMark = i-j, false
+		gj.yOffset = ey - ny
+	}
+}
+
+// resolveAttachments makes the offsets of attached glyphs relative to their
+// own position instead of the glyph they are attached to.
+func (b *glyphBuffer) resolveAttachments() {
+	for i := range b.glyphs {
+		b.resolveAttachment(i, 0)
+	}
+}
+
+func (b *glyphBuffer) resolveAttachment(i, depth int) {
+	g := &b.glyphs[i]
+	if g.attach == 0 {
+		return
+	}
+	j := i + g.attach
+	g.attach = 0
+	if j < 0 || j >= len(b.glyphs) || depth > len(b.glyphs) {
+		return
+	}
+	b.resolveAttachment(j, depth+1)
+	g.yOffset += b.glyphs[j].yOffset
+	if !g.attachMark {
+		return
+	}
+	g.xOffset += b.glyphs[j].xOffset
+	if j < i {
+		if b.rtl {
+			for k := j + 1; k <= i; k++ {
+				g.xOffset += b.glyphs[k].advance
+			}
+		} else {
+			for k := j; k < i; k++ {
+				g.xOffset -= b.glyphs[k].advance
+			}
+		}
+	}
+}
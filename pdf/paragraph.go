@@ -0,0 +1,507 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Alignment is the horizontal placement of lines in a paragraph.
+type Alignment int
+
+// Paragraph alignments
+const (
+	AlignLeft Alignment = iota
+	AlignRight
+	AlignCenter
+	// AlignJustify stretches the space between words so that every line
+	// except the last line of each paragraph fills the width.
+	AlignJustify
+)
+
+// A Span is a run of text in a single style.
+type Span struct {
+	Text string
+
+	// Font and Size are the font the text is shown in.  If Font is nil, the
+	// text object's current font is used, which must then have been set
+	// with SetFontFace; if Size is also zero, the current size is used.
+	Font Font
+	Size Unit
+
+	// Color is the color the text is painted with.  If it is nil, the
+	// current fill color is used.  A color that cannot be painted is
+	// reported by TextSpans and left unapplied by Paragraph.DrawSpans.
+	Color Color
+}
+
+// TextSpans adds a sequence of styled runs of text to the text object.  Each
+// span starts where the previous one ended, on the same baseline.  If a span's
+// color cannot be painted, TextSpans stops before that span and returns the
+// error.
+func (text *Text) TextSpans(spans []Span) error {
+	for _, span := range spans {
+		if err := text.setSpanStyle(span); err != nil {
+			return err
+		}
+		text.Text(span.Text)
+	}
+	return nil
+}
+
+// spanStyle returns span with a nil font replaced by the current font.
+func (text *Text) spanStyle(span Span) Span {
+	if span.Font == nil {
+		span.Font = text.currFont
+		if span.Size == 0 {
+			span.Size = text.currSize
+		}
+	}
+	return span
+}
+
+// setSpanStyle changes the current font and color to the span's style.
+func (text *Text) setSpanStyle(span Span) error {
+	span = text.spanStyle(span)
+	if span.Font != text.currFont || span.Size != text.currSize {
+		text.SetFontFace(span.Font, span.Size)
+	}
+	if span.Color != nil {
+		return text.SetFillColor(span.Color)
+	}
+	return nil
+}
+
+// A Paragraph breaks text into lines that fit in a box.
+type Paragraph struct {
+	// Font and Size are the font that Draw shows text in.  As in a Span, a
+	// nil Font selects the text object's current font.
+	Font Font
+	Size Unit
+
+	// Leading is the distance between baselines.  If it is zero, 1.2 times
+	// the largest font size on each line is used.
+	Leading Unit
+
+	// Width is the width of the lines.  Height is the maximum height of the
+	// paragraph; if it is zero, the height is not limited.
+	Width  Unit
+	Height Unit
+
+	Align Alignment
+
+	// Hyphenator, if not nil, is used to break words that do not fit at the
+	// end of a line.  A hyphen is shown at the end of a broken line.
+	Hyphenator Hyphenator
+}
+
+// Draw lays out s in the paragraph's font and adds it to a text object.  The
+// first line starts at the beginning of the text object's current line, even
+// if text has already been shown on it, and the text cursor is left at the
+// beginning of the line after the paragraph.
+//
+// Lines are broken between words, and newlines in s start a new line.  If the
+// paragraph has a Hyphenator, words are also hyphenated to fill lines.  A word
+// that is wider than the paragraph and cannot be hyphenated is placed on a line
+// of its own.  Mixed-direction text is ordered with the Unicode Bidirectional
+// Algorithm in the text object's direction: levels are resolved for each
+// paragraph and then each line is reordered.  Draw
+// returns the height of the lines drawn and the text that did not fit in the
+// paragraph's height, which is empty if all of s was drawn.
+func (p *Paragraph) Draw(text *Text, s string) (height Unit, overflow string) {
+	height, rest := p.DrawSpans(text, []Span{{Text: s, Font: p.Font, Size: p.Size}})
+	if len(rest) > 0 {
+		overflow = rest[0].Text
+	}
+	return height, overflow
+}
+
+// DrawSpans is like Draw, but lays out a sequence of styled runs of text.  The
+// paragraph's Font and Size are ignored.  Spans are joined without spaces, so a
+// word may be made up of several spans.  The returned overflow holds the spans
+// that did not fit, starting with the remainder of the first span that was cut.
+func (p *Paragraph) DrawSpans(text *Text, spans []Span) (height Unit, overflow []Span) {
+	styled := make([]Span, len(spans))
+	for i := range spans {
+		styled[i] = text.spanStyle(spans[i])
+	}
+	spans = styled
+	lines, rest := p.breakLines(text, spans)
+
+	baseSpacing := text.wordSpacing
+	offset := Unit(0)
+	var para *bidiParagraph
+	var paraPos int // index of the line's first character in para
+	for i, ln := range lines {
+		if i == 0 || lines[i-1].last {
+			para, paraPos = newLineParagraph(lines[i:], text.direction), 0
+		}
+		// Measure the line with the text's own word spacing, not the
+		// spacing that justified the previous line.
+		lineSpacing := text.wordSpacing
+		text.wordSpacing = baseSpacing
+		w := p.lineWidth(text, spans, ln)
+		text.wordSpacing = lineSpacing
+		tw := baseSpacing
+		var newOffset Unit
+		switch p.Align {
+		case AlignRight:
+			newOffset = p.Width - w
+		case AlignCenter:
+			newOffset = (p.Width - w) / 2
+		case AlignJustify:
+			if n := ln.spaces(); !ln.last && n > 0 && w < p.Width {
+				tw += (p.Width - w) / (Unit(n) * Unit(text.horizontalScale()))
+			}
+		}
+		if tw != text.wordSpacing {
+			text.SetWordSpacing(tw)
+		}
+		if i == 0 {
+			// Td moves relative to the start of the current line, so it
+			// also brings a cursor that is partway along the line back.
+			if newOffset != 0 || text.lineX != 0 || text.lineY != 0 {
+				text.NextLineOffset(newOffset, 0)
+			}
+		} else {
+			text.NextLineOffset(newOffset-offset, -ln.leading)
+		}
+		offset = newOffset
+		items := ln.items
+		if para != nil {
+			items, paraPos = para.visualItems(ln, paraPos)
+		}
+		for _, item := range items {
+			// DrawSpans has no error to report a bad color with, so
+			// the text keeps its current color, as documented on Span.
+			text.setSpanStyle(spans[item.span])
+			text.show(item.s, item.rtl)
+		}
+		height += ln.leading
+	}
+	if text.wordSpacing != baseSpacing {
+		text.SetWordSpacing(baseSpacing)
+	}
+	if len(lines) > 0 {
+		var dx Unit
+		if offset != 0 {
+			dx = -offset
+		}
+		text.NextLineOffset(dx, -lines[len(lines)-1].leading)
+	}
+	return height, rest
+}
+
+// paragraphLine is a line of text produced by breaking a paragraph.
+type paragraphLine struct {
+	items   []lineItem
+	leading Unit
+	last    bool // whether the line ends a paragraph
+}
+
+// lineItem is a part of a line that is in a single span's style.
+type lineItem struct {
+	span int
+	s    string
+	rtl  bool // s is right-to-left text in logical order
+}
+
+// add appends a string in a span's style to the line.
+func (ln *paragraphLine) add(span int, s string) {
+	if n := len(ln.items); n > 0 && ln.items[n-1].span == span {
+		ln.items[n-1].s += s
+	} else {
+		ln.items = append(ln.items, lineItem{span: span, s: s})
+	}
+}
+
+// spaces returns the number of space characters in the line.
+func (ln *paragraphLine) spaces() int {
+	n := 0
+	for _, item := range ln.items {
+		for i := 0; i < len(item.s); i++ {
+			if item.s[i] == ' ' {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// newLineParagraph returns the bidirectional paragraph formed by lines up to
+// the end of the first paragraph.  Lines are separated by a space.  It returns
+// nil if the text does not need to be reordered.
+func newLineParagraph(lines []paragraphLine, dir Direction) *bidiParagraph {
+	var buf bytes.Buffer
+	for i, ln := range lines {
+		if i > 0 {
+			buf.WriteByte(' ')
+		}
+		for _, item := range ln.items {
+			buf.WriteString(item.s)
+		}
+		if ln.last {
+			break
+		}
+	}
+	if !bidiNeeded(buf.String(), dir) {
+		return nil
+	}
+	return newBidiParagraph(buf.String(), dir)
+}
+
+// visualItems returns the items of a line in the order that they are
+// displayed, split where the embedding level changes.  The line starts at index
+// pos of the paragraph's text.  visualItems also returns the index of the next
+// line.
+func (p *bidiParagraph) visualItems(ln paragraphLine, pos int) ([]lineItem, int) {
+	var itemOf []int
+	for i, item := range ln.items {
+		for n := utf8.RuneCountInString(item.s); n > 0; n-- {
+			itemOf = append(itemOf, i)
+		}
+	}
+	var items []lineItem
+	for _, run := range p.runs(pos, pos+len(itemOf)) {
+		// Split the run where the style changes.  The parts of a
+		// right-to-left run are displayed in reverse order.
+		var parts []lineItem
+		start := 0
+		for k := 1; k <= len(run.indices); k++ {
+			it := itemOf[run.indices[start]-pos]
+			if k < len(run.indices) && itemOf[run.indices[k]-pos] == it {
+				continue
+			}
+			part := bidiRun{indices: run.indices[start:k], rtl: run.rtl}
+			parts = append(parts, lineItem{span: ln.items[it].span, s: p.runText(part), rtl: run.rtl})
+			start = k
+		}
+		if run.rtl {
+			for a, b := 0, len(parts)-1; a < b; a, b = a+1, b-1 {
+				parts[a], parts[b] = parts[b], parts[a]
+			}
+		}
+		items = append(items, parts...)
+	}
+	return items, pos + len(itemOf) + 1
+}
+
+// lineWidth returns the width of a line as it would be shown in text.
+func (p *Paragraph) lineWidth(text *Text, spans []Span, ln paragraphLine) Unit {
+	var w Unit
+	for _, item := range ln.items {
+		span := spans[item.span]
+		w += text.measure(span.Font, span.Size, item.s)
+	}
+	return w
+}
+
+// lineLeading returns the leading of a line whose largest font size is given.
+func (p *Paragraph) lineLeading(size Unit) Unit {
+	if p.Leading != 0 {
+		return p.Leading
+	}
+	return size * defaultLeadingScalar
+}
+
+// breakLines splits spans into lines that fit in the paragraph.  It returns the
+// lines and the spans that did not fit.
+func (p *Paragraph) breakLines(text *Text, spans []Span) (lines []paragraphLine, rest []Span) {
+	var height Unit
+	var line paragraphLine
+	var lineSize Unit
+	lineStart, pos := spanPos{}, spanPos{}
+
+	// endLine adds the current line to lines.  It returns false if the line
+	// does not fit in the paragraph's height.
+	endLine := func(last bool) bool {
+		line.leading = p.lineLeading(lineSize)
+		if p.Height > 0 && height+line.leading > p.Height {
+			return false
+		}
+		height += line.leading
+		line.last = last
+		lines = append(lines, line)
+		line, lineSize = paragraphLine{}, 0
+		return true
+	}
+	// addWord appends a word to the current line.
+	addWord := func(spaceSpan int, word []lineItem) {
+		if len(line.items) > 0 {
+			line.add(spaceSpan, " ")
+		}
+		for _, item := range word {
+			line.add(item.span, item.s)
+			if size := spans[item.span].Size; size > lineSize {
+				lineSize = size
+			}
+		}
+	}
+
+	for {
+		start, spaceSpan, newline := skipSpace(spans, pos)
+		word, end := scanWord(spans, start)
+		if spaceSpan == -1 && len(word) > 0 {
+			spaceSpan = word[0].span
+		}
+		endPara := len(word) == 0 && (len(line.items) > 0 || newline)
+		overfull := len(word) > 0 && p.lineWidth(text, spans, line.with(spaceSpan, word)) > p.Width
+		if overfull && p.Hyphenator != nil {
+			// The rest of the word must come after its start, or the
+			// same word would be broken again forever.
+			if prefix, breakPos := p.hyphenate(text, spans, line, spaceSpan, word, start); prefix != nil && start.before(breakPos) {
+				if len(line.items) == 0 {
+					lineStart = start
+				}
+				addWord(spaceSpan, prefix)
+				if !endLine(false) {
+					return lines, spansFrom(spans, lineStart)
+				}
+				pos = breakPos
+				continue
+			}
+		}
+		if endPara || overfull && len(line.items) > 0 {
+			if len(line.items) == 0 && start.span < len(spans) {
+				// Blank lines take the size of the text they are in.
+				lineSize = spans[start.span].Size
+			}
+			if !endLine(endPara) {
+				return lines, spansFrom(spans, lineStart)
+			}
+		}
+		if len(word) == 0 {
+			if !newline {
+				return lines, nil
+			}
+			pos = spanPos{start.span, start.off + 1}
+			lineStart = pos
+			continue
+		}
+		if len(line.items) == 0 {
+			lineStart = start
+		}
+		addWord(spaceSpan, word)
+		pos = end
+	}
+}
+
+// with returns a copy of the line with a word appended to it.
+func (ln paragraphLine) with(spaceSpan int, word []lineItem) paragraphLine {
+	candidate := paragraphLine{items: append([]lineItem(nil), ln.items...)}
+	if len(candidate.items) > 0 {
+		candidate.add(spaceSpan, " ")
+	}
+	for _, item := range word {
+		candidate.add(item.span, item.s)
+	}
+	return candidate
+}
+
+// hyphenate finds the longest part of a word that fits at the end of line when
+// followed by a hyphen.  The word starts at pos.  hyphenate returns the part of
+// the word, ending in a hyphen, and the position of the rest of the word.  If
+// the word cannot be broken so that it fits, hyphenate returns nil.
+func (p *Paragraph) hyphenate(text *Text, spans []Span, line paragraphLine, spaceSpan int, word []lineItem, pos spanPos) (prefix []lineItem, rest spanPos) {
+	var s string
+	for _, item := range word {
+		s += item.s
+	}
+	breaks := p.Hyphenator.Hyphenate(s)
+	for i := len(breaks) - 1; i >= 0; i-- {
+		if breaks[i] <= 0 || breaks[i] >= len(s) {
+			continue
+		}
+		// Split the word's items at the break.
+		prefix = nil
+		n, off := breaks[i], pos.off
+		for _, item := range word {
+			if n <= len(item.s) {
+				prefix = append(prefix, lineItem{span: item.span, s: item.s[:n] + "-"})
+				rest = spanPos{item.span, off + n}
+				break
+			}
+			prefix = append(prefix, item)
+			n -= len(item.s)
+			off = 0
+		}
+		if p.lineWidth(text, spans, line.with(spaceSpan, prefix)) <= p.Width {
+			return prefix, rest
+		}
+	}
+	return nil, spanPos{}
+}
+
+// spanPos is a position in a sequence of spans.
+type spanPos struct {
+	span int
+	off  int // byte offset in the span's text
+}
+
+// before reports whether pos comes before other.
+func (pos spanPos) before(other spanPos) bool {
+	return pos.span < other.span || pos.span == other.span && pos.off < other.off
+}
+
+// spansFrom returns the spans from pos onward.
+func spansFrom(spans []Span, pos spanPos) []Span {
+	if pos.span >= len(spans) {
+		return nil
+	}
+	first := spans[pos.span]
+	first.Text = first.Text[pos.off:]
+	return append([]Span{first}, spans[pos.span+1:]...)
+}
+
+// skipSpace advances pos past any whitespace, but stops at a newline.  It
+// returns the index of the span that the first whitespace character is in (or
+// -1 if there is none) and reports whether it stopped at a newline.
+func skipSpace(spans []Span, pos spanPos) (newPos spanPos, spaceSpan int, newline bool) {
+	spaceSpan = -1
+	for pos.span < len(spans) {
+		s := spans[pos.span].Text
+		if pos.off >= len(s) {
+			pos = spanPos{pos.span + 1, 0}
+			continue
+		}
+		r, n := utf8.DecodeRuneInString(s[pos.off:])
+		if r == '\n' {
+			return pos, spaceSpan, true
+		}
+		if !unicode.IsSpace(r) {
+			break
+		}
+		if spaceSpan == -1 {
+			spaceSpan = pos.span
+		}
+		pos.off += n
+	}
+	return pos, spaceSpan, false
+}
+
+// scanWord returns the parts of the word that starts at pos and the position
+// after the word.  A word ends at whitespace or the end of the last span.
+func scanWord(spans []Span, pos spanPos) ([]lineItem, spanPos) {
+	var word []lineItem
+	for pos.span < len(spans) {
+		s := spans[pos.span].Text
+		start := pos.off
+		for pos.off < len(s) {
+			r, n := utf8.DecodeRuneInString(s[pos.off:])
+			if unicode.IsSpace(r) {
+				break
+			}
+			pos.off += n
+		}
+		if pos.off > start {
+			word = append(word, lineItem{span: pos.span, s: s[start:pos.off]})
+		}
+		if pos.off < len(s) {
+			break
+		}
+		pos = spanPos{pos.span + 1, 0}
+	}
+	return word, pos
+}
@@ -0,0 +1,357 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+const paragraphText = "The quick brown fox jumps over the lazy dog."
+
+var breakLinesTests = []struct {
+	Width  Unit
+	Height Unit
+	Text   string
+	Lines  []string
+	Last   []bool
+	Rest   string
+}{
+	{
+		100, 0, paragraphText,
+		[]string{"The quick brown fox", "jumps over the lazy", "dog."},
+		[]bool{false, false, true},
+		"",
+	},
+	{
+		1000, 0, paragraphText,
+		[]string{paragraphText},
+		[]bool{true},
+		"",
+	},
+	{
+		100, 25, paragraphText,
+		[]string{"The quick brown fox", "jumps over the lazy"},
+		[]bool{false, false},
+		"dog.",
+	},
+	{
+		10, 0, "  supercalifragilistic  word ",
+		[]string{"supercalifragilistic", "word"},
+		[]bool{false, true},
+		"",
+	},
+	{
+		100, 0, "One\n\nTwo  three\n",
+		[]string{"One", "", "Two three"},
+		[]bool{true, true, true},
+		"",
+	},
+	{
+		100, 12, "One\n\nTwo",
+		[]string{"One"},
+		[]bool{true},
+		"\nTwo",
+	},
+	{
+		100, 5, paragraphText,
+		[]string{},
+		[]bool{},
+		paragraphText,
+	},
+}
+
+// lineStrings returns the text and paragraph endings of a list of lines.
+func lineStrings(lines []paragraphLine) ([]string, []bool) {
+	s, last := []string{}, []bool{}
+	for _, ln := range lines {
+		var buf bytes.Buffer
+		for _, item := range ln.items {
+			buf.WriteString(item.s)
+		}
+		s = append(s, buf.String())
+		last = append(last, ln.last)
+	}
+	return s, last
+}
+
+func TestParagraphBreakLines(t *testing.T) {
+	for i, tt := range breakLinesTests {
+		p := &Paragraph{Width: tt.Width, Height: tt.Height}
+		text := new(Text)
+		lines, rest := p.breakLines(text, []Span{{Text: tt.Text, Font: StandardFont(Helvetica), Size: 10}})
+		s, last := lineStrings(lines)
+		if !reflect.DeepEqual(s, tt.Lines) || !reflect.DeepEqual(last, tt.Last) {
+			t.Errorf("%d. lines = %q %v; want %q %v", i, s, last, tt.Lines, tt.Last)
+		}
+		var restText string
+		if len(rest) > 0 {
+			restText = rest[0].Text
+		}
+		if restText != tt.Rest {
+			t.Errorf("%d. rest = %q; want %q", i, restText, tt.Rest)
+		}
+	}
+}
+
+func TestParagraphSpans(t *testing.T) {
+	bold, regular := StandardFont(HelveticaBold), StandardFont(Helvetica)
+	spans := []Span{
+		{Text: "Total:", Font: bold, Size: 10},
+		{Text: " $1,000 due ", Font: regular, Size: 10, Color: DeviceRGB{1, 0, 0}},
+		{Text: "now", Font: bold, Size: 20},
+		{Text: " please", Font: regular, Size: 10},
+	}
+	p := &Paragraph{Width: 140}
+	text := new(Text)
+	lines, rest := p.breakLines(text, spans)
+	if rest != nil {
+		t.Errorf("rest = %v; want nil", rest)
+	}
+	s, _ := lineStrings(lines)
+	if want := []string{"Total: $1,000 due now", "please"}; !reflect.DeepEqual(s, want) {
+		t.Fatalf("lines = %q; want %q", s, want)
+	}
+	if lines[0].leading != 24 || lines[1].leading != 12 {
+		t.Errorf("leadings = %v, %v; want 24, 12", lines[0].leading, lines[1].leading)
+	}
+
+	height, overflow := p.DrawSpans(text, spans)
+	if height != 36 || overflow != nil {
+		t.Errorf("DrawSpans() = %v, %v; want 36, nil", height, overflow)
+	}
+	const wantOutput = "/Helvetica-Bold 10.00000 Tf\n12.00000 TL\n(Total:) Tj\n" +
+		"/Helvetica 10.00000 Tf\n12.00000 TL\n1.00000 0.00000 0.00000 rg\n( $1,000 due ) Tj\n" +
+		"/Helvetica-Bold 20.00000 Tf\n24.00000 TL\n(now) Tj\n" +
+		"0.00000 -12.00000 Td\n/Helvetica 10.00000 Tf\n12.00000 TL\n(please) Tj\n" +
+		"0.00000 -12.00000 Td\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+	if len(text.faces) != 2 {
+		t.Errorf("text uses %d fonts; want 2", len(text.faces))
+	}
+
+	p.Height = 30
+	_, overflow = p.DrawSpans(new(Text), spans)
+	if len(overflow) != 1 || overflow[0].Text != "please" || overflow[0].Font != regular {
+		t.Errorf("overflow = %v; want the \"please\" span", overflow)
+	}
+}
+
+func TestTextSpans(t *testing.T) {
+	text := new(Text)
+	text.TextSpans([]Span{
+		{Text: "Name: ", Font: StandardFont(HelveticaBold), Size: 10},
+		{Text: "Ada", Font: StandardFont(Helvetica), Size: 10},
+	})
+	want := MeasureString(StandardFont(HelveticaBold), 10, "Name: ") + MeasureString(StandardFont(Helvetica), 10, "Ada")
+	if !floatEq(float64(text.X()), float64(want), 1e-4) {
+		t.Errorf("X() = %.5f; want %.5f", text.X(), want)
+	}
+}
+
+func TestParagraphDraw(t *testing.T) {
+	p := &Paragraph{Font: StandardFont(Helvetica), Size: 10, Width: 100}
+	text := new(Text)
+	height, overflow := p.Draw(text, paragraphText)
+	if !floatEq(float64(height), 36, 1e-4) {
+		t.Errorf("height = %.5f; want 36", height)
+	}
+	if overflow != "" {
+		t.Errorf("overflow = %q; want \"\"", overflow)
+	}
+	if text.X() != 0 || !floatEq(float64(text.Y()), -36, 1e-4) {
+		t.Errorf("cursor at (%.5f, %.5f); want (0, -36)", text.X(), text.Y())
+	}
+
+	const wantOutput = "/Helvetica 10.00000 Tf\n12.00000 TL\n" +
+		"(The quick brown fox) Tj\n" +
+		"0.00000 -12.00000 Td\n(jumps over the lazy) Tj\n" +
+		"0.00000 -12.00000 Td\n(dog.) Tj\n" +
+		"0.00000 -12.00000 Td\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+}
+
+func TestParagraphAlign(t *testing.T) {
+	font := StandardFont(Helvetica)
+	for _, align := range []Alignment{AlignRight, AlignCenter} {
+		p := &Paragraph{Font: font, Size: 10, Width: 100, Align: align}
+		text := new(Text)
+		p.Draw(text, "Hello")
+		want := 100 - MeasureString(font, 10, "Hello")
+		if align == AlignCenter {
+			want /= 2
+		}
+		if !strings.HasPrefix(text.buf.String(), Unit(want).String()+" 0.00000 Td\n") {
+			t.Errorf("align %d: output %q does not offset line by %.5f", align, text.buf.String(), want)
+		}
+		if text.X() != 0 {
+			t.Errorf("align %d: X() = %.5f after paragraph; want 0", align, text.X())
+		}
+	}
+}
+
+func TestParagraphJustify(t *testing.T) {
+	font := StandardFont(Helvetica)
+	p := &Paragraph{Font: font, Size: 10, Width: 100, Align: AlignJustify}
+	text := new(Text)
+	p.Draw(text, paragraphText)
+
+	out := text.buf.String()
+	spacings := regexp.MustCompile(`(?m)^(\S+) Tw$`).FindAllStringSubmatch(out, -1)
+	lines := []string{"The quick brown fox", "jumps over the lazy"}
+	if len(spacings) != len(lines)+1 {
+		t.Fatalf("output %q sets word spacing %d times; want %d", out, len(spacings), len(lines)+1)
+	}
+	for i, line := range lines {
+		tw := (100 - MeasureString(font, 10, line)) / Unit(strings.Count(line, " "))
+		if spacings[i][1] != tw.String() {
+			t.Errorf("word spacing for line %q is %s; want %.5f", line, spacings[i][1], tw)
+		}
+
+		justified := new(Text)
+		justified.SetFontFace(font, 10)
+		justified.SetWordSpacing(tw)
+		if w := justified.measure(font, 10, line); !floatEq(float64(w), 100, 1e-3) {
+			t.Errorf("line %q is %.5f wide; want 100", line, w)
+		}
+	}
+	if text.wordSpacing != 0 {
+		t.Errorf("word spacing is %.5f after paragraph; want 0", text.wordSpacing)
+	}
+	if !strings.Contains(out, "0.00000 Tw\n0.00000 -12.00000 Td\n(dog.) Tj\n") {
+		t.Errorf("output %q justifies the last line", out)
+	}
+}
+
+func TestParagraphMidLine(t *testing.T) {
+	p := &Paragraph{Width: 100}
+	text := new(Text)
+	text.SetFontFace(StandardFont(Helvetica), 10)
+	text.Text("Label: ")
+	p.Draw(text, "quick fox")
+	want := "(Label: ) Tj\n0.00000 0.00000 Td\n(quick fox) Tj\n0.00000 -12.00000 Td\n"
+	if out := text.buf.String(); !strings.HasSuffix(out, want) {
+		t.Errorf("output %q does not end with %q", out, want)
+	}
+	if text.X() != 0 || text.Y() != -12 {
+		t.Errorf("cursor at (%v, %v); want (0, -12)", text.X(), text.Y())
+	}
+}
+
+func TestSpanCurrentFont(t *testing.T) {
+	font := StandardFont(Helvetica)
+	p := &Paragraph{Width: 40}
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	_, rest := p.DrawSpans(text, []Span{{Text: "quick brown fox"}})
+	if rest != nil {
+		t.Errorf("rest = %v; want nil", rest)
+	}
+	want := "(quick) Tj\n0.00000 -12.00000 Td\n(brown) Tj\n0.00000 -12.00000 Td\n(fox) Tj\n"
+	if out := text.buf.String(); !strings.Contains(out, want) {
+		t.Errorf("output %q does not contain %q", out, want)
+	}
+	if text.currFont != font || text.currSize != 10 {
+		t.Errorf("font face changed to %v %v", text.currFont, text.currSize)
+	}
+}
+
+func TestCompositeFontWordSpacing(t *testing.T) {
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(goregular.TTF))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.SetWordSpacing(2)
+	text.Text("a b")
+
+	want := MeasureString(font, 10, "a b") + 2
+	if !floatEq(float64(text.X()), float64(want), 1e-4) {
+		t.Errorf("X() = %.5f; want %.5f", text.X(), want)
+	}
+	if !strings.Contains(text.buf.String(), "> -200 <") {
+		t.Errorf("output %q does not adjust after the space", text.buf.String())
+	}
+}
+
+// fixedHyphenator allows breaks at the same offsets in every word.
+type fixedHyphenator []int
+
+func (h fixedHyphenator) Hyphenate(word string) []int {
+	return h
+}
+
+func TestParagraphBadHyphenator(t *testing.T) {
+	font := StandardFont(Helvetica)
+	tests := []struct {
+		Breaks []int
+		Lines  []string
+	}{
+		{[]int{0}, []string{"Some", "hyphenation"}},
+		{[]int{-1}, []string{"Some", "hyphenation"}},
+		{[]int{100}, []string{"Some", "hyphenation"}},
+		{[]int{11}, []string{"Some", "hyphenation"}},
+		{[]int{-3, 0, 2, 11, 50}, []string{"Some hy-", "phenation"}},
+	}
+	for _, test := range tests {
+		p := &Paragraph{Width: 60, Hyphenator: fixedHyphenator(test.Breaks)}
+		spans := []Span{{Text: "Some hyphenation", Font: font, Size: 10}}
+		lines, rest := p.breakLines(new(Text), spans)
+		if rest != nil {
+			t.Errorf("breaks %v: rest = %v; want nil", test.Breaks, rest)
+		}
+		if s, _ := lineStrings(lines); !reflect.DeepEqual(s, test.Lines) {
+			t.Errorf("breaks %v: lines = %q; want %q", test.Breaks, s, test.Lines)
+		}
+	}
+}
+
+func TestParagraphHyphenate(t *testing.T) {
+	font := StandardFont(Helvetica)
+	p := &Paragraph{Width: 60, Hyphenator: EnglishHyphenator()}
+	spans := []Span{{Text: "Some hyphenation algorithms", Font: font, Size: 10}}
+	lines, rest := p.breakLines(new(Text), spans)
+	if rest != nil {
+		t.Errorf("rest = %v; want nil", rest)
+	}
+	s, last := lineStrings(lines)
+	if want := []string{"Some hy-", "phenation al-", "gorithms"}; !reflect.DeepEqual(s, want) {
+		t.Errorf("lines = %q; want %q", s, want)
+	}
+	if want := []bool{false, false, true}; !reflect.DeepEqual(last, want) {
+		t.Errorf("last = %v; want %v", last, want)
+	}
+
+	p.Height = 24
+	_, rest = p.breakLines(new(Text), spans)
+	if len(rest) != 1 || rest[0].Text != "gorithms" {
+		t.Errorf("rest = %v; want the \"gorithms\" span", rest)
+	}
+}
+
+func TestParagraphBidi(t *testing.T) {
+	bold, regular := StandardFont(HelveticaBold), StandardFont(Helvetica)
+	p := &Paragraph{Width: 100}
+	text := new(Text)
+	p.DrawSpans(text, []Span{
+		{Text: "אב ", Font: bold, Size: 10},
+		{Text: "גד", Font: regular, Size: 10},
+	})
+	const wantOutput = "/Helvetica 10.00000 Tf\n12.00000 TL\n(??) Tj\n" +
+		"/Helvetica-Bold 10.00000 Tf\n12.00000 TL\n( ??) Tj\n" +
+		"0.00000 -12.00000 Td\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+}
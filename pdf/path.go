@@ -0,0 +1,488 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"io"
+	"math"
+)
+
+// Path is a shape that can be painted on a canvas.  The zero value is an empty
+// path.  A path is a list of segments, which are written to a canvas as the
+// operators that created them.
+type Path struct {
+	segs []PathSegment
+}
+
+// PathOp is the kind of a path segment.
+type PathOp int
+
+// Path segment kinds.  The points that each kind of segment has are listed in
+// order.
+const (
+	// PathMove begins a new subpath.  It has the subpath's first point.
+	PathMove PathOp = iota
+	// PathLine has the end of a line segment.
+	PathLine
+	// PathCurve has the two control points and the end of a cubic Bezier
+	// curve.
+	PathCurve
+	// PathCurveV has the second control point and the end of a cubic
+	// Bezier curve whose first control point is the current point.
+	PathCurveV
+	// PathCurveY has the first control point and the end of a cubic Bezier
+	// curve whose second control point is its end.
+	PathCurveY
+	// PathRectangle is a complete subpath that goes around a rectangle
+	// from one corner.  It has that corner and the opposite corner.
+	PathRectangle
+	// PathClose closes the current subpath.  It has no points.
+	PathClose
+)
+
+// pathOperators holds the operator that constructs each kind of path segment.
+var pathOperators = [...]string{
+	PathMove:      "m",
+	PathLine:      "l",
+	PathCurve:     "c",
+	PathCurveV:    "v",
+	PathCurveY:    "y",
+	PathRectangle: "re",
+	PathClose:     "h",
+}
+
+// PathSegment is a single operation in a path.
+type PathSegment struct {
+	Op     PathOp
+	Points []Point
+}
+
+// Segments returns a copy of the path's segments, in order.
+func (path *Path) Segments() []PathSegment {
+	segs := make([]PathSegment, len(path.segs))
+	for i, seg := range path.segs {
+		segs[i] = PathSegment{seg.Op, append([]Point(nil), seg.Points...)}
+	}
+	return segs
+}
+
+// add appends a segment to the path.
+func (path *Path) add(op PathOp, pts ...Point) {
+	path.segs = append(path.segs, PathSegment{op, pts})
+}
+
+// Move begins a new subpath by moving the current point to the given location.
+func (path *Path) Move(pt Point) {
+	path.add(PathMove, pt)
+}
+
+// Line appends a line segment from the current point to the given location.
+func (path *Path) Line(pt Point) {
+	path.add(PathLine, pt)
+}
+
+// Curve appends a cubic Bezier curve to the path.
+func (path *Path) Curve(pt1, pt2, pt3 Point) {
+	path.add(PathCurve, pt1, pt2, pt3)
+}
+
+// CurveV appends a cubic Bezier curve to the path whose first control point is
+// the current point.
+func (path *Path) CurveV(pt2, pt3 Point) {
+	path.add(PathCurveV, pt2, pt3)
+}
+
+// CurveY appends a cubic Bezier curve to the path whose second control point is
+// its end point.
+func (path *Path) CurveY(pt1, pt3 Point) {
+	path.add(PathCurveY, pt1, pt3)
+}
+
+// Rectangle appends a complete rectangle to the path.
+func (path *Path) Rectangle(rect Rectangle) {
+	path.add(PathRectangle, rect.Min, rect.Max)
+}
+
+// Close appends a line segment from the current point to the starting point of
+// the subpath.
+func (path *Path) Close() {
+	path.add(PathClose)
+}
+
+// writeTo writes the operators that construct the path.
+func (path *Path) writeTo(w io.Writer) {
+	for _, seg := range path.segs {
+		op := pathOperators[seg.Op]
+		if seg.Op == PathRectangle {
+			min, max := seg.Points[0], seg.Points[1]
+			writeCommand(w, op, min.X, min.Y, max.X-min.X, max.Y-min.Y)
+			continue
+		}
+		args := make([]interface{}, 0, 2*len(seg.Points))
+		for _, pt := range seg.Points {
+			args = append(args, pt.X, pt.Y)
+		}
+		writeCommand(w, op, args...)
+	}
+}
+
+// currentPoint returns the point that the next segment starts from.  ok is
+// false if the path is empty.
+func (path *Path) currentPoint() (pt Point, ok bool) {
+	if len(path.segs) == 0 {
+		return Point{}, false
+	}
+	return path.endPoint(len(path.segs) - 1), true
+}
+
+// endPoint returns the current point after the segment at index i.
+func (path *Path) endPoint(i int) Point {
+	seg := path.segs[i]
+	switch seg.Op {
+	case PathRectangle:
+		return seg.Points[0]
+	case PathClose:
+		return path.subpathStart(i)
+	}
+	return seg.Points[len(seg.Points)-1]
+}
+
+// subpathStart returns the first point of the subpath that contains the
+// segment at index i.
+func (path *Path) subpathStart(i int) Point {
+	for j := i; j >= 0; j-- {
+		switch seg := path.segs[j]; seg.Op {
+		case PathMove, PathRectangle:
+			return seg.Points[0]
+		case PathClose:
+			if j < i {
+				// A segment after a closed subpath starts a new
+				// subpath from where the closed one began.
+				return path.subpathStart(j)
+			}
+		}
+	}
+	return Point{}
+}
+
+// Bounds returns the smallest rectangle that contains the path.  Curves are
+// measured by their extent, not by their control points.  It returns the zero
+// rectangle for an empty path.
+func (path *Path) Bounds() Rectangle {
+	var r Rectangle
+	first := true
+	add := func(pt Point) {
+		if first {
+			r, first = Rectangle{pt, pt}, false
+		} else {
+			r = r.union(Rectangle{pt, pt})
+		}
+	}
+	var cur Point
+	for i, seg := range path.segs {
+		switch seg.Op {
+		case PathCurve, PathCurveV, PathCurveY:
+			p1, p2, p3 := curvePoints(cur, seg)
+			for _, t := range curveExtrema(cur, p1, p2, p3) {
+				add(bezierPoint(cur, p1, p2, p3, t))
+			}
+			add(p3)
+		case PathRectangle:
+			add(seg.Points[0])
+			add(seg.Points[1])
+		case PathMove, PathLine:
+			add(seg.Points[0])
+		}
+		cur = path.endPoint(i)
+	}
+	return r
+}
+
+// curvePoints returns the control points and end of a curve segment that
+// starts at cur.
+func curvePoints(cur Point, seg PathSegment) (p1, p2, p3 Point) {
+	switch seg.Op {
+	case PathCurveV:
+		return cur, seg.Points[0], seg.Points[1]
+	case PathCurveY:
+		return seg.Points[0], seg.Points[1], seg.Points[1]
+	}
+	return seg.Points[0], seg.Points[1], seg.Points[2]
+}
+
+// curveExtrema returns the parameters strictly between 0 and 1 where a cubic
+// Bezier curve reaches a horizontal or vertical extreme.
+func curveExtrema(p0, p1, p2, p3 Point) []float64 {
+	var ts []float64
+	for _, v := range [][4]float64{
+		{float64(p0.X), float64(p1.X), float64(p2.X), float64(p3.X)},
+		{float64(p0.Y), float64(p1.Y), float64(p2.Y), float64(p3.Y)},
+	} {
+		// The derivative is 3(at^2 + bt + c).
+		a := -v[0] + 3*v[1] - 3*v[2] + v[3]
+		b := 2 * (v[0] - 2*v[1] + v[2])
+		c := v[1] - v[0]
+		if math.Abs(a) < 1e-12 {
+			if b != 0 {
+				ts = append(ts, -c/b)
+			}
+			continue
+		}
+		disc := b*b - 4*a*c
+		if disc < 0 {
+			continue
+		}
+		sq := math.Sqrt(disc)
+		ts = append(ts, (-b+sq)/(2*a), (-b-sq)/(2*a))
+	}
+	inside := ts[:0]
+	for _, t := range ts {
+		if t > 0 && t < 1 {
+			inside = append(inside, t)
+		}
+	}
+	return inside
+}
+
+// bezierPoint returns the point at parameter t on a cubic Bezier curve.
+func bezierPoint(p0, p1, p2, p3 Point, t float64) Point {
+	s := 1 - t
+	f := func(a, b, c, d Unit) Unit {
+		return Unit(s*s*s*float64(a) + 3*s*s*t*float64(b) + 3*s*t*t*float64(c) + t*t*t*float64(d))
+	}
+	return Point{f(p0.X, p1.X, p2.X, p3.X), f(p0.Y, p1.Y, p2.Y, p3.Y)}
+}
+
+// Transform applies a transformation to every point in the path.  Rectangles
+// stay rectangles unless m rotates or skews them, in which case they are
+// replaced with four line segments.
+func (path *Path) Transform(m Matrix) {
+	segs := make([]PathSegment, 0, len(path.segs))
+	for _, seg := range path.segs {
+		if seg.Op == PathRectangle && (m[1] != 0 || m[2] != 0) {
+			min, max := seg.Points[0], seg.Points[1]
+			segs = append(segs,
+				PathSegment{PathMove, []Point{m.Apply(min)}},
+				PathSegment{PathLine, []Point{m.Apply(Point{max.X, min.Y})}},
+				PathSegment{PathLine, []Point{m.Apply(max)}},
+				PathSegment{PathLine, []Point{m.Apply(Point{min.X, max.Y})}},
+				PathSegment{PathClose, nil},
+			)
+			continue
+		}
+		pts := make([]Point, len(seg.Points))
+		for i, pt := range seg.Points {
+			pts[i] = m.Apply(pt)
+		}
+		segs = append(segs, PathSegment{seg.Op, pts})
+	}
+	path.segs = segs
+}
+
+// Reverse reverses the direction of each subpath, keeping the subpaths in the
+// same order.  Reversing a subpath changes whether it cuts a hole in the
+// subpaths around it when the path is filled with the nonzero winding number
+// rule.
+func (path *Path) Reverse() {
+	segs := make([]PathSegment, 0, len(path.segs))
+	start := 0
+	for i, seg := range path.segs {
+		if seg.Op == PathRectangle {
+			min, max := seg.Points[0], seg.Points[1]
+			segs = append(segs, PathSegment{PathRectangle, []Point{{max.X, min.Y}, {min.X, max.Y}}})
+			start = i + 1
+			continue
+		}
+		end := i == len(path.segs)-1 || seg.Op == PathClose
+		if !end {
+			next := path.segs[i+1].Op
+			end = next == PathMove || next == PathRectangle
+		}
+		if end {
+			segs = append(segs, path.reverseSubpath(start, i+1)...)
+			start = i + 1
+		}
+	}
+	path.segs = segs
+}
+
+// reverseSubpath returns the segments between start and end, which form a
+// single subpath, in the opposite direction.
+func (path *Path) reverseSubpath(start, end int) []PathSegment {
+	var first Point
+	if start > 0 {
+		first = path.endPoint(start - 1)
+	}
+	segs := path.segs[start:end]
+	if segs[0].Op == PathMove {
+		first = segs[0].Points[0]
+		segs = segs[1:]
+	}
+	closed := len(segs) > 0 && segs[len(segs)-1].Op == PathClose
+	if closed {
+		segs = segs[:len(segs)-1]
+	}
+
+	// from holds the point that each segment starts from.
+	from := make([]Point, len(segs))
+	last := first
+	for i, seg := range segs {
+		from[i] = last
+		last = seg.Points[len(seg.Points)-1]
+	}
+
+	rev := make([]PathSegment, 0, len(segs)+3)
+	if closed {
+		// The line segment that closes the subpath is reversed first.
+		rev = append(rev, PathSegment{PathMove, []Point{first}})
+		if last != first {
+			rev = append(rev, PathSegment{PathLine, []Point{last}})
+		}
+	} else {
+		rev = append(rev, PathSegment{PathMove, []Point{last}})
+	}
+	for i := len(segs) - 1; i >= 0; i-- {
+		seg, to := segs[i], from[i]
+		if i == 0 && closed && seg.Op == PathLine {
+			// Closing the subpath draws the first line segment.
+			break
+		}
+		switch seg.Op {
+		case PathLine:
+			rev = append(rev, PathSegment{PathLine, []Point{to}})
+		case PathCurve:
+			rev = append(rev, PathSegment{PathCurve, []Point{seg.Points[1], seg.Points[0], to}})
+		case PathCurveV:
+			rev = append(rev, PathSegment{PathCurveY, []Point{seg.Points[0], to}})
+		case PathCurveY:
+			rev = append(rev, PathSegment{PathCurveV, []Point{seg.Points[0], to}})
+		}
+	}
+	if closed {
+		rev = append(rev, PathSegment{PathClose, nil})
+	}
+	return rev
+}
+
+// Arc appends a circular arc to the path.  The arc is centered on center and
+// sweeps counterclockwise from the angle start to the angle end (in radians),
+// or clockwise if end is less than start.  If the path has a current point, a
+// line segment is appended from it to the beginning of the arc; otherwise the
+// arc begins a new subpath.
+func (path *Path) Arc(center Point, radius Unit, start, end float32) {
+	p0 := ellipsePoint(center, radius, radius, float64(start))
+	if _, ok := path.currentPoint(); ok {
+		path.Line(p0)
+	} else {
+		path.Move(p0)
+	}
+	path.arc(center, radius, radius, float64(start), float64(end))
+}
+
+// Circle appends a complete circle to the path.
+func (path *Path) Circle(center Point, radius Unit) {
+	path.Ellipse(center, radius, radius)
+}
+
+// Ellipse appends a complete ellipse to the path.  The ellipse is centered on
+// center, and its axes are horizontal and vertical with the given radii.
+func (path *Path) Ellipse(center Point, rx, ry Unit) {
+	path.Move(ellipsePoint(center, rx, ry, 0))
+	path.arc(center, rx, ry, 0, 2*math.Pi)
+	path.Close()
+}
+
+// RoundedRectangle appends a complete rectangle with rounded corners to the
+// path.  Each corner is a quarter circle with the given radius, which is
+// reduced to half the rectangle's width or height if it is larger.
+func (path *Path) RoundedRectangle(rect Rectangle, radius Unit) {
+	if w := abs(rect.Dx()) / 2; radius > w {
+		radius = w
+	}
+	if h := abs(rect.Dy()) / 2; radius > h {
+		radius = h
+	}
+	if radius <= 0 {
+		path.Rectangle(rect)
+		return
+	}
+	x0, y0 := rect.Min.X+radius, rect.Min.Y+radius
+	x1, y1 := rect.Max.X-radius, rect.Max.Y-radius
+	path.Move(Point{x0, rect.Min.Y})
+	path.Line(Point{x1, rect.Min.Y})
+	path.arc(Point{x1, y0}, radius, radius, -math.Pi/2, 0)
+	path.Line(Point{rect.Max.X, y1})
+	path.arc(Point{x1, y1}, radius, radius, 0, math.Pi/2)
+	path.Line(Point{x0, rect.Max.Y})
+	path.arc(Point{x0, y1}, radius, radius, math.Pi/2, math.Pi)
+	path.Line(Point{rect.Min.X, y0})
+	path.arc(Point{x0, y0}, radius, radius, math.Pi, 3*math.Pi/2)
+	path.Close()
+}
+
+// Polygon appends a complete polygon with the given vertices to the path.
+func (path *Path) Polygon(pts ...Point) {
+	if len(pts) == 0 {
+		return
+	}
+	path.Move(pts[0])
+	for _, pt := range pts[1:] {
+		path.Line(pt)
+	}
+	path.Close()
+}
+
+// arc appends curves along an ellipse from the angle start to the angle end,
+// starting at the current point.  Each curve spans at most a quarter turn,
+// which keeps the approximation within 0.03% of the radius.
+func (path *Path) arc(center Point, rx, ry Unit, start, end float64) {
+	sweep := end - start
+	// Allow for the rounding of float32 angles, so that a quarter turn is
+	// a single curve.
+	n := int(math.Ceil(math.Abs(sweep)/(math.Pi/2) - 1e-6))
+	if n == 0 {
+		return
+	}
+	step := sweep / float64(n)
+	// k is the distance of the control points from the ends of a curve
+	// along their tangents, as a fraction of the radius.
+	k := 4.0 / 3 * math.Tan(step/4)
+	for i := 0; i < n; i++ {
+		a := start + float64(i)*step
+		b := a + step
+		sinA, cosA := sincos(a)
+		sinB, cosB := sincos(b)
+		path.Curve(
+			Point{center.X + rx*Unit(cosA-k*sinA), center.Y + ry*Unit(sinA+k*cosA)},
+			Point{center.X + rx*Unit(cosB+k*sinB), center.Y + ry*Unit(sinB-k*cosB)},
+			ellipsePoint(center, rx, ry, b),
+		)
+	}
+}
+
+// ellipsePoint returns the point at an angle on an ellipse.
+func ellipsePoint(center Point, rx, ry Unit, theta float64) Point {
+	sin, cos := sincos(theta)
+	return Point{center.X + rx*Unit(cos), center.Y + ry*Unit(sin)}
+}
+
+// sincos returns the sine and cosine of theta.  Values that are zero to within
+// the precision of a float32 angle are rounded to zero, so that quarter turns
+// give exact points.
+func sincos(theta float64) (sin, cos float64) {
+	sin, cos = math.Sincos(theta)
+	if math.Abs(sin) < 1e-6 {
+		sin = 0
+	}
+	if math.Abs(cos) < 1e-6 {
+		cos = 0
+	}
+	return sin, cos
+}
+
+func abs(u Unit) Unit {
+	if u < 0 {
+		return -u
+	}
+	return u
+}
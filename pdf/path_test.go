@@ -0,0 +1,169 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+)
+
+// pathOutput returns the operators that construct a path.
+func pathOutput(path *Path) string {
+	var buf bytes.Buffer
+	path.writeTo(&buf)
+	return buf.String()
+}
+
+const pathExpectedOutput = `12.00000 34.00000 m
+-56.00000 78.00000 l
+h
+3.10000 -5.90000 21.10000 80.90000 re
+`
+
+func TestPath(t *testing.T) {
+	path := new(Path)
+	path.Move(Point{12, 34})
+	path.Line(Point{-56, 78})
+	path.Close()
+	path.Rectangle(Rectangle{Point{3.1, -5.9}, Point{24.2, 75.0}})
+
+	if pathOutput(path) != pathExpectedOutput {
+		t.Errorf("Output was %q, expected %q", pathOutput(path), pathExpectedOutput)
+	}
+}
+
+const shapesExpectedOutput = `0.00000 0.00000 m
+1.00000 0.00000 l
+h
+1.00000 0.00000 l
+1.00000 0.55228 0.55228 1.00000 0.00000 1.00000 c
+h
+5.00000 0.00000 m
+5.00000 2.00000 6.00000 3.00000 v
+7.00000 3.00000 8.00000 2.00000 y
+`
+
+func TestPathShapes(t *testing.T) {
+	path := new(Path)
+	path.Polygon(Point{0, 0}, Point{1, 0})
+	path.Arc(Point{0, 0}, 1, 0, math.Pi/2)
+	path.Close()
+	path.Move(Point{5, 0})
+	path.CurveV(Point{5, 2}, Point{6, 3})
+	path.CurveY(Point{7, 3}, Point{8, 2})
+
+	if pathOutput(path) != shapesExpectedOutput {
+		t.Errorf("Output was %q, expected %q", pathOutput(path), shapesExpectedOutput)
+	}
+}
+
+const circleExpectedOutput = `1.00000 0.00000 m
+1.00000 0.55228 0.55228 1.00000 0.00000 1.00000 c
+-0.55228 1.00000 -1.00000 0.55228 -1.00000 0.00000 c
+-1.00000 -0.55228 -0.55228 -1.00000 0.00000 -1.00000 c
+0.55228 -1.00000 1.00000 -0.55228 1.00000 0.00000 c
+h
+`
+
+func TestCircle(t *testing.T) {
+	path := new(Path)
+	path.Circle(Point{0, 0}, 1)
+	if pathOutput(path) != circleExpectedOutput {
+		t.Errorf("Output was %q, expected %q", pathOutput(path), circleExpectedOutput)
+	}
+}
+
+func TestRoundedRectangleRadius(t *testing.T) {
+	a, b := new(Path), new(Path)
+	a.RoundedRectangle(Rectangle{Point{0, 0}, Point{4, 2}}, 5)
+	b.RoundedRectangle(Rectangle{Point{0, 0}, Point{4, 2}}, 1)
+	if pathOutput(a) != pathOutput(b) {
+		t.Errorf("radius was not reduced to fit:\n%s\nexpected:\n%s", pathOutput(a), pathOutput(b))
+	}
+}
+
+func TestPathBounds(t *testing.T) {
+	path := new(Path)
+	if b := path.Bounds(); b != (Rectangle{}) {
+		t.Errorf("empty path Bounds() = %v", b)
+	}
+	path.Circle(Point{10, 20}, 5)
+	path.Rectangle(Rectangle{Point{0, 0}, Point{12, 3}})
+	want := Rectangle{Point{0, 0}, Point{15, 25}}
+	b := path.Bounds()
+	const epsilon = 1e-4
+	if !floatEq(float64(b.Min.X), float64(want.Min.X), epsilon) ||
+		!floatEq(float64(b.Min.Y), float64(want.Min.Y), epsilon) ||
+		!floatEq(float64(b.Max.X), float64(want.Max.X), epsilon) ||
+		!floatEq(float64(b.Max.Y), float64(want.Max.Y), epsilon) {
+		t.Errorf("Bounds() = %v; want %v", b, want)
+	}
+}
+
+const transformExpectedOutput = `2.00000 1.00000 m
+4.00000 5.00000 l
+2.00000 1.00000 2.00000 6.00000 re
+-1.00000 0.00000 m
+-1.00000 2.00000 l
+-4.00000 2.00000 l
+-4.00000 0.00000 l
+h
+`
+
+func TestPathTransform(t *testing.T) {
+	path := new(Path)
+	path.Move(Point{0, 0})
+	path.Line(Point{1, 2})
+	path.Rectangle(Rectangle{Point{0, 0}, Point{1, 3}})
+	path.Transform(Matrix{2, 0, 0, 2, 2, 1})
+
+	// A quarter turn cannot keep the rectangle as a rectangle.
+	rotated := new(Path)
+	rotated.Rectangle(Rectangle{Point{0, 1}, Point{2, 4}})
+	rotated.Transform(Matrix{0, 1, -1, 0, 0, 0})
+
+	if out := pathOutput(path) + pathOutput(rotated); out != transformExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, transformExpectedOutput)
+	}
+}
+
+const reverseExpectedOutput = `0.00000 0.00000 m
+4.00000 0.00000 l
+4.00000 4.00000 l
+0.00000 4.00000 l
+h
+1.00000 0.00000 -1.00000 2.00000 re
+6.00000 6.00000 m
+7.00000 7.00000 8.00000 8.00000 5.00000 5.00000 c
+5.00000 1.00000 5.00000 0.00000 y
+`
+
+func TestPathReverse(t *testing.T) {
+	path := new(Path)
+	path.Polygon(Point{0, 0}, Point{0, 4}, Point{4, 4}, Point{4, 0})
+	path.Rectangle(Rectangle{Point{0, 0}, Point{1, 2}})
+	path.Move(Point{5, 0})
+	path.CurveV(Point{5, 1}, Point{5, 5})
+	path.Curve(Point{8, 8}, Point{7, 7}, Point{6, 6})
+	path.Reverse()
+	if out := pathOutput(path); out != reverseExpectedOutput {
+		t.Errorf("Output was %q, expected %q", out, reverseExpectedOutput)
+	}
+}
+
+func TestPathSegments(t *testing.T) {
+	path := new(Path)
+	path.Move(Point{1, 2})
+	path.CurveY(Point{3, 4}, Point{5, 6})
+	path.Close()
+	segs := path.Segments()
+	if s := fmt.Sprint(segs); s != "[{0 [{1.00000 2.00000}]} {4 [{3.00000 4.00000} {5.00000 6.00000}]} {6 []}]" {
+		t.Errorf("Segments() = %s", s)
+	}
+	segs[0].Points[0] = Point{}
+	if p := path.Segments()[0].Points[0]; p != (Point{1, 2}) {
+		t.Errorf("changing the returned segments changed the path")
+	}
+}
@@ -3,6 +3,7 @@
 package pdf
 
 import (
+	"fmt"
 	"image"
 	"io"
 	"strconv"
@@ -38,6 +39,14 @@ type Document struct {
 	catalog *catalog
 	pages   []indirectObject
 	fonts   map[name]Reference
+
+	extGStates     map[extGStateDict]extGState
+	extGStateCount uint
+
+	fontCounter       uint
+	colorSpaceCounter uint
+	embeddedFonts     []embeddedFont
+	imageColorSpace   *ICCSpace
 }
 
 // New creates a new document with no pages.
@@ -70,10 +79,12 @@ func (doc *Document) NewPage(width, height Unit) *Canvas {
 	page.Contents = doc.add(stream)
 
 	return &Canvas{
-		doc:      doc,
-		page:     page,
-		ref:      pageRef,
-		contents: stream,
+		doc:       doc,
+		page:      page,
+		ref:       pageRef,
+		contents:  stream,
+		resources: &page.Resources,
+		ctm:       IdentityMatrix,
 	}
 }
 
@@ -86,21 +97,66 @@ func (doc *Document) standardFont(fontName name) Reference {
 	}
 
 	// TODO: check name is standard?
-	ref := doc.add(standardFontDict{
+	dict := standardFontDict{
 		Type:     fontType,
 		Subtype:  fontType1Subtype,
 		BaseFont: fontName,
-	})
+	}
+	if !standardFont(fontName).symbolic() {
+		dict.Encoding = winAnsiEncoding
+	}
+	ref := doc.add(dict)
 	doc.fonts[fontName] = ref
 	return ref
 }
 
+// extGState is a graphics state parameter dictionary in the document.
+type extGState struct {
+	resName name
+	ref     Reference
+}
+
+// extGState returns the document's graphics state parameter dictionary with
+// the same parameters as dict.  If there is no such dictionary in the document
+// yet, it is added automatically.
+func (doc *Document) extGState(dict extGStateDict) extGState {
+	dict.Type = extGStateType
+	if gs, ok := doc.extGStates[dict]; ok {
+		return gs
+	}
+	if doc.extGStates == nil {
+		doc.extGStates = make(map[extGStateDict]extGState)
+	}
+	gs := extGState{
+		resName: name(fmt.Sprintf(anonymousExtGStateFormat, doc.extGStateCount)),
+		ref:     doc.add(dict),
+	}
+	doc.extGStateCount++
+	doc.extGStates[dict] = gs
+	return gs
+}
+
+const anonymousExtGStateFormat = "__gs%d__"
+
+// extGStateDict is a graphics state parameter dictionary.  Parameters that
+// are nil are left unchanged by the dictionary.
+type extGStateDict struct {
+	Type name
+	CA   interface{} `pdf:",omitempty"`
+	Ca   interface{} `pdf:"ca,omitempty"`
+	BM   interface{} `pdf:",omitempty"`
+}
+
 // AddImage encodes an image into the document's stream and returns its PDF
 // file reference.  This reference can be used to draw the image multiple times
 // without storing the image multiple times.
 func (doc *Document) AddImage(img image.Image) Reference {
 	bd := img.Bounds()
-	st := newImageStream(streamFlateDecode, bd.Dx(), bd.Dy())
+	var colorSpace interface{} = deviceRGBColorSpace
+	if doc.imageColorSpace != nil {
+		colorSpace = doc.imageColorSpace.ref
+	}
+	st := newImageStream(streamFlateDecode, bd.Dx(), bd.Dy(), colorSpace)
 	defer st.Close()
 
 	switch i := img.(type) {
@@ -128,6 +184,11 @@ func (doc *Document) Encode(w io.Writer) error {
 		page.Parent = doc.catalog.Pages
 		pageRoot.Kids = append(pageRoot.Kids, p.Reference)
 	}
+	for _, font := range doc.embeddedFonts {
+		if err := font.writeFontProgram(); err != nil {
+			return err
+		}
+	}
 
 	return doc.encoder.encode(w)
 }
@@ -139,18 +200,39 @@ const (
 	pageType     name = "Page"
 	fontType     name = "Font"
 	xobjectType  name = "XObject"
+	encodingType name = "Encoding"
+
+	fontDescriptorType name = "FontDescriptor"
+	outputIntentType   name = "OutputIntent"
+	extGStateType      name = "ExtGState"
 )
 
 // PDF object subtypes
 const (
 	imageSubtype name = "Image"
 
-	fontType1Subtype name = "Type1"
+	fontType0Subtype        name = "Type0"
+	fontType1Subtype        name = "Type1"
+	fontTrueTypeSubtype     name = "TrueType"
+	fontCIDFontType0Subtype name = "CIDFontType0"
+	fontCIDFontType2Subtype name = "CIDFontType2"
+	fontType3Subtype        name = "Type3"
+)
+
+// Predefined encodings
+const (
+	winAnsiEncoding   name = "WinAnsiEncoding"
+	identityHEncoding name = "Identity-H"
 )
 
+// identityName is used for mappings that are the identity function, like
+// CIDToGIDMap.
+const identityName name = "Identity"
+
 type catalog struct {
-	Type  name
-	Pages Reference
+	Type          name
+	Pages         Reference
+	OutputIntents []outputIntentDict `pdf:",omitempty"`
 }
 
 type pageRootNode struct {
@@ -195,6 +277,23 @@ func (r Rectangle) Dy() Unit {
 	return r.Max.Y - r.Min.Y
 }
 
+// union returns the smallest rectangle that contains both r and s.
+func (r Rectangle) union(s Rectangle) Rectangle {
+	if s.Min.X < r.Min.X {
+		r.Min.X = s.Min.X
+	}
+	if s.Min.Y < r.Min.Y {
+		r.Min.Y = s.Min.Y
+	}
+	if s.Max.X > r.Max.X {
+		r.Max.X = s.Max.X
+	}
+	if s.Max.Y > r.Max.Y {
+		r.Max.Y = s.Max.Y
+	}
+	return r
+}
+
 func (r Rectangle) marshalPDF(dst []byte) ([]byte, error) {
 	dst = append(dst, '[', ' ')
 	dst, _ = marshal(dst, r.Min.X)
@@ -209,9 +308,23 @@ func (r Rectangle) marshalPDF(dst []byte) ([]byte, error) {
 }
 
 type resources struct {
-	ProcSet []name
-	Font    map[name]interface{}
-	XObject map[name]interface{}
+	ProcSet    []name
+	Font       map[name]interface{}
+	XObject    map[name]interface{}
+	ColorSpace map[name]interface{} `pdf:",omitempty"`
+	ExtGState  map[name]interface{} `pdf:",omitempty"`
+}
+
+// addColorSpace adds a color space to the resources.  Device color spaces
+// (nil) are not resources and are ignored.
+func (res *resources) addColorSpace(cs colorSpace) {
+	if cs == nil {
+		return
+	}
+	if res.ColorSpace == nil {
+		res.ColorSpace = make(map[name]interface{})
+	}
+	res.ColorSpace[cs.resourceName()] = cs.reference()
 }
 
 // Predefined procedure sets
@@ -227,4 +340,5 @@ type standardFontDict struct {
 	Type     name
 	Subtype  name
 	BaseFont name
+	Encoding name `pdf:",omitempty"`
 }
@@ -0,0 +1,571 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf16"
+)
+
+// sfnt is a parsed TrueType or OpenType font program.  Only the tables needed
+// to embed the font in a PDF file are interpreted.
+type sfnt struct {
+	data   []byte
+	tables map[string][]byte
+
+	postscriptName string
+	unitsPerEm     int
+	numGlyphs      int
+	xMin, yMin     int
+	xMax, yMax     int
+	ascent         int
+	descent        int
+	lineGap        int
+	numHMetrics    int
+	capHeight      int
+	xHeight        int
+	italicAngle    float64
+	fixedPitch     bool
+	weightClass    int
+	familyClass    int
+	advances       []uint16
+	cmap           map[rune]uint16
+	symbolic       bool
+	layout         *otLayout
+
+	// vAdvances and tsbs hold the advance heights and top side bearings
+	// from the vmtx table, and vertOrigins holds the vertical origins from
+	// the VORG table.  They are nil if the font does not have the table.
+	vAdvances         []uint16
+	tsbs              []int16
+	vertOrigins       map[uint16]int
+	defaultVertOrigin int
+}
+
+// sfnt version tags
+const (
+	sfntVersionTrueType = 0x00010000
+	sfntVersionApple    = 0x74727565 // 'true'
+	sfntVersionCFF      = 0x4f54544f // 'OTTO'
+)
+
+// fsType bits that restrict embedding
+const (
+	fsTypeRestricted = 0x0002
+	fsTypeBitmapOnly = 0x0200
+)
+
+var (
+	errFontFormat     = errors.New("pdf: unrecognized font format")
+	errFontMalformed  = errors.New("pdf: malformed font")
+	errFontRestricted = errors.New("pdf: font license does not permit embedding")
+)
+
+// parseSFNT parses a TrueType or OpenType font file.
+func parseSFNT(data []byte) (*sfnt, error) {
+	if len(data) < 12 {
+		return nil, errFontFormat
+	}
+	switch binary.BigEndian.Uint32(data) {
+	case sfntVersionTrueType, sfntVersionApple, sfntVersionCFF:
+	default:
+		return nil, errFontFormat
+	}
+	f := &sfnt{data: data, tables: make(map[string][]byte)}
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	if len(data) < 12+16*numTables {
+		return nil, errFontMalformed
+	}
+	for i := 0; i < numTables; i++ {
+		rec := data[12+16*i:]
+		tag := string(rec[:4])
+		off, n := binary.BigEndian.Uint32(rec[8:]), binary.BigEndian.Uint32(rec[12:])
+		if uint64(off)+uint64(n) > uint64(len(data)) {
+			return nil, errFontMalformed
+		}
+		f.tables[tag] = data[off : off+n]
+	}
+
+	for _, parse := range []func() error{
+		f.parseHead,
+		f.parseHhea,
+		f.parseMaxp,
+		f.parseHmtx,
+		f.parseOS2,
+		f.parsePost,
+		f.parseName,
+		f.parseCmap,
+		f.parseVmtx,
+		f.parseVORG,
+	} {
+		if err := parse(); err != nil {
+			return nil, err
+		}
+	}
+	f.layout = parseOTLayout(f.tables)
+	return f, nil
+}
+
+// table returns the named table, or an error if the font does not have it.
+func (f *sfnt) table(tag string) ([]byte, error) {
+	t, ok := f.tables[tag]
+	if !ok {
+		return nil, errors.New("pdf: font is missing " + tag + " table")
+	}
+	return t, nil
+}
+
+// isCFF reports whether the font's outlines are stored in a CFF table.
+func (f *sfnt) isCFF() bool {
+	_, ok := f.tables["CFF "]
+	return ok
+}
+
+func (f *sfnt) parseHead() error {
+	head, err := f.table("head")
+	if err != nil {
+		return err
+	}
+	if len(head) < 54 {
+		return errFontMalformed
+	}
+	f.unitsPerEm = int(u16(head, 18))
+	if f.unitsPerEm == 0 {
+		return errFontMalformed
+	}
+	f.xMin, f.yMin = int(i16(head, 36)), int(i16(head, 38))
+	f.xMax, f.yMax = int(i16(head, 40)), int(i16(head, 42))
+	return nil
+}
+
+func (f *sfnt) parseHhea() error {
+	hhea, err := f.table("hhea")
+	if err != nil {
+		return err
+	}
+	if len(hhea) < 36 {
+		return errFontMalformed
+	}
+	f.ascent = int(i16(hhea, 4))
+	f.descent = int(i16(hhea, 6))
+	f.lineGap = int(i16(hhea, 8))
+	f.numHMetrics = int(u16(hhea, 34))
+	return nil
+}
+
+func (f *sfnt) parseMaxp() error {
+	maxp, err := f.table("maxp")
+	if err != nil {
+		return err
+	}
+	if len(maxp) < 6 {
+		return errFontMalformed
+	}
+	f.numGlyphs = int(u16(maxp, 4))
+	return nil
+}
+
+func (f *sfnt) parseHmtx() error {
+	hmtx, err := f.table("hmtx")
+	if err != nil {
+		return err
+	}
+	numMetrics := f.numHMetrics
+	if numMetrics == 0 || numMetrics > f.numGlyphs || len(hmtx) < 4*numMetrics {
+		return errFontMalformed
+	}
+	f.advances = make([]uint16, f.numGlyphs)
+	for i := range f.advances {
+		if i < numMetrics {
+			f.advances[i] = u16(hmtx, 4*i)
+		} else {
+			f.advances[i] = f.advances[numMetrics-1]
+		}
+	}
+	return nil
+}
+
+// parseVmtx reads the vertical metrics of a font that has them.
+func (f *sfnt) parseVmtx() error {
+	vhea, ok := f.tables["vhea"]
+	if !ok {
+		return nil
+	}
+	vmtx, err := f.table("vmtx")
+	if err != nil {
+		return err
+	}
+	if len(vhea) < 36 {
+		return errFontMalformed
+	}
+	numMetrics := int(u16(vhea, 34))
+	if numMetrics == 0 || numMetrics > f.numGlyphs || len(vmtx) < 4*numMetrics {
+		return errFontMalformed
+	}
+	f.vAdvances = make([]uint16, f.numGlyphs)
+	f.tsbs = make([]int16, f.numGlyphs)
+	for i := range f.vAdvances {
+		if i < numMetrics {
+			f.vAdvances[i], f.tsbs[i] = u16(vmtx, 4*i), i16(vmtx, 4*i+2)
+			continue
+		}
+		f.vAdvances[i] = f.vAdvances[numMetrics-1]
+		if off := 4*numMetrics + 2*(i-numMetrics); off+2 <= len(vmtx) {
+			f.tsbs[i] = i16(vmtx, off)
+		}
+	}
+	return nil
+}
+
+// parseVORG reads the vertical origins of a font with CFF outlines.
+func (f *sfnt) parseVORG() error {
+	vorg, ok := f.tables["VORG"]
+	if !ok {
+		return nil
+	}
+	if len(vorg) < 8 {
+		return errFontMalformed
+	}
+	f.defaultVertOrigin = int(i16(vorg, 4))
+	n := int(u16(vorg, 6))
+	if len(vorg) < 8+4*n {
+		return errFontMalformed
+	}
+	f.vertOrigins = make(map[uint16]int, n)
+	for i := 0; i < n; i++ {
+		f.vertOrigins[u16(vorg, 8+4*i)] = int(i16(vorg, 8+4*i+2))
+	}
+	return nil
+}
+
+func (f *sfnt) parseOS2() error {
+	os2, ok := f.tables["OS/2"]
+	if !ok {
+		// OS/2 is required by OpenType, but old Mac TrueType fonts lack it.
+		f.weightClass = 400
+		f.capHeight = f.ascent
+		return nil
+	}
+	if len(os2) < 78 {
+		return errFontMalformed
+	}
+	fsType := u16(os2, 8)
+	if fsType&0x000f == fsTypeRestricted || fsType&fsTypeBitmapOnly != 0 {
+		return errFontRestricted
+	}
+	f.weightClass = int(u16(os2, 4))
+	f.familyClass = int(i16(os2, 30) >> 8)
+	if ascent, descent := int(i16(os2, 68)), int(i16(os2, 70)); ascent != 0 || descent != 0 {
+		f.ascent, f.descent = ascent, descent
+	}
+	f.capHeight = f.ascent
+	if u16(os2, 0) >= 2 && len(os2) >= 90 {
+		f.xHeight = int(i16(os2, 86))
+		if capHeight := int(i16(os2, 88)); capHeight != 0 {
+			f.capHeight = capHeight
+		}
+	}
+	return nil
+}
+
+func (f *sfnt) parsePost() error {
+	post, ok := f.tables["post"]
+	if !ok {
+		return nil
+	}
+	if len(post) < 16 {
+		return errFontMalformed
+	}
+	f.italicAngle = float64(int32(binary.BigEndian.Uint32(post[4:]))) / 65536
+	f.fixedPitch = binary.BigEndian.Uint32(post[12:]) != 0
+	return nil
+}
+
+// Name table identifiers
+const (
+	nameFullName       = 4
+	namePostScriptName = 6
+)
+
+func (f *sfnt) parseName() error {
+	tab, ok := f.tables["name"]
+	if !ok || len(tab) < 6 {
+		f.postscriptName = "Embedded"
+		return nil
+	}
+	count, strOff := int(u16(tab, 2)), int(u16(tab, 4))
+	if len(tab) < 6+12*count {
+		return errFontMalformed
+	}
+	var full string
+	for i := 0; i < count; i++ {
+		rec := tab[6+12*i:]
+		platform, encoding := u16(rec, 0), u16(rec, 2)
+		id := u16(rec, 6)
+		n, off := int(u16(rec, 8)), strOff+int(u16(rec, 10))
+		if id != namePostScriptName && id != nameFullName || off+n > len(tab) {
+			continue
+		}
+		var s string
+		switch {
+		case platform == 1 && encoding == 0:
+			s = string(tab[off : off+n])
+		case platform == 0 || platform == 3 && (encoding == 0 || encoding == 1):
+			s = decodeUTF16(tab[off : off+n])
+		default:
+			continue
+		}
+		if id == namePostScriptName && f.postscriptName == "" {
+			f.postscriptName = s
+		} else if id == nameFullName && full == "" {
+			full = s
+		}
+	}
+	if f.postscriptName == "" {
+		f.postscriptName = full
+	}
+	f.postscriptName = sanitizePostScriptName(f.postscriptName)
+	if f.postscriptName == "" {
+		f.postscriptName = "Embedded"
+	}
+	return nil
+}
+
+// sanitizePostScriptName removes characters that are not permitted in a
+// PostScript font name.
+func sanitizePostScriptName(s string) string {
+	b := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c <= ' ' || c >= 0x7f {
+			continue
+		}
+		switch c {
+		case '[', ']', '(', ')', '{', '}', '<', '>', '/', '%':
+			continue
+		}
+		b = append(b, c)
+	}
+	return string(b)
+}
+
+func decodeUTF16(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = u16(b, 2*i)
+	}
+	return string(utf16.Decode(u))
+}
+
+// parseCmap reads the best Unicode subtable from the cmap table.
+func (f *sfnt) parseCmap() error {
+	tab, err := f.table("cmap")
+	if err != nil {
+		return err
+	}
+	if len(tab) < 4 {
+		return errFontMalformed
+	}
+	n := int(u16(tab, 2))
+	if len(tab) < 4+8*n {
+		return errFontMalformed
+	}
+	best, bestRank := -1, 0
+	for i := 0; i < n; i++ {
+		rec := tab[4+8*i:]
+		platform, encoding := u16(rec, 0), u16(rec, 2)
+		var rank int
+		switch {
+		case platform == 3 && encoding == 10, platform == 0 && (encoding == 4 || encoding == 6):
+			rank = 4
+		case platform == 3 && encoding == 1, platform == 0:
+			rank = 3
+		case platform == 3 && encoding == 0:
+			rank = 2
+		case platform == 1 && encoding == 0:
+			rank = 1
+		}
+		if rank > bestRank {
+			best, bestRank = int(binary.BigEndian.Uint32(rec[4:])), rank
+		}
+	}
+	if best < 0 || best >= len(tab) {
+		return errors.New("pdf: font has no usable cmap")
+	}
+	f.symbolic = bestRank <= 2
+	f.cmap = make(map[rune]uint16)
+	if err := parseCmapSubtable(f.cmap, tab[best:]); err != nil {
+		return err
+	}
+	if bestRank == 2 {
+		// Symbol fonts map their characters into the private use area
+		// starting at U+F000.  Make them addressable by single bytes.
+		for r, g := range f.cmap {
+			if r >= 0xf000 && r <= 0xf0ff {
+				if _, ok := f.cmap[r-0xf000]; !ok {
+					f.cmap[r-0xf000] = g
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func parseCmapSubtable(m map[rune]uint16, sub []byte) error {
+	if len(sub) < 2 {
+		return errFontMalformed
+	}
+	switch u16(sub, 0) {
+	case 0:
+		if len(sub) < 6+256 {
+			return errFontMalformed
+		}
+		for c := 0; c < 256; c++ {
+			if g := sub[6+c]; g != 0 {
+				m[rune(c)] = uint16(g)
+			}
+		}
+	case 4:
+		if len(sub) < 14 {
+			return errFontMalformed
+		}
+		segCount := int(u16(sub, 6)) / 2
+		endOff := 14
+		startOff := endOff + 2*segCount + 2
+		deltaOff := startOff + 2*segCount
+		rangeOff := deltaOff + 2*segCount
+		if len(sub) < rangeOff+2*segCount {
+			return errFontMalformed
+		}
+		for i := 0; i < segCount; i++ {
+			end, start := int(u16(sub, endOff+2*i)), int(u16(sub, startOff+2*i))
+			delta, ro := u16(sub, deltaOff+2*i), int(u16(sub, rangeOff+2*i))
+			for c := start; c <= end && c != 0xffff; c++ {
+				var g uint16
+				if ro == 0 {
+					g = uint16(c) + delta
+				} else {
+					off := rangeOff + 2*i + ro + 2*(c-start)
+					if off+2 > len(sub) {
+						return errFontMalformed
+					}
+					if g = u16(sub, off); g != 0 {
+						g += delta
+					}
+				}
+				if g != 0 {
+					m[rune(c)] = g
+				}
+			}
+		}
+	case 6:
+		if len(sub) < 10 {
+			return errFontMalformed
+		}
+		first, count := int(u16(sub, 6)), int(u16(sub, 8))
+		if len(sub) < 10+2*count {
+			return errFontMalformed
+		}
+		for i := 0; i < count; i++ {
+			if g := u16(sub, 10+2*i); g != 0 {
+				m[rune(first+i)] = g
+			}
+		}
+	case 12:
+		if len(sub) < 16 {
+			return errFontMalformed
+		}
+		n := int(binary.BigEndian.Uint32(sub[12:]))
+		if n < 0 || len(sub) < 16+12*n {
+			return errFontMalformed
+		}
+		for i := 0; i < n; i++ {
+			grp := sub[16+12*i:]
+			start, end := binary.BigEndian.Uint32(grp), binary.BigEndian.Uint32(grp[4:])
+			g := binary.BigEndian.Uint32(grp[8:])
+			if end > 0x10ffff || start > end {
+				return errFontMalformed
+			}
+			for c := start; c <= end; c++ {
+				m[rune(c)] = uint16(g + c - start)
+			}
+		}
+	default:
+		return errors.New("pdf: unsupported cmap format")
+	}
+	return nil
+}
+
+// glyphIndex returns the glyph that represents r, or zero if the font does not
+// have a glyph for r.
+func (f *sfnt) glyphIndex(r rune) uint16 {
+	return f.cmap[r]
+}
+
+// advance returns the advance width of a glyph in thousandths of an em.
+func (f *sfnt) advance(g uint16) int {
+	if int(g) >= len(f.advances) {
+		return 0
+	}
+	return f.scale(int(f.advances[g]))
+}
+
+// verticalAdvance returns the advance height of a glyph in thousandths of an
+// em.  Fonts without vertical metrics advance by the distance between their
+// ascender and descender.
+func (f *sfnt) verticalAdvance(g uint16) int {
+	if int(g) < len(f.vAdvances) {
+		return f.scale(int(f.vAdvances[g]))
+	}
+	return f.scale(f.ascent - f.descent)
+}
+
+// verticalOrigin returns the height of a glyph's origin in vertical writing
+// above its origin in horizontal writing, in thousandths of an em.
+func (f *sfnt) verticalOrigin(g uint16) int {
+	if f.vertOrigins != nil {
+		if y, ok := f.vertOrigins[g]; ok {
+			return f.scale(y)
+		}
+		return f.scale(f.defaultVertOrigin)
+	}
+	if int(g) < len(f.tsbs) && !f.isCFF() {
+		// The top of the glyph is its top side bearing below the
+		// vertical origin.
+		if data, err := f.glyphData(g); err == nil && len(data) >= 10 {
+			return f.scale(int(f.tsbs[g]) + int(i16(data, 8)))
+		}
+	}
+	return f.scale(f.ascent)
+}
+
+// scale converts a value in font design units to thousandths of an em, the
+// unit used by PDF glyph space.
+func (f *sfnt) scale(v int) int {
+	if v < 0 {
+		return -((-v*1000 + f.unitsPerEm/2) / f.unitsPerEm)
+	}
+	return (v*1000 + f.unitsPerEm/2) / f.unitsPerEm
+}
+
+// metrics returns the font's dimensions in thousandths of an em.
+func (f *sfnt) metrics() FontMetrics {
+	u := func(v int) Unit { return Unit(f.scale(v)) }
+	return FontMetrics{
+		Ascender:    u(f.ascent),
+		Descender:   u(f.descent),
+		CapHeight:   u(f.capHeight),
+		XHeight:     u(f.xHeight),
+		ItalicAngle: f.italicAngle,
+		FontBBox:    Rectangle{Point{u(f.xMin), u(f.yMin)}, Point{u(f.xMax), u(f.yMax)}},
+	}
+}
+
+func u16(b []byte, off int) uint16 {
+	return binary.BigEndian.Uint16(b[off:])
+}
+
+func i16(b []byte, off int) int16 {
+	return int16(binary.BigEndian.Uint16(b[off:]))
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func TestParseSFNT(t *testing.T) {
+	f, err := parseSFNT(goregular.TTF)
+	if err != nil {
+		t.Fatalf("parseSFNT error: %v", err)
+	}
+	if f.postscriptName != "GoRegular" {
+		t.Errorf("postscriptName = %q; want %q", f.postscriptName, "GoRegular")
+	}
+	if f.unitsPerEm != 2048 {
+		t.Errorf("unitsPerEm = %d; want %d", f.unitsPerEm, 2048)
+	}
+	if f.isCFF() {
+		t.Error("isCFF() = true; want false")
+	}
+	if f.symbolic {
+		t.Error("symbolic = true; want false")
+	}
+
+	advanceTests := []struct {
+		r       rune
+		advance int
+	}{
+		{'H', 722},
+		{'e', 556},
+		{'l', 268},
+		{' ', 278},
+		{'é', 556},
+		{'€', 556},
+	}
+	for _, tt := range advanceTests {
+		g := f.glyphIndex(tt.r)
+		if g == 0 {
+			t.Errorf("glyphIndex(%q) = 0", tt.r)
+			continue
+		}
+		if adv := f.advance(g); adv != tt.advance {
+			t.Errorf("advance(glyphIndex(%q)) = %d; want %d", tt.r, adv, tt.advance)
+		}
+	}
+	if g := f.glyphIndex('世'); g != 0 {
+		t.Errorf("glyphIndex(%q) = %d; want 0", '世', g)
+	}
+}
+
+func TestParseSFNTErrors(t *testing.T) {
+	tests := [][]byte{
+		nil,
+		[]byte("not a font file"),
+		goregular.TTF[:100],
+	}
+	for i, data := range tests {
+		if _, err := parseSFNT(data); err == nil {
+			t.Errorf("%d. parseSFNT did not return an error", i)
+		}
+	}
+}
@@ -0,0 +1,674 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"sort"
+	"unicode"
+)
+
+// shapingFont is a font that chooses and positions its own glyphs for a
+// string, rather than showing one glyph per character.
+type shapingFont interface {
+	Font
+
+	// shape converts s to character codes in the order that they are
+	// displayed.  If rtl is true, s is right-to-left text in logical
+	// order.  adjust holds the horizontal positioning adjustments, and
+	// rise holds the vertical offset of the glyphs from the index of each
+	// adjustment onward; both are in thousandths of an em.
+	shape(s string, rtl, kern bool) (codes string, adjust, rise []kernAdjustment)
+}
+
+// shape lays out s with the font's OpenType layout tables.  Ligatures and
+// alternate forms are recorded so that the font's ToUnicode CMap maps them
+// back to the text they came from.
+func (font *compositeFont) shape(s string, rtl, kern bool) (string, []kernAdjustment, []kernAdjustment) {
+	f := font.sfnt
+	text := []rune(s)
+	glyphs := f.shape(text, rtl, kern)
+	font.recordText(text, glyphs, rtl)
+
+	codes := make([]byte, 0, 2*len(glyphs))
+	var adjust, rise []kernAdjustment
+	pen, pos, y := 0, 0, 0
+	for k, g := range glyphs {
+		cid := font.cid(g.gid)
+		codes = append(codes, byte(cid>>8), byte(cid))
+		if want := pen + f.scale(g.xOffset); want != pos {
+			adjust = append(adjust, kernAdjustment{2 * k, want - pos})
+			pos = want
+		}
+		pos += f.advance(g.gid)
+		pen += f.scale(g.advance)
+		if dy := f.scale(g.yOffset); dy != y {
+			rise = append(rise, kernAdjustment{2 * k, dy})
+			y = dy
+		}
+	}
+	if pen != pos {
+		adjust = append(adjust, kernAdjustment{len(codes), pen - pos})
+	}
+	return string(codes), adjust, rise
+}
+
+// recordText remembers the text shown by each glyph in a shaped string.  A
+// glyph shows the characters from its cluster to the next cluster in the
+// string.
+func (font *compositeFont) recordText(text []rune, glyphs []glyphInfo, rtl bool) {
+	clusters := make([]int, 0, len(glyphs))
+	for _, g := range glyphs {
+		clusters = append(clusters, g.cluster)
+	}
+	sort.Ints(clusters)
+	seen := make(map[int]bool)
+	for k := range glyphs {
+		if rtl {
+			k = len(glyphs) - 1 - k
+		}
+		g := glyphs[k]
+		if seen[g.cluster] {
+			continue
+		}
+		seen[g.cluster] = true
+		end := len(text)
+		if i := sort.SearchInts(clusters, g.cluster+1); i < len(clusters) {
+			end = clusters[i]
+		}
+		cid := font.cid(g.gid)
+		if _, ok := font.glyphText[cid]; !ok && g.cluster < end {
+			font.glyphText[cid] = string(text[g.cluster:end])
+		}
+	}
+}
+
+// shape converts text to glyphs with the font's layout tables.  The glyphs
+// are returned in the order that they are displayed.
+func (f *sfnt) shape(text []rune, rtl, kern bool) []glyphInfo {
+	var glyphs []glyphInfo
+	for start := 0; start < len(text); {
+		end, sh := scriptRun(text, start)
+		glyphs = append(glyphs, f.shapeRun(text, start, end, sh, rtl, kern)...)
+		start = end
+	}
+	if rtl {
+		for a, b := 0, len(glyphs)-1; a < b; a, b = a+1, b-1 {
+			glyphs[a], glyphs[b] = glyphs[b], glyphs[a]
+		}
+	}
+	return glyphs
+}
+
+// shapeRun shapes the characters of text between start and end, which are in a
+// single script.  The glyphs are returned in logical order.
+func (f *sfnt) shapeRun(text []rune, start, end int, sh *scriptShaper, rtl, kern bool) []glyphInfo {
+	l := f.layout
+	b := &glyphBuffer{layout: l, rtl: rtl}
+	var plan *shapePlan
+	if l != nil {
+		plan = l.plan(sh, kern)
+	}
+	for i := start; i < end; i++ {
+		g := glyphInfo{gid: f.glyphIndex(text[i]), cluster: i, class: glyphClassBase}
+		if l != nil {
+			g.mask = plan.global
+			if c := l.glyphClass(g.gid); c != 0 {
+				g.class = c
+			} else if unicode.In(text[i], unicode.Mn, unicode.Me) {
+				g.class = glyphClassMark
+			}
+		}
+		b.glyphs = append(b.glyphs, g)
+	}
+
+	if l != nil && l.gsub != nil {
+		if sh.setup != nil {
+			sh.setup(b, text[start:end], plan)
+		}
+		for _, st := range plan.gsub {
+			b.apply(l.gsub, st.lookups, st.masks)
+			if st.pause != nil {
+				st.pause(b, plan)
+			}
+		}
+	}
+
+	// Remove invisible formatting characters, which have done their job of
+	// controlling the substitutions.
+	glyphs := b.glyphs[:0]
+	for _, g := range b.glyphs {
+		if !defaultIgnorable(text[g.cluster]) || g.gid != f.glyphIndex(text[g.cluster]) {
+			if int(g.gid) < len(f.advances) {
+				g.advance = int(f.advances[g.gid])
+			}
+			glyphs = append(glyphs, g)
+		}
+	}
+	b.glyphs = glyphs
+
+	if l != nil && l.gpos != nil {
+		for _, st := range plan.gpos {
+			b.apply(l.gpos, st.lookups, st.masks)
+		}
+	}
+	if l != nil {
+		for i := range b.glyphs {
+			if b.glyphs[i].class == glyphClassMark {
+				b.glyphs[i].advance = 0
+			}
+		}
+		b.resolveAttachments()
+	}
+	return b.glyphs
+}
+
+// defaultIgnorable reports whether r is a formatting character that is not
+// displayed, like a zero width joiner.
+func defaultIgnorable(r rune) bool {
+	switch {
+	case r == 0x00AD, r == 0x034F, r == 0x180E, r == 0xFEFF:
+		return true
+	case r >= 0x200B && r <= 0x200F, r >= 0x202A && r <= 0x202E, r >= 0x2060 && r <= 0x206F:
+		return true
+	}
+	return false
+}
+
+// shapeFeature is an OpenType feature applied by a shaper.  Global features
+// apply to every glyph; others only apply to the glyphs the shaper selects.
+type shapeFeature struct {
+	tag    string
+	global bool
+}
+
+// featureStage is a group of features whose lookups are applied together, in
+// lookup order.  pause, if not nil, is called after the stage.
+type featureStage struct {
+	features []shapeFeature
+	pause    func(b *glyphBuffer, plan *shapePlan)
+}
+
+// scriptShaper describes how text in a script is shaped.
+type scriptShaper struct {
+	table  *unicode.RangeTable
+	tags   []string // OpenType script tags, in order of preference
+	stages []featureStage
+
+	// setup prepares the glyphs of a run before the substitutions are
+	// applied, selecting the glyphs that features apply to.
+	setup func(b *glyphBuffer, text []rune, plan *shapePlan)
+}
+
+// shapeStage is a feature stage resolved to lookups.
+type shapeStage struct {
+	lookups []int
+	masks   []uint32
+	pause   func(b *glyphBuffer, plan *shapePlan)
+}
+
+// shapePlan is the lookups that a font applies to text in a script.
+type shapePlan struct {
+	gsub, gpos []shapeStage
+	global     uint32            // mask of the global features
+	masks      map[string]uint32 // mask of each feature that is not global
+}
+
+type planKey struct {
+	shaper *scriptShaper
+	kern   bool
+}
+
+var positionStage = featureStage{features: []shapeFeature{
+	{"kern", true},
+	{"mark", true},
+	{"mkmk", true},
+	{"curs", true},
+	{"dist", true},
+	{"abvm", true},
+	{"blwm", true},
+}}
+
+// plan returns the lookups that the font applies to text in a script.
+func (l *otLayout) plan(sh *scriptShaper, kern bool) *shapePlan {
+	if p := l.plans[planKey{sh, kern}]; p != nil {
+		return p
+	}
+	p := &shapePlan{global: 1, masks: make(map[string]uint32)}
+	next := uint32(2)
+	for _, st := range sh.stages {
+		for _, f := range st.features {
+			if !f.global && p.masks[f.tag] == 0 {
+				p.masks[f.tag] = next
+				next <<= 1
+			}
+		}
+	}
+	scripts := append(append([]string(nil), sh.tags...), "DFLT", "dflt", "latn")
+	if l.gsub != nil {
+		ls := l.gsub.langSys(scripts)
+		for i, st := range sh.stages {
+			stage := p.stage(l.gsub, ls, st, i == 0)
+			stage.pause = st.pause
+			p.gsub = append(p.gsub, stage)
+		}
+	}
+	if l.gpos != nil {
+		st := positionStage
+		if !kern {
+			st.features = st.features[1:]
+		}
+		p.gpos = []shapeStage{p.stage(l.gpos, l.gpos.langSys(scripts), st, true)}
+	}
+	if l.plans == nil {
+		l.plans = make(map[planKey]*shapePlan)
+	}
+	l.plans[planKey{sh, kern}] = p
+	return p
+}
+
+// stage resolves the features of a stage to the lookups of a table.  If
+// required is true, the language system's required feature is included.
+func (p *shapePlan) stage(t *otTable, ls otData, st featureStage, required bool) shapeStage {
+	masks := make(map[int]uint32)
+	if required {
+		for _, i := range t.featureLookups(ls, "") {
+			masks[i] |= p.global
+		}
+	}
+	for _, f := range st.features {
+		mask := p.masks[f.tag]
+		if f.global {
+			mask = p.global
+		}
+		for _, i := range t.featureLookups(ls, f.tag) {
+			masks[i] |= mask
+		}
+	}
+	var stage shapeStage
+	for i := range masks {
+		stage.lookups = append(stage.lookups, i)
+	}
+	sort.Ints(stage.lookups)
+	for _, i := range stage.lookups {
+		stage.masks = append(stage.masks, masks[i])
+	}
+	return stage
+}
+
+var defaultStages = []featureStage{
+	{features: []shapeFeature{{"ccmp", true}, {"locl", true}}},
+	{features: []shapeFeature{{"rlig", true}, {"liga", true}, {"clig", true}, {"calt", true}}},
+}
+
+var arabicStages = []featureStage{
+	{features: []shapeFeature{{"ccmp", true}, {"locl", true}}},
+	{features: []shapeFeature{{"isol", false}}},
+	{features: []shapeFeature{{"fina", false}}},
+	{features: []shapeFeature{{"medi", false}}},
+	{features: []shapeFeature{{"init", false}}},
+	{features: []shapeFeature{{"rlig", true}, {"calt", true}, {"liga", true}, {"mset", true}}},
+}
+
+var indicStages = []featureStage{
+	{features: []shapeFeature{{"ccmp", true}, {"locl", true}}},
+	{features: []shapeFeature{{"nukt", true}}},
+	{features: []shapeFeature{{"akhn", true}}},
+	{features: []shapeFeature{{"rphf", false}}},
+	{features: []shapeFeature{{"rkrf", true}}},
+	{features: []shapeFeature{{"pref", false}}},
+	{features: []shapeFeature{{"blwf", false}}},
+	{features: []shapeFeature{{"abvf", true}}},
+	{features: []shapeFeature{{"half", false}}},
+	{features: []shapeFeature{{"pstf", false}}},
+	{features: []shapeFeature{{"vatu", true}}},
+	{features: []shapeFeature{{"cjct", true}}, pause: indicFinalReorder},
+	{features: []shapeFeature{
+		{"pres", true},
+		{"abvs", true},
+		{"blws", true},
+		{"psts", true},
+		{"haln", true},
+		{"calt", true},
+		{"rlig", true},
+		{"liga", true},
+		{"clig", true},
+	}},
+}
+
+var (
+	defaultShaper = &scriptShaper{stages: defaultStages}
+	latinShaper   = &scriptShaper{table: unicode.Latin, tags: []string{"latn"}, stages: defaultStages}
+)
+
+// scriptShapers lists the scripts that have their own OpenType script tags.
+var scriptShapers = []*scriptShaper{
+	latinShaper,
+	{table: unicode.Arabic, tags: []string{"arab"}, stages: arabicStages, setup: arabicSetup},
+	{table: unicode.Hebrew, tags: []string{"hebr"}, stages: defaultStages},
+	{table: unicode.Greek, tags: []string{"grek"}, stages: defaultStages},
+	{table: unicode.Cyrillic, tags: []string{"cyrl"}, stages: defaultStages},
+	{table: unicode.Armenian, tags: []string{"armn"}, stages: defaultStages},
+	{table: unicode.Georgian, tags: []string{"geor"}, stages: defaultStages},
+	{table: unicode.Devanagari, tags: []string{"dev2", "deva"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Bengali, tags: []string{"bng2", "beng"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Gurmukhi, tags: []string{"gur2", "guru"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Gujarati, tags: []string{"gjr2", "gujr"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Oriya, tags: []string{"ory2", "orya"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Tamil, tags: []string{"tml2", "taml"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Telugu, tags: []string{"tel2", "telu"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Kannada, tags: []string{"knd2", "knda"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Malayalam, tags: []string{"mlm2", "mlym"}, stages: indicStages, setup: indicSetup},
+	{table: unicode.Thai, tags: []string{"thai"}, stages: defaultStages},
+	{table: unicode.Han, tags: []string{"hani"}, stages: defaultStages},
+	{table: unicode.Hiragana, tags: []string{"kana"}, stages: defaultStages},
+	{table: unicode.Katakana, tags: []string{"kana"}, stages: defaultStages},
+	{table: unicode.Hangul, tags: []string{"hang"}, stages: defaultStages},
+}
+
+// shaperOf returns the shaper for a character's script, or nil if the
+// character is used by many scripts.
+func shaperOf(r rune) *scriptShaper {
+	if r < 0x80 {
+		if 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' {
+			return latinShaper
+		}
+		return nil
+	}
+	if unicode.In(r, unicode.Common, unicode.Inherited) {
+		return nil
+	}
+	for _, sh := range scriptShapers {
+		if unicode.Is(sh.table, r) {
+			return sh
+		}
+	}
+	return defaultShaper
+}
+
+// scriptRun returns the end of the run of text in a single script that begins
+// at start.  Characters that are used by many scripts, like spaces and
+// combining marks, join the run around them.
+func scriptRun(text []rune, start int) (end int, sh *scriptShaper) {
+	for end = start; end < len(text); end++ {
+		s := shaperOf(text[end])
+		if s == nil {
+			continue
+		}
+		if sh == nil {
+			sh = s
+		} else if s != sh {
+			break
+		}
+	}
+	if sh == nil {
+		sh = defaultShaper
+	}
+	return end, sh
+}
+
+// arabicSetup selects the isolated, final, medial or initial form of each
+// letter from the joining behavior of the letters around it.
+func arabicSetup(b *glyphBuffer, text []rune, plan *shapePlan) {
+	forms := make([]string, len(text))
+	prev, prevType := -1, byte('U')
+	for i, r := range text {
+		t := arabicJoining(r)
+		if t == 'T' {
+			continue
+		}
+		if prev >= 0 && (prevType == 'D' || prevType == 'C') && (t == 'D' || t == 'R' || t == 'C') {
+			switch forms[prev] {
+			case "isol":
+				forms[prev] = "init"
+			case "fina":
+				forms[prev] = "medi"
+			}
+			forms[i] = "fina"
+		} else if t != 'U' {
+			forms[i] = "isol"
+		}
+		prev, prevType = i, t
+	}
+	for i, form := range forms {
+		if form != "" {
+			b.glyphs[i].mask |= plan.masks[form]
+		}
+	}
+}
+
+// arabicJoining returns the joining type of a character: 'R' for characters
+// that only join to the character before them, 'D' for characters that join
+// on both sides, 'C' for characters that cause joining, 'T' for transparent
+// characters and 'U' for characters that do not join.
+func arabicJoining(r rune) byte {
+	switch {
+	case r == 0x0640 || r == 0x200D:
+		return 'C'
+	case r == 0x200C:
+		return 'U'
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 'T'
+	case r < 0x0620 || r > 0x077F || r > 0x06FF && r < 0x0750:
+		return 'U'
+	}
+	for _, rng := range arabicJoiningRanges {
+		if rng.lo <= r && r <= rng.hi {
+			return rng.t
+		}
+	}
+	if unicode.IsLetter(r) {
+		return 'D'
+	}
+	return 'U'
+}
+
+// arabicJoiningRanges lists the Arabic letters that do not join on both sides.
+var arabicJoiningRanges = []struct {
+	lo, hi rune
+	t      byte
+}{
+	{0x0621, 0x0621, 'U'},
+	{0x0622, 0x0625, 'R'},
+	{0x0627, 0x0627, 'R'},
+	{0x0629, 0x0629, 'R'},
+	{0x062F, 0x0632, 'R'},
+	{0x0648, 0x0648, 'R'},
+	{0x0671, 0x0673, 'R'},
+	{0x0674, 0x0674, 'U'},
+	{0x0675, 0x0677, 'R'},
+	{0x0688, 0x0699, 'R'},
+	{0x06C0, 0x06C0, 'R'},
+	{0x06C3, 0x06CB, 'R'},
+	{0x06CD, 0x06CD, 'R'},
+	{0x06CF, 0x06CF, 'R'},
+	{0x06D2, 0x06D3, 'R'},
+	{0x06D5, 0x06D5, 'R'},
+	{0x06E5, 0x06E6, 'U'},
+	{0x06EE, 0x06EF, 'R'},
+	{0x0759, 0x075B, 'R'},
+	{0x076B, 0x076C, 'R'},
+	{0x0771, 0x0771, 'R'},
+	{0x0773, 0x0774, 'R'},
+	{0x0778, 0x0779, 'R'},
+}
+
+// Indic character categories
+const (
+	indicOther = iota
+	indicConsonant
+	indicVowel
+	indicNukta
+	indicHalant
+	indicMatra
+	indicModifier
+	indicJoiner
+)
+
+// indicCategory returns the category of a character in one of the Indic
+// scripts from Devanagari to Malayalam, whose blocks share a layout.
+func indicCategory(r rune) byte {
+	if r == 0x200C || r == 0x200D {
+		return indicJoiner
+	}
+	if r < 0x0900 || r > 0x0D7F {
+		return indicOther
+	}
+	switch o := r & 0x7f; {
+	case o >= 0x01 && o <= 0x03:
+		return indicModifier
+	case o >= 0x04 && o <= 0x14, o == 0x60, o == 0x61:
+		return indicVowel
+	case o >= 0x15 && o <= 0x39, o >= 0x58 && o <= 0x5f:
+		return indicConsonant
+	case o == 0x3c:
+		return indicNukta
+	case o == 0x4d:
+		return indicHalant
+	case o >= 0x3e && o <= 0x4c, o >= 0x55 && o <= 0x57, o == 0x62, o == 0x63:
+		return indicMatra
+	}
+	return indicOther
+}
+
+// indicPreBase reports whether a vowel sign is displayed before the consonants
+// that it follows.
+func indicPreBase(r rune) bool {
+	switch r {
+	case 0x093F, 0x094E, 0x09BF, 0x09C7, 0x09C8, 0x0A3F, 0x0ABF, 0x0B47,
+		0x0BC6, 0x0BC7, 0x0BC8, 0x0D46, 0x0D47, 0x0D48:
+		return true
+	}
+	return false
+}
+
+// indicReph reports whether a character is a Ra that forms a reph when it
+// starts a syllable.
+func indicReph(r rune) bool {
+	return r&0x7f == 0x30 && r >= 0x0900 && r <= 0x0D7F && r&^0x7f != 0x0A00 && r&^0x7f != 0x0B80
+}
+
+// indicSyllableEnd returns the end of the syllable that starts at i.
+func indicSyllableEnd(cat []byte, i int) int {
+	n := len(cat)
+	switch cat[i] {
+	case indicConsonant:
+		j := i + 1
+		for {
+			if j < n && cat[j] == indicNukta {
+				j++
+			}
+			if j >= n || cat[j] != indicHalant {
+				break
+			}
+			j++
+			if j < n && cat[j] == indicJoiner {
+				j++
+			}
+			if j >= n || cat[j] != indicConsonant {
+				return j
+			}
+			j++
+		}
+		for j < n && (cat[j] == indicMatra || cat[j] == indicNukta) {
+			j++
+		}
+		for j < n && cat[j] == indicModifier {
+			j++
+		}
+		return j
+	case indicVowel:
+		j := i + 1
+		for j < n && (cat[j] == indicMatra || cat[j] == indicNukta) {
+			j++
+		}
+		for j < n && cat[j] == indicModifier {
+			j++
+		}
+		return j
+	}
+	return i + 1
+}
+
+// indicSetup splits a run into syllables, moves pre-base vowel signs before
+// their consonants and selects the glyphs that form a reph, half forms and
+// below-base or post-base forms.
+func indicSetup(b *glyphBuffer, text []rune, plan *shapePlan) {
+	cat := make([]byte, len(text))
+	for i, r := range text {
+		cat[i] = indicCategory(r)
+	}
+	glyphs := make([]glyphInfo, 0, len(b.glyphs))
+	for s, syllable := 0, 1; s < len(text); syllable++ {
+		e := indicSyllableEnd(cat, s)
+		seg := b.glyphs[s:e]
+		for k := range seg {
+			seg[k].category, seg[k].syllable = cat[s+k], syllable
+		}
+		if cat[s] == indicConsonant {
+			seg = indicInitialReorder(seg, text[s:e], plan)
+		}
+		glyphs = append(glyphs, seg...)
+		s = e
+	}
+	b.glyphs = glyphs
+}
+
+// indicInitialReorder reorders a consonant syllable and sets its masks.  The
+// base consonant is taken to be the last one.
+func indicInitialReorder(seg []glyphInfo, text []rune, plan *shapePlan) []glyphInfo {
+	base := -1
+	for k := len(seg) - 1; k >= 0; k-- {
+		if seg[k].category == indicConsonant {
+			base = k
+			break
+		}
+	}
+	start := 0
+	if base >= 2 && indicReph(text[0]) && seg[1].category == indicHalant && seg[2].category != indicJoiner {
+		seg[0].mask |= plan.masks["rphf"]
+		seg[1].mask |= plan.masks["rphf"]
+		start = 2
+	}
+	for k := start; k < base; k++ {
+		seg[k].mask |= plan.masks["half"]
+	}
+	for k := base + 1; k < len(seg); k++ {
+		seg[k].mask |= plan.masks["pref"] | plan.masks["blwf"] | plan.masks["pstf"]
+	}
+
+	out := make([]glyphInfo, 0, len(seg))
+	out = append(out, seg[:start]...)
+	for k := base + 1; k < len(seg); k++ {
+		if seg[k].category == indicMatra && indicPreBase(text[k]) {
+			out = append(out, seg[k])
+		}
+	}
+	for k := start; k < len(seg); k++ {
+		if k <= base || seg[k].category != indicMatra || !indicPreBase(text[k]) {
+			out = append(out, seg[k])
+		}
+	}
+	return out
+}
+
+// indicFinalReorder moves each reph that was formed to the end of its
+// syllable, before any modifiers.
+func indicFinalReorder(b *glyphBuffer, plan *shapePlan) {
+	rphf := plan.masks["rphf"]
+	for s := 0; s < len(b.glyphs); {
+		e := s + 1
+		for e < len(b.glyphs) && b.glyphs[e].syllable == b.glyphs[s].syllable {
+			e++
+		}
+		if b.glyphs[s].mask&rphf != 0 && e-s > 1 && b.glyphs[s+1].mask&rphf == 0 {
+			j := e - 1
+			for j > s && b.glyphs[j].category == indicModifier {
+				j--
+			}
+			reph := b.glyphs[s]
+			copy(b.glyphs[s:j], b.glyphs[s+1:j+1])
+			b.glyphs[j] = reph
+		}
+		s = e
+	}
+}
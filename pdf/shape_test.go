@@ -0,0 +1,185 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+func u16s(v ...int) []byte {
+	b := make([]byte, 2*len(v))
+	for i, x := range v {
+		binary.BigEndian.PutUint16(b[2*i:], uint16(x))
+	}
+	return b
+}
+
+// buildLayoutTable returns a GSUB or GPOS table with a single feature for the
+// Latin script that uses a single lookup.
+func buildLayoutTable(feature string, lookupType int, subtable []byte) []byte {
+	var b []byte
+	b = append(b, u16s(1, 0, 10, 30, 44)...)
+	// ScriptList
+	b = append(b, u16s(1)...)
+	b = append(b, "latn"...)
+	b = append(b, u16s(8, 4, 0, 0, 0xffff, 1, 0)...)
+	// FeatureList
+	b = append(b, u16s(1)...)
+	b = append(b, feature...)
+	b = append(b, u16s(8, 0, 1, 0)...)
+	// LookupList
+	b = append(b, u16s(1, 4, lookupType, 0, 1, 8)...)
+	return append(b, subtable...)
+}
+
+// shapingTestFont returns Go Regular with an "fi" ligature and kerning for
+// "AV".  It also returns the ligature glyph, which is not in the font's cmap.
+func shapingTestFont(t *testing.T) ([]byte, uint16) {
+	f, err := parseSFNT(goregular.TTF)
+	if err != nil {
+		t.Fatalf("parseSFNT error: %v", err)
+	}
+	mapped := make(map[uint16]bool)
+	for _, g := range f.cmap {
+		mapped[g] = true
+	}
+	lig := uint16(1)
+	for mapped[lig] {
+		lig++
+	}
+
+	tables := make(map[string][]byte)
+	for tag, data := range f.tables {
+		tables[tag] = data
+	}
+	g := func(r rune) int { return int(f.glyphIndex(r)) }
+	tables["GSUB"] = buildLayoutTable("liga", gsubLigature,
+		u16s(1, 8, 1, 14, 1, 1, g('f'), 1, 4, int(lig), 2, g('i')))
+	tables["GPOS"] = buildLayoutTable("kern", gposPair,
+		u16s(1, 12, 0x0004, 0, 1, 18, 1, 1, g('A'), 1, g('V'), -100))
+	return buildSFNT(sfntVersionTrueType, tables), lig
+}
+
+func TestShapeLigature(t *testing.T) {
+	data, lig := shapingTestFont(t)
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	cf := font.(*compositeFont)
+
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.Text("fit")
+	want := fmt.Sprintf("12.00000 TL\n/__font0__ 10.00000 Tf\n<%04X%04X> Tj\n", lig, cf.sfnt.glyphIndex('t'))
+	if text.buf.String() != want {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), want)
+	}
+	if w, want := MeasureString(font, 10, "fit"), Unit(cf.sfnt.advance(lig)+cf.sfnt.advance(cf.sfnt.glyphIndex('t')))/100; w != want {
+		t.Errorf("MeasureString = %v; want %v", w, want)
+	}
+	canvas.DrawText(text)
+	canvas.Close()
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	cmap, err := ioutil.ReadAll(flateReader(t, cf.toUnicode.Bytes()))
+	if err != nil {
+		t.Fatalf("reading ToUnicode: %v", err)
+	}
+	if s := fmt.Sprintf("<%04X> <00660069>\n", lig); !bytes.Contains(cmap, []byte(s)) {
+		t.Errorf("ToUnicode CMap does not contain %q", s)
+	}
+}
+
+func TestShapeKerning(t *testing.T) {
+	data, _ := shapingTestFont(t)
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	f := font.(*compositeFont).sfnt
+	codes := fmt.Sprintf("<%04X> 49 <%04X>", f.glyphIndex('A'), f.glyphIndex('V'))
+
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.Text("AV")
+	text.SetKerning(true)
+	text.Text("AV")
+	want := "12.00000 TL\n/__font0__ 10.00000 Tf\n" +
+		fmt.Sprintf("<%04X%04X> Tj\n", f.glyphIndex('A'), f.glyphIndex('V')) +
+		"[ " + codes + " ] TJ\n"
+	if text.buf.String() != want {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), want)
+	}
+}
+
+var arabicFormTests = []struct {
+	s     string
+	forms []string
+}{
+	{"ب", []string{"isol"}},
+	{"بب", []string{"init", "fina"}},
+	{"ببب", []string{"init", "medi", "fina"}},
+	{"دب", []string{"isol", "isol"}},
+	{"بد", []string{"init", "fina"}},
+	{"بِب", []string{"init", "", "fina"}},
+	{"ب ب", []string{"isol", "", "isol"}},
+}
+
+func TestArabicForms(t *testing.T) {
+	plan := &shapePlan{masks: map[string]uint32{"isol": 1, "fina": 2, "medi": 4, "init": 8}}
+	names := map[uint32]string{0: "", 1: "isol", 2: "fina", 4: "medi", 8: "init"}
+	for _, tt := range arabicFormTests {
+		text := []rune(tt.s)
+		b := &glyphBuffer{glyphs: make([]glyphInfo, len(text))}
+		arabicSetup(b, text, plan)
+		var forms []string
+		for _, g := range b.glyphs {
+			forms = append(forms, names[g.mask])
+		}
+		if fmt.Sprint(forms) != fmt.Sprint(tt.forms) {
+			t.Errorf("forms of %q = %q; want %q", tt.s, forms, tt.forms)
+		}
+	}
+}
+
+var indicReorderTests = []struct {
+	s    string
+	want string
+}{
+	{"कि", "िक"},
+	{"क्षि", "िक्ष"},
+	{"र्कि", "र्िक"},
+	{"नमस्ते", "नमस्ते"},
+}
+
+func TestIndicReorder(t *testing.T) {
+	plan := &shapePlan{masks: map[string]uint32{"rphf": 1, "half": 2}}
+	for _, tt := range indicReorderTests {
+		text := []rune(tt.s)
+		b := &glyphBuffer{}
+		for i := range text {
+			b.glyphs = append(b.glyphs, glyphInfo{cluster: i})
+		}
+		indicSetup(b, text, plan)
+		var out []rune
+		for _, g := range b.glyphs {
+			out = append(out, text[g.cluster])
+		}
+		if string(out) != tt.want {
+			t.Errorf("indicSetup(%q) = %q; want %q", tt.s, string(out), tt.want)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"sort"
+)
+
+// glyphSet is a set of glyph indices.
+type glyphSet map[uint16]bool
+
+// subsetTag returns the six-letter tag that identifies a subset of a font.
+// The tag is derived from the glyphs in the subset, so the same subset always
+// has the same tag.
+func subsetTag(glyphs glyphSet) string {
+	h := fnv.New64a()
+	var buf [2]byte
+	for _, g := range glyphs.sorted() {
+		binary.BigEndian.PutUint16(buf[:], g)
+		h.Write(buf[:])
+	}
+	sum := h.Sum64()
+	tag := make([]byte, 6)
+	for i := range tag {
+		tag[i] = byte('A' + sum%26)
+		sum /= 26
+	}
+	return string(tag)
+}
+
+// sorted returns the glyph indices in ascending order.
+func (glyphs glyphSet) sorted() []uint16 {
+	list := make([]uint16, 0, len(glyphs))
+	for g := range glyphs {
+		list = append(list, g)
+	}
+	sort.Sort(uint16Slice(list))
+	return list
+}
+
+type uint16Slice []uint16
+
+func (p uint16Slice) Len() int           { return len(p) }
+func (p uint16Slice) Less(i, j int) bool { return p[i] < p[j] }
+func (p uint16Slice) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+
+// Composite glyph flags
+const (
+	compositeArgsAreWords   = 0x0001
+	compositeHaveScale      = 0x0008
+	compositeMoreComponents = 0x0020
+	compositeHaveXYScale    = 0x0040
+	compositeHaveTwoByTwo   = 0x0080
+)
+
+// tablesKeptInSubset lists the TrueType tables copied into a subset font.
+// The other tables are either rebuilt or not needed by PDF viewers.
+var tablesKeptInSubset = []string{"OS/2", "cvt ", "fpgm", "prep"}
+
+// postVersion3 is the version of a post table that has no glyph names.
+const postVersion3 = 0x00030000
+
+// glyphData returns the outline data for a glyph from the glyf table.
+func (f *sfnt) glyphData(g uint16) ([]byte, error) {
+	head, loca, glyf := f.tables["head"], f.tables["loca"], f.tables["glyf"]
+	if loca == nil || glyf == nil {
+		return nil, errFontMalformed
+	}
+	var start, end int
+	if i16(head, 50) == 0 {
+		if len(loca) < 2*int(g)+4 {
+			return nil, errFontMalformed
+		}
+		start, end = 2*int(u16(loca, 2*int(g))), 2*int(u16(loca, 2*int(g)+2))
+	} else {
+		if len(loca) < 4*int(g)+8 {
+			return nil, errFontMalformed
+		}
+		start, end = int(binary.BigEndian.Uint32(loca[4*int(g):])), int(binary.BigEndian.Uint32(loca[4*int(g)+4:]))
+	}
+	if start > end || end > len(glyf) {
+		return nil, errFontMalformed
+	}
+	return glyf[start:end], nil
+}
+
+// glyphComponents returns the glyphs referenced by a composite glyph.
+func glyphComponents(data []byte) ([]uint16, error) {
+	if len(data) < 10 || i16(data, 0) >= 0 {
+		return nil, nil
+	}
+	var components []uint16
+	for off := 10; ; {
+		if off+4 > len(data) {
+			return nil, errFontMalformed
+		}
+		flags := u16(data, off)
+		components = append(components, u16(data, off+2))
+		off += 4
+		if flags&compositeArgsAreWords != 0 {
+			off += 4
+		} else {
+			off += 2
+		}
+		switch {
+		case flags&compositeHaveScale != 0:
+			off += 2
+		case flags&compositeHaveXYScale != 0:
+			off += 4
+		case flags&compositeHaveTwoByTwo != 0:
+			off += 8
+		}
+		if flags&compositeMoreComponents == 0 {
+			return components, nil
+		}
+	}
+}
+
+// closeGlyphSet adds the notdef glyph and the components of every composite
+// glyph in the set.
+func (f *sfnt) closeGlyphSet(glyphs glyphSet) error {
+	glyphs[0] = true
+	if f.isCFF() {
+		return nil
+	}
+	queue := glyphs.sorted()
+	for len(queue) > 0 {
+		g := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		data, err := f.glyphData(g)
+		if err != nil {
+			return err
+		}
+		components, err := glyphComponents(data)
+		if err != nil {
+			return err
+		}
+		for _, c := range components {
+			if int(c) < f.numGlyphs && !glyphs[c] {
+				glyphs[c] = true
+				queue = append(queue, c)
+			}
+		}
+	}
+	return nil
+}
+
+// subsetTrueType returns a TrueType font program that only contains the given
+// glyphs, which must already be closed under composition.  Glyph indices are
+// preserved.  The cmap maps character codes to glyphs and is written as a
+// format 4 subtable for the given platform encoding.
+func (f *sfnt) subsetTrueType(glyphs glyphSet, cmap map[rune]uint16, cmapEncoding uint16) ([]byte, error) {
+	numGlyphs := 0
+	for g := range glyphs {
+		if int(g) >= f.numGlyphs {
+			continue
+		}
+		if int(g)+1 > numGlyphs {
+			numGlyphs = int(g) + 1
+		}
+	}
+
+	// Build glyf and loca tables.
+	var glyf []byte
+	offsets := make([]int, numGlyphs+1)
+	for g := 0; g < numGlyphs; g++ {
+		offsets[g] = len(glyf)
+		if !glyphs[uint16(g)] {
+			continue
+		}
+		data, err := f.glyphData(uint16(g))
+		if err != nil {
+			return nil, err
+		}
+		glyf = append(glyf, data...)
+		for len(glyf)%4 != 0 {
+			glyf = append(glyf, 0)
+		}
+	}
+	offsets[numGlyphs] = len(glyf)
+	var loca []byte
+	var locaFormat int16
+	if len(glyf) <= 0x1fffe {
+		loca = make([]byte, 2*len(offsets))
+		for i, off := range offsets {
+			binary.BigEndian.PutUint16(loca[2*i:], uint16(off/2))
+		}
+	} else {
+		locaFormat = 1
+		loca = make([]byte, 4*len(offsets))
+		for i, off := range offsets {
+			binary.BigEndian.PutUint32(loca[4*i:], uint32(off))
+		}
+	}
+
+	// Truncate the horizontal metrics to the new glyph count.
+	numHMetrics := f.numHMetrics
+	if numHMetrics > numGlyphs {
+		numHMetrics = numGlyphs
+	}
+	hmtx := make([]byte, 4*numHMetrics+2*(numGlyphs-numHMetrics))
+	copy(hmtx, f.tables["hmtx"])
+
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)
+	binary.BigEndian.PutUint16(head[50:], uint16(locaFormat))
+	hhea := append([]byte(nil), f.tables["hhea"]...)
+	binary.BigEndian.PutUint16(hhea[34:], uint16(numHMetrics))
+	maxp := append([]byte(nil), f.tables["maxp"]...)
+	binary.BigEndian.PutUint16(maxp[4:], uint16(numGlyphs))
+
+	tables := map[string][]byte{
+		"cmap": buildCmap(cmap, cmapEncoding),
+		"glyf": glyf,
+		"head": head,
+		"hhea": hhea,
+		"hmtx": hmtx,
+		"loca": loca,
+		"maxp": maxp,
+	}
+	f.copySubsetTables(tables)
+	return buildSFNT(sfntVersionTrueType, tables), nil
+}
+
+// subsetOpenTypeCFF returns an OpenType font program with CFF outlines that
+// only contains the given glyphs.  Glyph indices are preserved.  The cmap maps
+// Unicode characters to glyphs.
+func (f *sfnt) subsetOpenTypeCFF(glyphs glyphSet, cmap map[rune]uint16) ([]byte, error) {
+	cff, err := subsetCFF(f.tables["CFF "], glyphs)
+	if err != nil {
+		return nil, err
+	}
+	head := append([]byte(nil), f.tables["head"]...)
+	binary.BigEndian.PutUint32(head[8:], 0)
+	tables := map[string][]byte{
+		"CFF ": cff,
+		"cmap": buildCmap(cmap, cmapEncodingUnicode),
+		"head": head,
+		"hhea": f.tables["hhea"],
+		"hmtx": f.tables["hmtx"],
+		"maxp": f.tables["maxp"],
+	}
+	f.copySubsetTables(tables)
+	return buildSFNT(sfntVersionCFF, tables), nil
+}
+
+// copySubsetTables adds the tables that are carried over unchanged into a
+// subset font.
+func (f *sfnt) copySubsetTables(tables map[string][]byte) {
+	if post := f.tables["post"]; len(post) >= 32 {
+		// Glyph names are not needed, so only keep the header.
+		post = append([]byte(nil), post[:32]...)
+		binary.BigEndian.PutUint32(post, postVersion3)
+		tables["post"] = post
+	}
+	for _, tag := range tablesKeptInSubset {
+		if t, ok := f.tables[tag]; ok {
+			tables[tag] = t
+		}
+	}
+}
+
+// buildCmap returns a cmap table with a single format 4 subtable for the
+// Windows platform.  Characters outside the Basic Multilingual Plane are
+// ignored.
+func buildCmap(m map[rune]uint16, encoding uint16) []byte {
+	codes := make([]int, 0, len(m))
+	for r := range m {
+		if r >= 0 && r < 0xffff {
+			codes = append(codes, int(r))
+		}
+	}
+	sort.Ints(codes)
+
+	// Each code gets its own segment unless it continues a run of
+	// consecutive codes and glyphs.
+	type segment struct {
+		start, end int
+		delta      uint16
+	}
+	var segs []segment
+	for _, c := range codes {
+		delta := m[rune(c)] - uint16(c)
+		if n := len(segs); n > 0 && segs[n-1].end == c-1 && segs[n-1].delta == delta {
+			segs[n-1].end = c
+		} else {
+			segs = append(segs, segment{c, c, delta})
+		}
+	}
+	segs = append(segs, segment{0xffff, 0xffff, 1})
+
+	segCount := len(segs)
+	sub := make([]byte, 16+8*segCount)
+	binary.BigEndian.PutUint16(sub[0:], 4)
+	binary.BigEndian.PutUint16(sub[2:], uint16(len(sub)))
+	binary.BigEndian.PutUint16(sub[6:], uint16(2*segCount))
+	searchRange, entrySelector := 2, 0
+	for searchRange*2 <= 2*segCount {
+		searchRange *= 2
+		entrySelector++
+	}
+	binary.BigEndian.PutUint16(sub[8:], uint16(searchRange))
+	binary.BigEndian.PutUint16(sub[10:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(sub[12:], uint16(2*segCount-searchRange))
+	for i, s := range segs {
+		binary.BigEndian.PutUint16(sub[14+2*i:], uint16(s.end))
+		binary.BigEndian.PutUint16(sub[16+2*segCount+2*i:], uint16(s.start))
+		binary.BigEndian.PutUint16(sub[16+4*segCount+2*i:], s.delta)
+	}
+
+	tab := make([]byte, 12, 12+len(sub))
+	binary.BigEndian.PutUint16(tab[2:], 1)
+	binary.BigEndian.PutUint16(tab[4:], 3)
+	binary.BigEndian.PutUint16(tab[6:], encoding)
+	binary.BigEndian.PutUint32(tab[8:], 12)
+	return append(tab, sub...)
+}
+
+// buildSFNT assembles a font file from its tables.
+func buildSFNT(version uint32, tables map[string][]byte) []byte {
+	tags := make([]string, 0, len(tables))
+	for tag := range tables {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	n := len(tags)
+	searchRange, entrySelector := 1, 0
+	for searchRange*2 <= n {
+		searchRange *= 2
+		entrySelector++
+	}
+	out := make([]byte, 12+16*n)
+	binary.BigEndian.PutUint32(out[0:], version)
+	binary.BigEndian.PutUint16(out[4:], uint16(n))
+	binary.BigEndian.PutUint16(out[6:], uint16(16*searchRange))
+	binary.BigEndian.PutUint16(out[8:], uint16(entrySelector))
+	binary.BigEndian.PutUint16(out[10:], uint16(16*(n-searchRange)))
+	headOffset := -1
+	for i, tag := range tags {
+		t := tables[tag]
+		rec := out[12+16*i:]
+		copy(rec, tag)
+		binary.BigEndian.PutUint32(rec[4:], tableChecksum(t))
+		binary.BigEndian.PutUint32(rec[8:], uint32(len(out)))
+		binary.BigEndian.PutUint32(rec[12:], uint32(len(t)))
+		if tag == "head" {
+			headOffset = len(out)
+		}
+		out = append(out, t...)
+		for len(out)%4 != 0 {
+			out = append(out, 0)
+		}
+	}
+	if headOffset >= 0 {
+		binary.BigEndian.PutUint32(out[headOffset+8:], 0xb1b0afba-tableChecksum(out))
+	}
+	return out
+}
+
+// tableChecksum computes the checksum of a table as defined by the TrueType
+// specification.
+func tableChecksum(b []byte) uint32 {
+	var sum uint32
+	for i := 0; i < len(b); i += 4 {
+		var word [4]byte
+		copy(word[:], b[i:])
+		sum += binary.BigEndian.Uint32(word[:])
+	}
+	return sum
+}
@@ -4,39 +4,421 @@ package pdf
 
 import (
 	"bytes"
+	"math"
+	"strings"
 )
 
 // Text is a PDF text object.  The zero value is an empty text object.
 type Text struct {
 	buf   bytes.Buffer
 	fonts map[name]bool
+	faces map[name]Font
 
-	x, y        Unit
-	currFont    name
+	colorSpaces map[name]colorSpace
+
+	// lineMatrix is the text line matrix, which is the identity matrix if
+	// lineMatrixSet is false.  lineX and lineY are the distances that the
+	// text cursor has advanced to the right and downward along the line.
+	lineMatrix    Matrix
+	lineMatrixSet bool
+	lineX         Unit
+	lineY         Unit
+
+	currFont    Font
 	currSize    Unit
+	shownFont   Font // font selected by the last Tf operator
 	currLeading Unit
+	kerning     bool
+	charSpacing Unit
+	wordSpacing Unit
+
+	// horizScale is only meaningful if horizScaleSet is true, so that the
+	// zero value of Text has the default scale of 1.
+	horizScale    float32
+	horizScaleSet bool
+
+	direction Direction
+	mode      WritingMode
+	rise      Unit
 }
 
-// Text adds a string to the text object.
+// Text adds a string to the text object.  The string is converted to the
+// current font's encoding, and characters that cannot be encoded are replaced
+// with a question mark.
+//
+// Right-to-left and mixed-direction strings are put in the order that they are
+// displayed using the Unicode Bidirectional Algorithm, treating s as a single
+// line in the text object's direction.  Mirrored characters such as brackets
+// are flipped in right-to-left text.
+//
+// Text in a font added with AddUnicodeFont is shaped with the font's OpenType
+// layout tables: ligatures, contextual forms such as the joining forms of
+// Arabic letters, Indic conjuncts and mark positioning are applied as the font
+// describes them.
 func (text *Text) Text(s string) {
-	writeCommand(&text.buf, "Tj", s)
-	if widths := getFontWidths(text.currFont); widths != nil {
-		text.x += computeStringWidth(s, widths, text.currSize)
+	if !bidiNeeded(s, text.direction) {
+		text.show(s, false)
+		return
+	}
+	p := newBidiParagraph(s, text.direction)
+	for _, run := range p.runs(0, len(p.text)) {
+		text.show(p.runText(run), run.rtl)
+	}
+}
+
+// SetDirection sets the base direction of the text object's lines, which is
+// used to order mixed-direction text.  The default is DirectionAuto.  A
+// Paragraph drawn in the text object uses the same direction.
+func (text *Text) SetDirection(dir Direction) {
+	text.direction = dir
+}
+
+// SetWritingMode changes whether the following text is written horizontally
+// or vertically.  The default is HorizontalWriting.  In vertical writing, the
+// text cursor moves down by each character's advance height, so Y changes
+// instead of X.
+func (text *Text) SetWritingMode(mode WritingMode) {
+	// The font for the new mode is selected when text is shown.
+	text.mode = mode
+}
+
+// writingFont returns the font that shows text in font with the current
+// writing mode.
+func (text *Text) writingFont(font Font) Font {
+	if cf, ok := font.(*compositeFont); ok && text.mode == VerticalWriting {
+		return cf.verticalFont()
+	}
+	return font
+}
+
+// show adds a string to the text object.  If rtl is true, s is right-to-left
+// text in logical order, which is displayed reversed.
+func (text *Text) show(s string, rtl bool) {
+	if text.currFont == nil {
+		if rtl {
+			s = reverseString(s)
+		}
+		writeCommand(&text.buf, "Tj", s)
+		return
+	}
+	fam, ok := text.currFont.(*FontFamily)
+	if !ok {
+		font := text.writingFont(text.currFont)
+		text.selectFont(font)
+		text.showFont(font, s, rtl)
+		return
+	}
+	runs := fam.runs(s)
+	if rtl {
+		for a, b := 0, len(runs)-1; a < b; a, b = a+1, b-1 {
+			runs[a], runs[b] = runs[b], runs[a]
+		}
+	}
+	for _, run := range runs {
+		font := text.writingFont(run.font)
+		text.selectFont(font)
+		text.showFont(font, run.s, rtl)
+	}
+}
+
+// showFont adds a string to the text object in a single font, which must be
+// the selected font.
+func (text *Text) showFont(font Font, s string, rtl bool) {
+	codes, adjust, rise := text.layout(font, text.currSize, s, rtl)
+	if font, ok := font.(embeddedFont); ok {
+		font.useCodes(codes)
+	}
+
+	// Glyphs that are raised or lowered from the baseline are shown
+	// separately with their own text rise.
+	start, dy, shown := 0, 0, 0
+	for i := 0; i <= len(rise); i++ {
+		end := len(codes)
+		if i < len(rise) {
+			end = rise[i].index
+		}
+		if end > start {
+			if dy != shown {
+				writeCommand(&text.buf, "Ts", text.rise+Unit(dy)*text.currSize/1000)
+				shown = dy
+			}
+			var segment []kernAdjustment
+			for _, a := range adjust {
+				if a.index >= start && (a.index < end || end == len(codes)) {
+					segment = append(segment, kernAdjustment{a.index - start, a.amount})
+				}
+			}
+			text.showCodes(font, codes[start:end], segment)
+			start = end
+		}
+		if i < len(rise) {
+			dy = rise[i].amount
+		}
+	}
+	if shown != 0 {
+		writeCommand(&text.buf, "Ts", text.rise)
+	}
+	if _, ok := font.(*verticalFont); ok {
+		text.lineY += text.advance(font, text.currSize, codes, adjust)
+	} else {
+		text.lineX += text.advance(font, text.currSize, codes, adjust)
+	}
+}
+
+// showCodes writes the operator that shows a string of character codes in a
+// font with positioning adjustments.
+func (text *Text) showCodes(font Font, codes string, adjust []kernAdjustment) {
+	if len(adjust) == 0 {
+		writeCommand(&text.buf, "Tj", showString(font, codes))
+	} else {
+		writeCommand(&text.buf, "TJ", showKerned(font, codes, adjust))
+	}
+}
+
+// layout converts a string to the character codes that show it in a font,
+// along with the positioning adjustments and changes in rise that are made when
+// it is shown with the current text state.  If rtl is true, s is right-to-left
+// text in logical order, and the codes are returned in the order that they are
+// displayed.
+func (text *Text) layout(font Font, size Unit, s string, rtl bool) (codes string, adjust, rise []kernAdjustment) {
+	if sf, ok := font.(shapingFont); ok {
+		codes, adjust, rise = sf.shape(s, rtl, text.kerning)
+	} else {
+		if rtl {
+			s = reverseString(s)
+		}
+		codes = font.encode(s)
+		if kf, ok := font.(kerningFont); ok && text.kerning {
+			adjust = kf.kern(codes)
+		}
+	}
+	if cf, ok := font.(*compositeFont); ok && text.wordSpacing != 0 {
+		// Word spacing only applies to the single-byte code 32, so it is
+		// emulated for composite fonts by adjusting after each space.
+		amount := int(math.Floor(float64(text.wordSpacing*1000/size) + 0.5))
+		adjust = mergeAdjustments(adjust, cf.spaceAdjustments(codes, amount))
+	}
+	return codes, adjust, rise
+}
+
+// reverseString returns the characters of s in reverse order.
+func reverseString(s string) string {
+	r := []rune(s)
+	for a, b := 0, len(r)-1; a < b; a, b = a+1, b-1 {
+		r[a], r[b] = r[b], r[a]
+	}
+	return string(r)
+}
+
+// advance returns the distance that the text cursor moves when a string of
+// character codes is shown in a font with the given adjustments.
+func (text *Text) advance(font Font, size Unit, codes string, adjust []kernAdjustment) Unit {
+	if _, ok := font.(*verticalFont); ok {
+		// Vertical displacements are negative, so character spacing
+		// moves the cursor back up.  The horizontal scale does not
+		// apply.
+		return font.stringWidth(codes, size) - text.charSpacing*Unit(len(codes)/2)
+	}
+	w := font.stringWidth(codes, size)
+	for _, a := range adjust {
+		w += Unit(a.amount) * size / 1000
+	}
+	if _, ok := font.(*compositeFont); ok {
+		w += text.charSpacing * Unit(len(codes)/2)
+	} else {
+		w += text.charSpacing * Unit(len(codes))
+		w += text.wordSpacing * Unit(strings.Count(codes, " "))
+	}
+	return w * Unit(text.horizontalScale())
+}
+
+// measure returns the width of s in a font, as it would be shown by Text with
+// the current text state.
+func (text *Text) measure(font Font, size Unit, s string) Unit {
+	if fam, ok := font.(*FontFamily); ok {
+		var w Unit
+		for _, run := range fam.runs(s) {
+			w += text.measure(run.font, size, run.s)
+		}
+		return w
+	}
+	codes, adjust, _ := text.layout(font, size, s, false)
+	return text.advance(font, size, codes, adjust)
+}
+
+// SetCharSpacing changes the extra space added after each character.
+// Negative values bring characters closer together.
+func (text *Text) SetCharSpacing(tc Unit) {
+	writeCommand(&text.buf, "Tc", tc)
+	text.charSpacing = tc
+}
+
+// SetWordSpacing changes the extra space added after each space character,
+// in addition to the character spacing.
+func (text *Text) SetWordSpacing(tw Unit) {
+	writeCommand(&text.buf, "Tw", tw)
+	text.wordSpacing = tw
+}
+
+// SetHorizontalScale stretches or compresses characters horizontally.  A scale
+// of 1 is the normal width, and 0.5 makes characters half as wide.
+func (text *Text) SetHorizontalScale(scale float32) {
+	writeCommand(&text.buf, "Tz", scale*100)
+	text.horizScale, text.horizScaleSet = scale, true
+}
+
+// horizontalScale returns the current horizontal scale.
+func (text *Text) horizontalScale() float32 {
+	if !text.horizScaleSet {
+		return 1
 	}
+	return text.horizScale
+}
+
+// SetRise moves the baseline of the following text up from the current line,
+// which is useful for superscripts and subscripts.  Negative values move the
+// baseline down.  The rise does not change the position of the text cursor.
+func (text *Text) SetRise(rise Unit) {
+	writeCommand(&text.buf, "Ts", rise)
+	text.rise = rise
+}
+
+// TextRenderMode determines how the glyphs of a text object are painted.
+type TextRenderMode int
+
+// Text render modes.  The clipping modes add the glyph outlines to the clipping
+// path of the canvas, which stays in effect until the canvas state is popped.
+const (
+	RenderFill TextRenderMode = iota
+	RenderStroke
+	RenderFillStroke
+	RenderInvisible
+	RenderFillClip
+	RenderStrokeClip
+	RenderFillStrokeClip
+	RenderClip
+)
+
+// SetRenderMode changes how the following text is painted.  The default mode
+// is RenderFill.
+func (text *Text) SetRenderMode(mode TextRenderMode) {
+	writeCommand(&text.buf, "Tr", int(mode))
+}
+
+// SetKerning changes whether text is kerned.  When kerning is enabled, text in
+// fonts with kerning information is shown with the space between pairs of
+// characters adjusted.  Kerning is disabled by default.
+func (text *Text) SetKerning(kern bool) {
+	text.kerning = kern
+}
+
+// showString returns the string object that shows a string of character codes
+// in the given font.  Composite fonts use binary codes, so their strings are
+// written in hexadecimal.
+func showString(font Font, codes string) interface{} {
+	switch font.(type) {
+	case *compositeFont, *verticalFont:
+		return hexString(codes)
+	}
+	return codes
+}
+
+// mergeAdjustments combines two lists of adjustments that are sorted by index.
+func mergeAdjustments(a, b []kernAdjustment) []kernAdjustment {
+	merged := make([]kernAdjustment, 0, len(a)+len(b))
+	for len(a) > 0 || len(b) > 0 {
+		var next kernAdjustment
+		switch {
+		case len(b) == 0 || len(a) > 0 && a[0].index < b[0].index:
+			next, a = a[0], a[1:]
+		case len(a) == 0 || b[0].index < a[0].index:
+			next, b = b[0], b[1:]
+		default:
+			next = kernAdjustment{a[0].index, a[0].amount + b[0].amount}
+			a, b = a[1:], b[1:]
+		}
+		merged = append(merged, next)
+	}
+	return merged
+}
+
+// showKerned returns the array of strings and positioning adjustments that
+// shows a string of character codes with kerning.
+func showKerned(font Font, codes string, adjust []kernAdjustment) []interface{} {
+	array := make([]interface{}, 0, 2*len(adjust)+1)
+	start := 0
+	for _, a := range adjust {
+		// TJ adjustments are subtracted from the position, so they have
+		// the opposite sign.
+		if a.index > start {
+			array = append(array, showString(font, codes[start:a.index]))
+		}
+		array = append(array, -a.amount)
+		start = a.index
+	}
+	if start < len(codes) {
+		array = append(array, showString(font, codes[start:]))
+	}
+	return array
 }
 
 const defaultLeadingScalar = 1.2
 
 // SetFont changes the current font to a standard font.  This also changes the
-// leading to 1.2 times the font size.
+// leading to 1.2 times the font size.  Text shown in a standard font is encoded
+// with WinAnsiEncoding, except for Symbol and ZapfDingbats, which use their
+// built-in encodings.
 func (text *Text) SetFont(fontName string, size Unit) {
+	text.SetFontFace(StandardFont(fontName), size)
+}
+
+// SetFontFace changes the current font.  This also changes the leading to 1.2
+// times the font size.  If font is a FontFamily, its primary font is selected
+// and the text object switches to its fallback fonts as needed.
+func (text *Text) SetFontFace(font Font, size Unit) {
+	text.currFont, text.currSize = font, size
+	if fam, ok := font.(*FontFamily); ok {
+		font = fam.fonts[0]
+	}
+	text.shownFont = nil
+	if _, ok := font.(*compositeFont); !ok {
+		// Composite fonts have a different font for each writing mode,
+		// so they are selected when text is shown.
+		text.selectFont(font)
+	}
+	text.SetLeading(size * defaultLeadingScalar)
+}
+
+// selectFont makes font the font that text is shown in at the current size,
+// if it is not already.
+func (text *Text) selectFont(font Font) {
+	if font == text.shownFont {
+		return
+	}
 	if text.fonts == nil {
 		text.fonts = make(map[name]bool)
+		text.faces = make(map[name]Font)
 	}
-	text.fonts[name(fontName)] = true
-	text.currFont, text.currSize = name(fontName), size
-	writeCommand(&text.buf, "Tf", name(fontName), size)
-	text.SetLeading(size * defaultLeadingScalar)
+	fontName := font.resourceName()
+	text.fonts[fontName] = true
+	text.faces[fontName] = font
+	text.shownFont = font
+	writeCommand(&text.buf, "Tf", fontName, text.currSize)
+}
+
+// SetFillColor changes the color that the following text is painted with.  It
+// returns an error, and leaves the color unchanged, if c cannot be painted.
+func (text *Text) SetFillColor(c Color) error {
+	if err := c.check(); err != nil {
+		return err
+	}
+	if cs := c.colorSpace(); cs != nil {
+		if text.colorSpaces == nil {
+			text.colorSpaces = make(map[name]colorSpace)
+		}
+		text.colorSpaces[cs.resourceName()] = cs
+	}
+	return c.writeFill(&text.buf)
 }
 
 // SetLeading changes the amount of space between lines.
@@ -49,26 +431,84 @@ func (text *Text) SetLeading(leading Unit) {
 // current leading.
 func (text *Text) NextLine() {
 	writeCommand(&text.buf, "T*")
-	text.x = 0
-	text.y -= text.currLeading
+	text.moveLine(0, -text.currLeading)
 }
 
 // NextLineOffset moves the current text position to an offset relative to the
 // beginning of the line.
 func (text *Text) NextLineOffset(tx, ty Unit) {
 	writeCommand(&text.buf, "Td", tx, ty)
-	text.x = tx
-	text.y += ty
+	text.moveLine(tx, ty)
+}
+
+// moveLine starts a new line at an offset from the start of the current line.
+func (text *Text) moveLine(tx, ty Unit) {
+	m := text.matrix()
+	m[4] += m[0]*float32(tx) + m[2]*float32(ty)
+	m[5] += m[1]*float32(tx) + m[3]*float32(ty)
+	text.lineMatrix, text.lineMatrixSet = m, true
+	text.lineX, text.lineY = 0, 0
+}
+
+// matrix returns the text line matrix.
+func (text *Text) matrix() Matrix {
+	if !text.lineMatrixSet {
+		return IdentityMatrix
+	}
+	return text.lineMatrix
 }
 
-// X returns the current x position of the text cursor.
+// SetMatrix replaces the text matrix, which maps text space to the canvas's
+// coordinate system, and starts a new line at the new origin.  Unlike
+// Canvas.Transform, the matrix is not concatenated with the current text
+// matrix.  The arguments map to values in the matrix as shown below:
+//
+//  / a b 0 \
+//  | c d 0 |
+//  \ e f 1 /
+//
+// For more information, see Section 9.4.2 of ISO 32000-1.
+func (text *Text) SetMatrix(a, b, c, d, e, f float32) {
+	writeCommand(&text.buf, "Tm", a, b, c, d, e, f)
+	text.lineMatrix, text.lineMatrixSet = Matrix{a, b, c, d, e, f}, true
+	text.lineX, text.lineY = 0, 0
+}
+
+// SetPosition moves the text cursor to an absolute position and removes any
+// rotation, skew or scaling from the text matrix.
+func (text *Text) SetPosition(x, y Unit) {
+	text.SetMatrix(1, 0, 0, 1, float32(x), float32(y))
+}
+
+// SetRotatedPosition moves the text cursor to an absolute position and rotates
+// the following lines of text counterclockwise by an angle (in radians) around
+// that position.
+func (text *Text) SetRotatedPosition(x, y Unit, theta float32) {
+	s, c := math.Sin(float64(theta)), math.Cos(float64(theta))
+	text.SetMatrix(float32(c), float32(s), float32(-s), float32(c), float32(x), float32(y))
+}
+
+// FontMetrics returns the metrics of the current font at the current font
+// size.  It returns the zero value if no font has been set.
+func (text *Text) FontMetrics() FontMetrics {
+	if text.currFont == nil {
+		return FontMetrics{}
+	}
+	return text.currFont.Metrics().Scale(text.currSize)
+}
+
+// X returns the current x position of the text cursor in the canvas's
+// coordinate system.
 func (text *Text) X() Unit {
-	return text.x
+	m := text.matrix()
+	return Unit(m[4]) + Unit(m[0])*text.lineX - Unit(m[2])*text.lineY
 }
 
-// Y returns the current y position of the text cursor.
+// Y returns the current y position of the text cursor in the canvas's
+// coordinate system.
 func (text *Text) Y() Unit {
-	return text.y
+	m := text.matrix()
+	return Unit(m[5]) + Unit(m[1])*text.lineX - Unit(m[3])*text.lineY
 }
 
 // Standard 14 fonts
@@ -127,11 +567,77 @@ func getFontWidths(fontName name) []uint16 {
 	return nil
 }
 
-func computeStringWidth(s string, widths []uint16, fontSize Unit) Unit {
+// getFontMetrics returns the metrics of a standard font.
+func getFontMetrics(fontName name) FontMetrics {
+	switch fontName {
+	case Courier:
+		return courierMetrics
+	case CourierBold:
+		return courierBoldMetrics
+	case CourierOblique:
+		return courierObliqueMetrics
+	case CourierBoldOblique:
+		return courierBoldObliqueMetrics
+	case Helvetica:
+		return helveticaMetrics
+	case HelveticaBold:
+		return helveticaBoldMetrics
+	case HelveticaOblique:
+		return helveticaObliqueMetrics
+	case HelveticaBoldOblique:
+		return helveticaBoldObliqueMetrics
+	case Symbol:
+		return symbolMetrics
+	case Times:
+		return timesRomanMetrics
+	case TimesBold:
+		return timesBoldMetrics
+	case TimesItalic:
+		return timesItalicMetrics
+	case TimesBoldItalic:
+		return timesBoldItalicMetrics
+	case ZapfDingbats:
+		return zapfDingbatsMetrics
+	}
+	return FontMetrics{}
+}
+
+// kernPair is a pair of adjacent character codes.
+type kernPair struct {
+	left, right byte
+}
+
+// getFontKerning returns the kerning table of a standard font, or nil if the
+// font is not kerned.  The values are in thousandths of an em.
+func getFontKerning(fontName name) map[kernPair]int16 {
+	switch fontName {
+	case Helvetica:
+		return helveticaKerning
+	case HelveticaBold:
+		return helveticaBoldKerning
+	case HelveticaOblique:
+		return helveticaObliqueKerning
+	case HelveticaBoldOblique:
+		return helveticaBoldObliqueKerning
+	case Times:
+		return timesRomanKerning
+	case TimesBold:
+		return timesBoldKerning
+	case TimesItalic:
+		return timesItalicKerning
+	case TimesBoldItalic:
+		return timesBoldItalicKerning
+	}
+	return nil
+}
+
+// computeStringWidth returns the width of a string of single-byte character
+// codes.
+func computeStringWidth(codes string, widths []uint16, fontSize Unit) Unit {
 	width := Unit(0)
-	for _, r := range s {
-		if int(r) < len(widths) {
-			width += Unit(widths[r])
+	for i := 0; i < len(codes); i++ {
+		if c := codes[i]; int(c) < len(widths) {
+			width += Unit(widths[c])
 		}
 	}
 	return width * fontSize / 1000
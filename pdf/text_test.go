@@ -4,6 +4,7 @@ package pdf
 
 import (
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -97,3 +98,142 @@ func TestTextY(t *testing.T) {
 		t.Errorf("NextLineOffset does not set Y correctly, y = %.5f (expected %.5f)", text.Y(), -50.130)
 	}
 }
+
+func TestTextWinAnsi(t *testing.T) {
+	text := new(Text)
+	text.SetFont(Helvetica, 10)
+	text.Text("café €5")
+
+	const wantOutput = "/Helvetica 10.00000 Tf\n12.00000 TL\n(caf\xe9 \x805) Tj\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+	// c + a + f + eacute + space + Euro + five
+	const wantX = (500 + 556 + 278 + 556 + 278 + 556 + 556) * 10 / 1000.0
+	if !floatEq(float64(text.X()), wantX, 1e-4) {
+		t.Errorf("\"café €5\" has wrong X (=%.5f) when %.5f is desired", text.X(), wantX)
+	}
+}
+
+func TestStandardFontEncoding(t *testing.T) {
+	doc := New()
+	ref := doc.standardFont(Times)
+	if dict := doc.objects[ref.Number-1].(standardFontDict); dict.Encoding != winAnsiEncoding {
+		t.Errorf("%s Encoding = %q; want %q", Times, dict.Encoding, winAnsiEncoding)
+	}
+	ref = doc.standardFont(Symbol)
+	if dict := doc.objects[ref.Number-1].(standardFontDict); dict.Encoding != "" {
+		t.Errorf("%s Encoding = %q; want none", Symbol, dict.Encoding)
+	}
+}
+
+func TestTextKerning(t *testing.T) {
+	text := new(Text)
+	text.SetFont(Helvetica, 10)
+	text.SetKerning(true)
+	text.Text("AVA.")
+
+	const wantOutput = "/Helvetica 10.00000 Tf\n12.00000 TL\n[ (A) 70 (V) 80 (A.) ] TJ\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+	// A + V + A + period - (70 + 80)
+	const wantX = (667 + 667 + 667 + 278 - 150) * 10 / 1000.0
+	if !floatEq(float64(text.X()), wantX, 1e-4) {
+		t.Errorf("\"AVA.\" has wrong X (=%.5f) when %.5f is desired", text.X(), wantX)
+	}
+
+	text.SetKerning(false)
+	text.NextLine()
+	text.Text("AV")
+	if !floatEq(float64(text.X()), (667+667)*10/1000.0, 1e-4) {
+		t.Errorf("unkerned \"AV\" has wrong X (=%.5f)", text.X())
+	}
+}
+
+func TestTextFontMetrics(t *testing.T) {
+	text := new(Text)
+	if m := text.FontMetrics(); m != (FontMetrics{}) {
+		t.Errorf("FontMetrics() with no font = %+v; want zero", m)
+	}
+
+	text.SetFont(Helvetica, 10)
+	m := text.FontMetrics()
+	want := FontMetrics{
+		Ascender:  7.18,
+		Descender: -2.07,
+		CapHeight: 7.18,
+		XHeight:   5.23,
+		FontBBox:  Rectangle{Point{-1.66, -2.25}, Point{10, 9.31}},
+	}
+	if !floatEq(float64(m.Ascender), float64(want.Ascender), 1e-4) ||
+		!floatEq(float64(m.Descender), float64(want.Descender), 1e-4) ||
+		!floatEq(float64(m.CapHeight), float64(want.CapHeight), 1e-4) ||
+		!floatEq(float64(m.XHeight), float64(want.XHeight), 1e-4) ||
+		!floatEq(float64(m.FontBBox.Min.X), float64(want.FontBBox.Min.X), 1e-4) ||
+		!floatEq(float64(m.FontBBox.Max.Y), float64(want.FontBBox.Max.Y), 1e-4) {
+		t.Errorf("Helvetica 10pt metrics = %+v; want %+v", m, want)
+	}
+
+	text.SetFont(TimesItalic, 10)
+	if m := text.FontMetrics(); m.ItalicAngle != -15.5 {
+		t.Errorf("Times-Italic ItalicAngle = %v; want -15.5", m.ItalicAngle)
+	}
+}
+
+func TestTextState(t *testing.T) {
+	text := new(Text)
+	text.SetFont(Helvetica, 10)
+	text.SetCharSpacing(1)
+	text.SetWordSpacing(2)
+	text.SetHorizontalScale(0.5)
+	text.SetRise(3)
+	text.SetRenderMode(RenderStrokeClip)
+	text.Text("Hi there")
+
+	const wantOutput = "/Helvetica 10.00000 Tf\n12.00000 TL\n" +
+		"1.00000 Tc\n2.00000 Tw\n50.00000 Tz\n3.00000 Ts\n5 Tr\n(Hi there) Tj\n"
+	if text.buf.String() != wantOutput {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), wantOutput)
+	}
+	// Glyph widths, plus 8 characters of character spacing and 1 space of word
+	// spacing, scaled by half.
+	wantX := (MeasureString(StandardFont(Helvetica), 10, "Hi there") + 8*1 + 1*2) / 2
+	if !floatEq(float64(text.X()), float64(wantX), 1e-4) {
+		t.Errorf("X() = %.5f; want %.5f", text.X(), wantX)
+	}
+	if text.Y() != 0 {
+		t.Errorf("Y() = %.5f; rise should not move the cursor", text.Y())
+	}
+}
+
+func TestTextMatrix(t *testing.T) {
+	text := new(Text)
+	text.SetFont(Helvetica, 12)
+	text.SetPosition(100, 200)
+	text.Text("Hello!")
+	if !floatEq(float64(text.X()), 130.672, 1e-4) || !floatEq(float64(text.Y()), 200, 1e-4) {
+		t.Errorf("after SetPosition, cursor at (%.5f, %.5f); want (130.67200, 200.00000)", text.X(), text.Y())
+	}
+	text.NextLineOffset(10, -20)
+	if !floatEq(float64(text.X()), 110, 1e-4) || !floatEq(float64(text.Y()), 180, 1e-4) {
+		t.Errorf("after NextLineOffset, cursor at (%.5f, %.5f); want (110.00000, 180.00000)", text.X(), text.Y())
+	}
+
+	text.SetRotatedPosition(50, 50, math.Pi/2)
+	text.Text("Hello!")
+	if !floatEq(float64(text.X()), 50, 1e-4) || !floatEq(float64(text.Y()), 80.672, 1e-4) {
+		t.Errorf("after rotated text, cursor at (%.5f, %.5f); want (50.00000, 80.67200)", text.X(), text.Y())
+	}
+	text.NextLine()
+	if !floatEq(float64(text.X()), 64.4, 1e-4) || !floatEq(float64(text.Y()), 50, 1e-4) {
+		t.Errorf("after rotated NextLine, cursor at (%.5f, %.5f); want (64.40000, 50.00000)", text.X(), text.Y())
+	}
+
+	const wantOutput = "/Helvetica 12.00000 Tf\n14.40000 TL\n" +
+		"1.00000 0.00000 0.00000 1.00000 100.00000 200.00000 Tm\n(Hello!) Tj\n" +
+		"10.00000 -20.00000 Td\n"
+	if !strings.HasPrefix(text.buf.String(), wantOutput) {
+		t.Errorf("Output was %q, expected prefix %q", text.buf.String(), wantOutput)
+	}
+}
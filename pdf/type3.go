@@ -0,0 +1,235 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"errors"
+	"fmt"
+)
+
+// A Type3Font is a font whose glyphs are drawn with the same operations as a
+// canvas.  It can be used wherever a font is set, like any other font.
+//
+// Glyphs are drawn in glyph space, where an em is 1000 units.  The glyphs are
+// painted in the text's fill color, so a glyph's drawing must not use colors.
+// The color setters of a glyph canvas return an error and SetColor does
+// nothing.  Text with a fill color and images other than stencil masks must
+// not be drawn on a glyph canvas either.
+type Type3Font struct {
+	doc     *Document
+	name    string
+	resName name
+	ref     Reference
+
+	codes  map[rune]byte
+	glyphs [256]*type3Glyph
+	bbox   Rectangle
+
+	dict      *type3FontDict
+	toUnicode *cmapStream
+}
+
+type type3Glyph struct {
+	r     rune
+	name  name
+	width Unit
+}
+
+// NewType3Font adds an empty Type3 font to the document.  Its glyphs are added
+// with AddGlyph.
+func (doc *Document) NewType3Font(fontName string) *Type3Font {
+	font := &Type3Font{
+		doc:     doc,
+		name:    fontName,
+		resName: doc.nextFontName(),
+		codes:   make(map[rune]byte),
+	}
+	font.dict = &type3FontDict{
+		Type:       fontType,
+		Subtype:    fontType3Subtype,
+		FontMatrix: []float32{0.001, 0, 0, 0.001, 0, 0},
+		CharProcs:  make(map[name]interface{}),
+		Encoding:   type3Encoding{Type: encodingType},
+		Resources: resources{
+			ProcSet: []name{pdfProcSet, textProcSet, imageCProcSet},
+			Font:    make(map[name]interface{}),
+			XObject: make(map[name]interface{}),
+		},
+	}
+	font.toUnicode = new(cmapStream)
+	font.dict.ToUnicode = doc.add(font.toUnicode)
+	font.ref = doc.add(font.dict)
+	doc.embeddedFonts = append(doc.embeddedFonts, font)
+	return font
+}
+
+// AddGlyph adds a glyph for r to the font and returns the canvas to draw it
+// on.  The glyph advances the text cursor by width, and its drawing must fit
+// in bbox.  The canvas cannot set colors and must be closed once the glyph has
+// been drawn.  A font can have at most 256 glyphs.
+func (font *Type3Font) AddGlyph(r rune, width Unit, bbox Rectangle) (*Canvas, error) {
+	if _, ok := font.codes[r]; ok {
+		return nil, fmt.Errorf("pdf: Type3 font already has a glyph for %U", r)
+	}
+	code := -1
+	if r >= 0 && r < 256 && font.glyphs[r] == nil {
+		code = int(r)
+	} else {
+		for c := range font.glyphs {
+			if font.glyphs[c] == nil {
+				code = c
+				break
+			}
+		}
+	}
+	if code == -1 {
+		return nil, errors.New("pdf: Type3 font has too many glyphs")
+	}
+
+	g := &type3Glyph{r: r, width: width, name: type3GlyphName(r)}
+	font.glyphs[code] = g
+	font.codes[r] = byte(code)
+	if len(font.codes) == 1 {
+		font.bbox = bbox
+	} else {
+		font.bbox = font.bbox.union(bbox)
+	}
+
+	st := newStream(streamFlateDecode)
+	ref := font.doc.add(st)
+	font.dict.CharProcs[g.name] = ref
+	writeCommand(st, "d1", width, 0, bbox.Min.X, bbox.Min.Y, bbox.Max.X, bbox.Max.Y)
+	return &Canvas{
+		doc:       font.doc,
+		ref:       ref,
+		contents:  st,
+		resources: &font.dict.Resources,
+		ctm:       IdentityMatrix,
+	}, nil
+}
+
+// type3GlyphName returns the name of the glyph procedure for r.
+func type3GlyphName(r rune) name {
+	if r <= 0xffff {
+		return name(fmt.Sprintf("uni%04X", r))
+	}
+	return name(fmt.Sprintf("u%X", r))
+}
+
+// Name returns the name that the font was created with.
+func (font *Type3Font) Name() string {
+	return font.name
+}
+
+// Metrics returns the font's dimensions.  The bounding box encloses all of the
+// glyphs added so far, and the ascender and descender are its top and bottom.
+func (font *Type3Font) Metrics() FontMetrics {
+	return FontMetrics{
+		Ascender:  font.bbox.Max.Y,
+		Descender: font.bbox.Min.Y,
+		FontBBox:  font.bbox,
+	}
+}
+
+func (font *Type3Font) resourceName() name {
+	return font.resName
+}
+
+func (font *Type3Font) reference(doc *Document) Reference {
+	return font.ref
+}
+
+// encode converts s to the codes of the font's glyphs.  Like the other fonts,
+// characters that the font has no glyph for are shown as '?', if the font has
+// a glyph for it.  Otherwise they are dropped, since a Type3 font has no
+// .notdef glyph to show instead.
+func (font *Type3Font) encode(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, r := range s {
+		if c, ok := font.codes[r]; ok {
+			b = append(b, c)
+		} else if c, ok := font.codes['?']; ok {
+			b = append(b, c)
+		}
+	}
+	return string(b)
+}
+
+func (font *Type3Font) hasGlyph(r rune) bool {
+	_, ok := font.codes[r]
+	return ok
+}
+
+func (font *Type3Font) stringWidth(codes string, size Unit) Unit {
+	var width Unit
+	for i := 0; i < len(codes); i++ {
+		if g := font.glyphs[codes[i]]; g != nil {
+			width += g.width
+		}
+	}
+	return width * size / 1000
+}
+
+// useCodes does nothing: every glyph procedure is written to the document.
+func (font *Type3Font) useCodes(codes string) {
+}
+
+// writeFontProgram fills in the font's encoding, widths and ToUnicode CMap
+// from the glyphs that were added.
+func (font *Type3Font) writeFontProgram() error {
+	first, last := -1, -1
+	for c, g := range font.glyphs {
+		if g != nil {
+			if first == -1 {
+				first = c
+			}
+			last = c
+		}
+	}
+	font.dict.FontBBox = font.bbox
+	font.dict.FirstChar, font.dict.LastChar = 0, 0
+	font.dict.Widths = []Unit{0}
+	font.dict.Encoding.Differences = nil
+	text := make(map[int]string)
+	if first != -1 {
+		font.dict.FirstChar, font.dict.LastChar = first, last
+		font.dict.Widths = make([]Unit, last-first+1)
+		for c := first; c <= last; c++ {
+			g := font.glyphs[c]
+			if g == nil {
+				continue
+			}
+			font.dict.Widths[c-first] = g.width
+			if c == 0 || font.glyphs[c-1] == nil {
+				font.dict.Encoding.Differences = append(font.dict.Encoding.Differences, c)
+			}
+			font.dict.Encoding.Differences = append(font.dict.Encoding.Differences, g.name)
+			text[c] = string(g.r)
+		}
+	}
+
+	font.toUnicode.stream = newStream(streamFlateDecode)
+	if err := writeToUnicodeCMap(font.toUnicode, 1, text); err != nil {
+		return err
+	}
+	return font.toUnicode.Close()
+}
+
+type type3FontDict struct {
+	Type       name
+	Subtype    name
+	FontBBox   Rectangle
+	FontMatrix []float32
+	CharProcs  map[name]interface{}
+	Encoding   type3Encoding
+	FirstChar  int
+	LastChar   int
+	Widths     []Unit
+	Resources  resources
+	ToUnicode  Reference
+}
+
+type type3Encoding struct {
+	Type        name
+	Differences []interface{}
+}
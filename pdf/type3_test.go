@@ -0,0 +1,89 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"testing"
+)
+
+func TestType3Font(t *testing.T) {
+	doc := New()
+	font := doc.NewType3Font("Shapes")
+	box := Rectangle{Point{0, 0}, Point{600, 700}}
+	for _, r := range "AΩ" {
+		glyph, err := font.AddGlyph(r, 600, box)
+		if err != nil {
+			t.Fatalf("AddGlyph(%q) error: %v", r, err)
+		}
+		if err := glyph.SetFillColor(DeviceGray(0.5)); err == nil {
+			t.Error("SetFillColor on a glyph canvas did not return an error")
+		}
+		if err := glyph.SetStrokeColor(DeviceGray(0.5)); err == nil {
+			t.Error("SetStrokeColor on a glyph canvas did not return an error")
+		}
+		glyph.SetColor(1, 0, 0)
+		path := new(Path)
+		path.Rectangle(box)
+		glyph.Fill(path)
+		glyph.Close()
+	}
+	if _, err := font.AddGlyph('A', 600, box); err == nil {
+		t.Error("AddGlyph did not fail for a duplicate glyph")
+	}
+
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.Text("AΩB")
+	const want = "/__font0__ 10.00000 Tf\n12.00000 TL\n(A\x00) Tj\n"
+	if text.buf.String() != want {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), want)
+	}
+	if w := MeasureString(font, 10, "AΩB"); w != 12 {
+		t.Errorf("MeasureString = %v; want 12", w)
+	}
+	canvas.DrawText(text)
+	canvas.Close()
+	if canvas.resources.Font[font.resourceName()] != font.ref {
+		t.Error("font was not added to the page's resources")
+	}
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	d := font.dict
+	if d.FirstChar != 0 || d.LastChar != 'A' || len(d.Widths) != 'A'+1 || d.Widths[0] != 600 || d.Widths['A'] != 600 {
+		t.Errorf("FirstChar, LastChar, Widths = %d, %d, %v", d.FirstChar, d.LastChar, d.Widths)
+	}
+	if s := fmt.Sprint(d.Encoding.Differences); s != "[0 uni03A9 65 uni0041]" {
+		t.Errorf("Differences = %s", s)
+	}
+	cmap, err := ioutil.ReadAll(flateReader(t, font.toUnicode.Bytes()))
+	if err != nil {
+		t.Fatalf("reading ToUnicode: %v", err)
+	}
+	for _, s := range []string{"<00> <03A9>\n", "<41> <0041>\n"} {
+		if !bytes.Contains(cmap, []byte(s)) {
+			t.Errorf("ToUnicode CMap does not contain %q", s)
+		}
+	}
+}
+
+func TestType3FontMissingGlyph(t *testing.T) {
+	font := New().NewType3Font("Shapes")
+	box := Rectangle{Point{0, 0}, Point{600, 700}}
+	for _, r := range "A?" {
+		glyph, err := font.AddGlyph(r, 600, box)
+		if err != nil {
+			t.Fatalf("AddGlyph(%q) error: %v", r, err)
+		}
+		glyph.Close()
+	}
+	if codes := font.encode("AΩB"); codes != "A??" {
+		t.Errorf("encode(%q) = %q; want %q", "AΩB", codes, "A??")
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+// WritingMode is the direction that a text object's lines advance in.
+type WritingMode int
+
+// Writing modes
+const (
+	// HorizontalWriting advances the text cursor to the right after each
+	// character.
+	HorizontalWriting WritingMode = iota
+
+	// VerticalWriting advances the text cursor downward after each
+	// character, as in traditional Chinese, Japanese and Korean text.
+	// Only fonts added with AddUnicodeFont can be written vertically;
+	// text in other fonts is still written horizontally.
+	VerticalWriting
+)
+
+// identityVEncoding is the vertical counterpart of Identity-H.
+const identityVEncoding name = "Identity-V"
+
+// verticalFont shows the glyphs of a composite font in vertical writing.  It
+// shares the composite font's CIDFont and ToUnicode CMap, but selects them with
+// the Identity-V encoding, so the glyphs advance by their vertical metrics.
+type verticalFont struct {
+	*compositeFont
+	resName name
+	ref     Reference
+	dict    *type0FontDict
+}
+
+// verticalFont returns the vertical version of the font, adding it to the
+// document the first time it is needed.
+func (font *compositeFont) verticalFont() *verticalFont {
+	if font.vertical != nil {
+		return font.vertical
+	}
+	dict := *font.dict
+	dict.Encoding = identityVEncoding
+	vf := &verticalFont{
+		compositeFont: font,
+		resName:       font.doc.nextFontName(),
+		dict:          &dict,
+	}
+	vf.ref = font.doc.add(vf.dict)
+	font.vertical = vf
+	return vf
+}
+
+func (font *verticalFont) resourceName() name {
+	return font.resName
+}
+
+func (font *verticalFont) reference(doc *Document) Reference {
+	return font.ref
+}
+
+// stringWidth returns the distance that a string of character codes advances
+// downward.
+func (font *verticalFont) stringWidth(codes string, size Unit) Unit {
+	height := 0
+	for i := 0; i+1 < len(codes); i += 2 {
+		cid := uint16(codes[i])<<8 | uint16(codes[i+1])
+		height += font.sfnt.verticalAdvance(font.glyphIndex(cid))
+	}
+	return Unit(height) * size / 1000
+}
+
+// shape lays out s like the composite font does, then substitutes the glyphs
+// for their vertical alternates, like rotated brackets.  Horizontal
+// positioning does not apply to vertical text, so there are no adjustments.
+func (font *verticalFont) shape(s string, rtl, kern bool) (string, []kernAdjustment, []kernAdjustment) {
+	f := font.sfnt
+	text := []rune(s)
+	glyphs := f.shape(text, rtl, false)
+	if l := f.layout; l != nil && l.gsub != nil {
+		st := l.verticalStage()
+		b := &glyphBuffer{glyphs: glyphs, layout: l}
+		b.apply(l.gsub, st.lookups, st.masks)
+		glyphs = b.glyphs
+	}
+	font.recordText(text, glyphs, rtl)
+
+	codes := make([]byte, 0, 2*len(glyphs))
+	for _, g := range glyphs {
+		cid := font.cid(g.gid)
+		codes = append(codes, byte(cid>>8), byte(cid))
+	}
+	return string(codes), nil, nil
+}
+
+// verticalStage returns the lookups of the font's vertical alternates feature.
+// vrt2 is preferred to vert, since it includes vert's substitutions.
+func (l *otLayout) verticalStage() *shapeStage {
+	if l.vertical != nil {
+		return l.vertical
+	}
+	scripts := []string{"hani", "kana", "hang", "DFLT", "dflt", "latn"}
+	ls := l.gsub.langSys(scripts)
+	p := &shapePlan{global: 1}
+	var st shapeStage
+	for _, tag := range []string{"vrt2", "vert"} {
+		st = p.stage(l.gsub, ls, featureStage{features: []shapeFeature{{tag, true}}}, false)
+		if len(st.lookups) > 0 {
+			break
+		}
+	}
+	l.vertical = &st
+	return l.vertical
+}
+
+// verticalMetrics returns the DW2 and W2 entries of a CIDFont for the used
+// CIDs.  Each glyph's vertical origin is centered horizontally, and glyphs
+// whose advance height or origin differ from the default are listed in W2,
+// with consecutive CIDs grouped together.
+func (font *compositeFont) verticalMetrics() (dw2 []int, w2 []interface{}) {
+	f := font.sfnt
+	dw2 = []int{f.scale(f.ascent), -f.scale(f.ascent - f.descent)}
+	var run []int
+	last := -1
+	for _, cid := range font.usedCIDs() {
+		g := font.glyphIndex(uint16(cid))
+		vy, w1 := f.verticalOrigin(g), -f.verticalAdvance(g)
+		if vy == dw2[0] && w1 == dw2[1] {
+			continue
+		}
+		if cid != last+1 || run == nil {
+			if run != nil {
+				w2 = append(w2, run)
+			}
+			w2 = append(w2, cid)
+			run = nil
+		}
+		run = append(run, w1, f.advance(g)/2, vy)
+		last = cid
+	}
+	if run != nil {
+		w2 = append(w2, run)
+	}
+	return dw2, w2
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2011, Ross Light
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"golang.org/x/image/font/gofont/goregular"
+)
+
+// verticalTestFont returns Go Regular with vertical metrics that advance every
+// glyph by an em and a vert feature that substitutes "[" for "(".
+func verticalTestFont(t *testing.T) []byte {
+	f, err := parseSFNT(goregular.TTF)
+	if err != nil {
+		t.Fatalf("parseSFNT error: %v", err)
+	}
+	tables := make(map[string][]byte)
+	for tag, data := range f.tables {
+		tables[tag] = data
+	}
+	vhea := make([]byte, 36)
+	copy(vhea[34:], u16s(1))
+	tables["vhea"] = vhea
+	tables["vmtx"] = u16s(f.unitsPerEm, 100)
+	from, to := int(f.glyphIndex('(')), int(f.glyphIndex('['))
+	tables["GSUB"] = buildLayoutTable("vert", gsubSingle, u16s(1, 6, to-from, 1, 1, from))
+	return buildSFNT(sfntVersionTrueType, tables)
+}
+
+func TestVerticalWriting(t *testing.T) {
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(verticalTestFont(t)))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	f := font.(*compositeFont).sfnt
+
+	canvas := doc.NewPage(USLetterWidth, USLetterHeight)
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.SetWritingMode(VerticalWriting)
+	text.Text("(A")
+	want := "12.00000 TL\n/__font1__ 10.00000 Tf\n" +
+		fmt.Sprintf("<%04X%04X> Tj\n", f.glyphIndex('['), f.glyphIndex('A'))
+	if text.buf.String() != want {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), want)
+	}
+	if text.X() != 0 || text.Y() != -20 {
+		t.Errorf("cursor at (%v, %v); want (0, -20)", text.X(), text.Y())
+	}
+	canvas.DrawText(text)
+	if _, ok := canvas.resources.Font[font.resourceName()]; ok {
+		t.Errorf("Font resources include the unused horizontal font: %v", canvas.resources.Font)
+	}
+	canvas.Close()
+
+	var buf bytes.Buffer
+	if err := doc.Encode(&buf); err != nil {
+		t.Fatalf("Encode error: %v", err)
+	}
+	vf := font.(*compositeFont).vertical
+	if vf.dict.Encoding != identityVEncoding {
+		t.Errorf("Encoding = %v; want %v", vf.dict.Encoding, identityVEncoding)
+	}
+	if vf.dict.DescendantFonts[0] != font.(*compositeFont).dict.DescendantFonts[0] {
+		t.Error("vertical font does not share the CIDFont")
+	}
+	cidFont := font.(*compositeFont).cidFont
+	if len(cidFont.DW2) != 2 || cidFont.DW2[1] != -f.scale(f.ascent-f.descent) {
+		t.Errorf("DW2 = %v", cidFont.DW2)
+	}
+	g := f.glyphIndex('A')
+	data, err := f.glyphData(g)
+	if err != nil {
+		t.Fatalf("glyphData error: %v", err)
+	}
+	entry := fmt.Sprint(int(g), []int{-1000, f.advance(g) / 2, f.scale(int(i16(data, 8)))})
+	if s := fmt.Sprint(cidFont.W2...); !bytes.Contains([]byte(s), []byte(entry)) {
+		t.Errorf("W2 = %s; want it to contain %s", s, entry)
+	}
+}
+
+func TestWritingModeSwitch(t *testing.T) {
+	doc := New()
+	font, err := doc.AddUnicodeFont(bytes.NewReader(verticalTestFont(t)))
+	if err != nil {
+		t.Fatalf("AddUnicodeFont error: %v", err)
+	}
+	f := font.(*compositeFont).sfnt
+	g := fmt.Sprintf("<%04X> Tj\n", f.glyphIndex('A'))
+
+	text := new(Text)
+	text.SetFontFace(font, 10)
+	text.Text("A")
+	text.SetWritingMode(VerticalWriting)
+	text.SetWritingMode(VerticalWriting)
+	text.Text("A")
+	text.SetWritingMode(HorizontalWriting)
+	text.SetWritingMode(VerticalWriting)
+	text.Text("A")
+	want := "12.00000 TL\n/__font0__ 10.00000 Tf\n" + g + "/__font1__ 10.00000 Tf\n" + g + g
+	if text.buf.String() != want {
+		t.Errorf("Output was %q, expected %q", text.buf.String(), want)
+	}
+}